@@ -43,6 +43,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.NodeConfig == nil {
 		return nil, errors.New("wasm config is required for ante builder")
 	}
+	if options.WasmKeeper == nil {
+		return nil, errors.New("wasm keeper is required for ante builder")
+	}
 	if options.TXCounterStoreService == nil {
 		return nil, errors.New("wasm store service is required for ante builder")
 	}
@@ -54,7 +57,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		wasmkeeper.NewLimitSimulationGasDecorator(options.NodeConfig.SimulationGasLimit), // after setup context to enforce limits early
 		wasmkeeper.NewCountTXDecorator(options.TXCounterStoreService),
-		wasmkeeper.NewGasRegisterDecorator(options.WasmKeeper.GetGasRegister()),
+		wasmkeeper.NewGasRegisterSourceDecorator(options.WasmKeeper),
 		wasmkeeper.NewTxContractsDecorator(),
 		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
@@ -62,14 +65,34 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		// contracts with a fee sponsorship can be set as fee granter of txs that only execute them
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, wasmkeeper.NewFeeSponsorGrantKeeper(options.WasmKeeper, options.FeegrantKeeper), options.TxFeeChecker),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		wasmkeeper.NewFeeSponsorshipDecorator(options.WasmKeeper), // contracts approve sponsored fees only for authenticated txs
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
+
+// PostHandlerOptions are the options required for constructing the post handler
+type PostHandlerOptions struct {
+	WasmKeeper *wasmkeeper.Keeper
+}
+
+// NewPostHandler constructor
+func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
+	if options.WasmKeeper == nil {
+		return nil, errors.New("wasm keeper is required for post handler builder")
+	}
+
+	postDecorators := []sdk.PostDecorator{
+		wasmkeeper.NewFeeShareDecorator(options.WasmKeeper),
+	}
+
+	return sdk.ChainPostDecorators(postDecorators...), nil
+}
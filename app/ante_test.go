@@ -0,0 +1,121 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestAnteHandlerFeeSponsorshipRequiresValidSignature(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	var sudoCalled bool
+	mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		sudoCalled = true
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 1, nil
+	}
+	app := Setup(t, wasmkeeper.WithWasmEngine(&mock))
+	parentCtx := app.NewContext(false).WithBlockHeader(cmtproto.Header{ChainID: "testing", Height: app.LastBlockHeight() + 1, Time: time.Now()})
+	txCfg := app.TxConfig()
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	// a sponsoring contract
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	initAccountWithCoins(app, parentCtx, creator, fee)
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(parentCtx, creator, []byte("\x00asm"), nil)
+	require.NoError(t, err)
+	contract, _, err := contractKeeper.Instantiate(parentCtx, codeID, creator, creator, []byte(`{}`), "sponsor", nil)
+	require.NoError(t, err)
+	initAccountWithCoins(app, parentCtx, contract, fee)
+	_, err = wasmkeeper.NewMsgServerImpl(&app.WasmKeeper).SetFeeSponsorship(parentCtx, &types.MsgSetFeeSponsorship{
+		Sender:           creator.String(),
+		Contract:         contract.String(),
+		PerBlockLimit:    fee,
+		PerSenderLimit:   fee,
+		ApprovalGasLimit: 100_000,
+	})
+	require.NoError(t, err)
+
+	// and a fee payer without funds
+	priv := secp256k1.GenPrivKey()
+	feePayer := sdk.AccAddress(priv.PubKey().Address())
+	app.AccountKeeper.SetAccount(parentCtx, app.AccountKeeper.NewAccountWithAddress(parentCtx, feePayer))
+	accNum := app.AccountKeeper.GetAccount(parentCtx, feePayer).GetAccountNumber()
+	signMode, err := authsigning.APISignModeToInternal(txCfg.SignModeHandler().DefaultMode())
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		sign      func(t *testing.T, ctx sdk.Context, txBuilder client.TxBuilder) signing.SignatureV2
+		expCalled bool
+		expErr    error
+	}{
+		"valid signature": {
+			sign: func(t *testing.T, ctx sdk.Context, txBuilder client.TxBuilder) signing.SignatureV2 {
+				signerData := authsigning.SignerData{
+					ChainID:       ctx.ChainID(),
+					AccountNumber: accNum,
+					Address:       feePayer.String(),
+					PubKey:        priv.PubKey(),
+				}
+				sig, err := clienttx.SignWithPrivKey(ctx, signMode, signerData, txBuilder, priv, txCfg, 0)
+				require.NoError(t, err)
+				return sig
+			},
+			expCalled: true,
+		},
+		"invalid signature": {
+			sign: func(t *testing.T, ctx sdk.Context, txBuilder client.TxBuilder) signing.SignatureV2 {
+				return signing.SignatureV2{
+					PubKey: priv.PubKey(),
+					Data:   &signing.SingleSignatureData{SignMode: signMode, Signature: []byte("invalid")},
+				}
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			sudoCalled = false
+			txBuilder := txCfg.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(&types.MsgExecuteContract{Sender: feePayer.String(), Contract: contract.String(), Msg: []byte(`{}`)}))
+			txBuilder.SetFeeAmount(fee)
+			txBuilder.SetFeeGranter(contract)
+			txBuilder.SetGasLimit(1_000_000)
+			require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey: priv.PubKey(),
+				Data:   &signing.SingleSignatureData{SignMode: signMode},
+			}))
+			require.NoError(t, txBuilder.SetSignatures(spec.sign(t, ctx, txBuilder)))
+
+			// when
+			_, gotErr := app.AnteHandler()(ctx, txBuilder.GetTx(), false)
+
+			// then
+			assert.Equal(t, spec.expCalled, sudoCalled)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
@@ -78,6 +78,27 @@ func TestGrants(t *testing.T) {
 			senderKey:      granteePrivKey,
 			expErr:         sdkerrors.ErrUnauthorized,
 		},
+		"in limits and field constraints": {
+			limit: types.NewMaxFundsLimit(myAmount),
+			filter: types.NewAcceptedMessageConstraintsFilter(types.MessageFieldConstraint{
+				Path:     "reflect_msg.msgs.0.bank.burn.amount.0.amount",
+				Operator: types.ConstraintOperatorLessThanOrEqual,
+				Values:   []types.RawContractMessage{[]byte(myAmount.Amount.String())},
+			}),
+			transferAmount: myAmount,
+			senderKey:      granteePrivKey,
+		},
+		"not match field constraints": {
+			limit: types.NewMaxFundsLimit(myAmount),
+			filter: types.NewAcceptedMessageConstraintsFilter(types.MessageFieldConstraint{
+				Path:     "reflect_msg.msgs.0.bank.burn.amount.0.amount",
+				Operator: types.ConstraintOperatorLessThan,
+				Values:   []types.RawContractMessage{[]byte(myAmount.Amount.String())},
+			}),
+			transferAmount: sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.OneInt()),
+			senderKey:      granteePrivKey,
+			expErr:         sdkerrors.ErrUnauthorized,
+		},
 		"non authorized sender address": { // sanity check - testing sdk
 			limit:          types.NewMaxFundsLimit(myAmount),
 			filter:         types.NewAllowAllMessagesFilter(),
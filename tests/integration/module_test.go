@@ -503,6 +503,16 @@ func TestReadNodeConfig(t *testing.T) {
 				ContractDebugMode:  true,
 			},
 		},
+		"disable simulate execute via opts": {
+			src: AppOptionsMock{
+				"wasm.simulate_execute_disabled": true,
+			},
+			exp: types.NodeConfig{
+				SmartQueryGasLimit:      defaults.SmartQueryGasLimit,
+				MemoryCacheSize:         defaults.MemoryCacheSize,
+				SimulateExecuteDisabled: true,
+			},
+		},
 		"all defaults when no options set": {
 			src: AppOptionsMock{},
 			exp: defaults,
@@ -513,15 +523,17 @@ func TestReadNodeConfig(t *testing.T) {
 		},
 		"custom config template values": {
 			src: withViper(types.ConfigTemplate(types.NodeConfig{
-				SimulationGasLimit: &one,
-				SmartQueryGasLimit: 2,
-				MemoryCacheSize:    3,
+				SimulationGasLimit:      &one,
+				SmartQueryGasLimit:      2,
+				MemoryCacheSize:         3,
+				SimulateExecuteDisabled: true,
 			})),
 			exp: types.NodeConfig{
-				SimulationGasLimit: &one,
-				SmartQueryGasLimit: 2,
-				MemoryCacheSize:    3,
-				ContractDebugMode:  false,
+				SimulationGasLimit:      &one,
+				SmartQueryGasLimit:      2,
+				MemoryCacheSize:         3,
+				ContractDebugMode:       false,
+				SimulateExecuteDisabled: true,
 			},
 		},
 	}
@@ -14,8 +14,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
 	"github.com/CosmWasm/wasmd/app"
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -55,6 +58,70 @@ func TestStoreCode(t *testing.T) {
 	assert.Equal(t, types.DefaultParams().InstantiateDefaultPermission.With(sender), info.InstantiateConfig)
 }
 
+func TestStoreCodeWithMetadata(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false)
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, otherAddr := testdata.KeyTestPubAddr()
+	myMetadata := types.CodeMetadata{
+		SourceRepository: "https://github.com/CosmWasm/cosmwasm",
+		Commit:           "a1b2c3d",
+		BuilderImage:     "cosmwasm/optimizer:0.16.0",
+		OptimizerVersion: "0.16.0",
+	}
+	msg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+		m.WASMByteCode = wasmContract
+		m.Sender = sender.String()
+		m.Metadata = &myMetadata
+	})
+
+	// when
+	rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+
+	// then
+	require.NoError(t, err)
+	var result types.MsgStoreCodeResponse
+	require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+	assert.Equal(t, &myMetadata, wasmApp.WasmKeeper.GetCodeInfo(ctx, result.CodeID).Metadata)
+
+	specs := map[string]struct {
+		sender      sdk.AccAddress
+		expErr      bool
+		expMetadata *types.CodeMetadata
+	}{
+		"creator can update": {
+			sender:      sender,
+			expMetadata: &types.CodeMetadata{Commit: "e4f5a6b", SchemaHash: "abcdef"},
+		},
+		"other address rejected": {
+			sender:      otherAddr,
+			expErr:      true,
+			expMetadata: &myMetadata,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			msg := &types.MsgSetCodeMetadata{
+				Sender:   spec.sender.String(),
+				CodeID:   result.CodeID,
+				Metadata: types.CodeMetadata{Commit: "e4f5a6b", SchemaHash: "abcdef"},
+			}
+
+			// when
+			_, err := wasmApp.MsgServiceRouter().Handler(msg)(xCtx, msg)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, spec.expMetadata, wasmApp.WasmKeeper.GetCodeInfo(xCtx, result.CodeID).Metadata)
+		})
+	}
+}
+
 func TestUpdateParams(t *testing.T) {
 	wasmApp := app.Setup(t)
 	ctx := wasmApp.BaseApp.NewContext(false)
@@ -1227,3 +1294,860 @@ func TestUpdateContractLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestFreezeContract(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"authority can freeze contract": {
+			addr:   authority,
+			expErr: false,
+		},
+		"admin cannot freeze contract": {
+			addr:   myAddress.String(),
+			expErr: true,
+		},
+		"other address cannot freeze contract": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				UnpinCode:             false,
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr, err := sdk.AccAddressFromBech32(storeAndInstantiateResponse.Address)
+			require.NoError(t, err)
+
+			// when
+			msgFreeze := &types.MsgFreezeContract{
+				Authority: spec.addr,
+				Contract:  storeAndInstantiateResponse.Address,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgFreeze)(ctx, msgFreeze)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.False(t, wasmApp.WasmKeeper.IsContractFrozen(ctx, contractAddr))
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, wasmApp.WasmKeeper.IsContractFrozen(ctx, contractAddr))
+
+			// and executions are rejected
+			msgExecute := &types.MsgExecuteContract{
+				Sender:   myAddress.String(),
+				Contract: storeAndInstantiateResponse.Address,
+				Msg:      []byte(`{}`),
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgExecute)(ctx, msgExecute)
+			require.ErrorIs(t, err, types.ErrContractFrozen)
+
+			// and unfreeze resumes the contract
+			msgUnfreeze := &types.MsgUnfreezeContract{
+				Authority: spec.addr,
+				Contract:  storeAndInstantiateResponse.Address,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgUnfreeze)(ctx, msgUnfreeze)
+			require.NoError(t, err)
+			assert.False(t, wasmApp.WasmKeeper.IsContractFrozen(ctx, contractAddr))
+		})
+	}
+}
+
+func TestDeprecateCodes(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"authority can deprecate codes": {
+			addr:   authority,
+			expErr: false,
+		},
+		"other address cannot deprecate codes": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			codeID := wasmApp.WasmKeeper.GetContractInfo(ctx, sdk.MustAccAddressFromBech32(storeAndInstantiateResponse.Address)).CodeID
+
+			// when
+			msgDeprecate := &types.MsgDeprecateCodes{
+				Authority: spec.addr,
+				CodeIDs:   []uint64{codeID},
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgDeprecate)(ctx, msgDeprecate)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.False(t, wasmApp.WasmKeeper.IsCodeDeprecated(ctx, codeID))
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, wasmApp.WasmKeeper.IsCodeDeprecated(ctx, codeID))
+
+			// and new instances are rejected
+			msgInstantiate := &types.MsgInstantiateContract{
+				Sender: myAddress.String(),
+				CodeID: codeID,
+				Label:  "test",
+				Msg:    []byte(`{}`),
+				Funds:  sdk.Coins{},
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgInstantiate)(ctx, msgInstantiate)
+			require.ErrorIs(t, err, types.ErrCodeDeprecated)
+
+			// and undeprecate allows them again
+			msgUndeprecate := &types.MsgUndeprecateCodes{
+				Authority: spec.addr,
+				CodeIDs:   []uint64{codeID},
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgUndeprecate)(ctx, msgUndeprecate)
+			require.NoError(t, err)
+			assert.False(t, wasmApp.WasmKeeper.IsCodeDeprecated(ctx, codeID))
+			_, err = wasmApp.MsgServiceRouter().Handler(msgInstantiate)(ctx, msgInstantiate)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRegisterCronSchedule(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"authority can register schedules": {
+			addr:   authority,
+			expErr: false,
+		},
+		"other address cannot register schedules": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+
+			// when
+			msgRegister := &types.MsgRegisterCronSchedule{
+				Authority: spec.addr,
+				Name:      "my-schedule",
+				Contract:  storeAndInstantiateResponse.Address,
+				Msg:       []byte(`{}`),
+				Interval:  10,
+				GasLimit:  100_000,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgRegister)(ctx, msgRegister)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Nil(t, wasmApp.WasmKeeper.GetCronSchedule(ctx, "my-schedule"))
+				return
+			}
+			require.NoError(t, err)
+			got := wasmApp.WasmKeeper.GetCronSchedule(ctx, "my-schedule")
+			require.NotNil(t, got)
+			assert.Equal(t, storeAndInstantiateResponse.Address, got.Contract)
+			assert.Equal(t, uint64(ctx.BlockHeight())+10, got.NextHeight)
+
+			// and can be removed
+			msgRemove := &types.MsgRemoveCronSchedule{
+				Authority: spec.addr,
+				Name:      "my-schedule",
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgRemove)(ctx, msgRemove)
+			require.NoError(t, err)
+			assert.Nil(t, wasmApp.WasmKeeper.GetCronSchedule(ctx, "my-schedule"))
+		})
+	}
+}
+
+func TestSubscribeEpochHook(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+	)
+	params := wasmApp.WasmKeeper.GetParams(ctx)
+	params.EpochHookGasBudget = 1_000_000
+	require.NoError(t, wasmApp.WasmKeeper.SetParams(ctx, params))
+
+	specs := map[string]struct {
+		addr            string
+		epochIdentifier string
+		expErr          bool
+	}{
+		"admin can subscribe": {
+			addr: myAddress.String(),
+		},
+		"authority can subscribe": {
+			addr: authority,
+		},
+		"other address cannot subscribe": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+		"unknown epoch identifier": {
+			addr:            myAddress.String(),
+			epochIdentifier: "fortnight",
+			expErr:          true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr := sdk.MustAccAddressFromBech32(storeAndInstantiateResponse.Address)
+
+			// when
+			epochIdentifier := "week"
+			if spec.epochIdentifier != "" {
+				epochIdentifier = spec.epochIdentifier
+			}
+			msgSubscribe := &types.MsgSubscribeEpochHook{
+				Sender:          spec.addr,
+				Contract:        contractAddr.String(),
+				EpochIdentifier: epochIdentifier,
+				GasLimit:        100_000,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgSubscribe)(ctx, msgSubscribe)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Nil(t, wasmApp.WasmKeeper.GetEpochHookSubscription(ctx, epochIdentifier, contractAddr))
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, wasmApp.WasmKeeper.GetEpochHookSubscription(ctx, "week", contractAddr))
+
+			// and the contract is called on epoch end
+			em := sdk.NewEventManager()
+			require.NoError(t, wasmApp.EpochsKeeper.AfterEpochEnd(ctx.WithEventManager(em), "week", 1))
+			var found bool
+			for _, e := range em.Events() {
+				if e.Type != types.EventTypeEpochHookExecution {
+					continue
+				}
+				for _, a := range e.Attributes {
+					found = found || (a.Key == types.AttributeKeyContractAddr && a.Value == contractAddr.String())
+				}
+			}
+			assert.True(t, found)
+
+			// and can be unsubscribed
+			msgUnsubscribe := &types.MsgUnsubscribeEpochHook{
+				Sender:          spec.addr,
+				Contract:        contractAddr.String(),
+				EpochIdentifier: "week",
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgUnsubscribe)(ctx, msgUnsubscribe)
+			require.NoError(t, err)
+			assert.Nil(t, wasmApp.WasmKeeper.GetEpochHookSubscription(ctx, "week", contractAddr))
+		})
+	}
+}
+
+func TestRegisterFeeShare(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+		fee                            = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	)
+	params := wasmApp.WasmKeeper.GetParams(ctx)
+	params.DeveloperFeeShareBps = 1000
+	require.NoError(t, wasmApp.WasmKeeper.SetParams(ctx, params))
+	postHandler, err := app.NewPostHandler(app.PostHandlerOptions{WasmKeeper: &wasmApp.WasmKeeper})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"admin can register": {
+			addr: myAddress.String(),
+		},
+		"authority can register": {
+			addr: authority,
+		},
+		"other address cannot register": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr := sdk.MustAccAddressFromBech32(storeAndInstantiateResponse.Address)
+			_, _, withdrawAddr := testdata.KeyTestPubAddr()
+
+			// when
+			msgRegister := &types.MsgRegisterFeeShare{
+				Sender:          spec.addr,
+				Contract:        contractAddr.String(),
+				WithdrawAddress: withdrawAddr.String(),
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgRegister)(ctx, msgRegister)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Nil(t, wasmApp.WasmKeeper.GetFeeShare(ctx, contractAddr))
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, wasmApp.WasmKeeper.GetFeeShare(ctx, contractAddr))
+
+			// and the withdraw address receives a share of the fee of a tx executing the contract
+			require.NoError(t, banktestutil.FundModuleAccount(ctx, wasmApp.BankKeeper, authtypes.FeeCollectorName, fee))
+			txCtx := types.WithTxContracts(ctx, types.NewTxContracts())
+			msgExecute := &types.MsgExecuteContract{
+				Sender:   authority,
+				Contract: contractAddr.String(),
+				Msg:      []byte(`{"change_owner":{"owner":"` + authority + `"}}`),
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgExecute)(txCtx, msgExecute)
+			require.NoError(t, err)
+			_, err = postHandler(txCtx, feeTxMock{fee: fee}, false, true)
+			require.NoError(t, err)
+			assert.Equal(t, sdk.NewInt64Coin("stake", 100), wasmApp.BankKeeper.GetBalance(ctx, withdrawAddr, "stake"))
+
+			// and can be cancelled
+			msgCancel := &types.MsgCancelFeeShare{
+				Sender:   spec.addr,
+				Contract: contractAddr.String(),
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgCancel)(ctx, msgCancel)
+			require.NoError(t, err)
+			assert.Nil(t, wasmApp.WasmKeeper.GetFeeShare(ctx, contractAddr))
+		})
+	}
+}
+
+type feeTxMock struct {
+	sdk.FeeTx
+	fee sdk.Coins
+}
+
+func (m feeTxMock) GetFee() sdk.Coins {
+	return m.fee
+}
+
+func TestSetFeeSponsorship(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+		limit                          = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"admin can set": {
+			addr: myAddress.String(),
+		},
+		"authority can set": {
+			addr: authority,
+		},
+		"other address cannot set": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr := sdk.MustAccAddressFromBech32(storeAndInstantiateResponse.Address)
+
+			// when
+			msgSet := &types.MsgSetFeeSponsorship{
+				Sender:           spec.addr,
+				Contract:         contractAddr.String(),
+				PerBlockLimit:    limit,
+				PerSenderLimit:   limit,
+				ApprovalGasLimit: 100_000,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgSet)(ctx, msgSet)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Nil(t, wasmApp.WasmKeeper.GetFeeSponsorship(ctx, contractAddr))
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, wasmApp.WasmKeeper.GetFeeSponsorship(ctx, contractAddr))
+
+			// and fees of other msgs are not sponsored
+			grantKeeper := keeper.NewFeeSponsorGrantKeeper(&wasmApp.WasmKeeper, wasmApp.FeeGrantKeeper)
+			msgClearAdmin := &types.MsgClearAdmin{Sender: myAddress.String(), Contract: contractAddr.String()}
+			err = grantKeeper.UseGrantedFees(ctx, contractAddr, otherAddr, limit, []sdk.Msg{msgClearAdmin})
+			require.Error(t, err)
+
+			// and can be removed
+			msgRemove := &types.MsgRemoveFeeSponsorship{
+				Sender:   spec.addr,
+				Contract: contractAddr.String(),
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgRemove)(ctx, msgRemove)
+			require.NoError(t, err)
+			assert.Nil(t, wasmApp.WasmKeeper.GetFeeSponsorship(ctx, contractAddr))
+		})
+	}
+}
+
+func TestProposeContractAdmin(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+		_, _, newAdmin                 = testdata.KeyTestPubAddr()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"admin can propose": {
+			addr: myAddress.String(),
+		},
+		"authority can propose": {
+			addr: authority,
+		},
+		"other address cannot propose": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr := sdk.MustAccAddressFromBech32(storeAndInstantiateResponse.Address)
+
+			// when
+			msgPropose := &types.MsgProposeContractAdmin{
+				Sender:   spec.addr,
+				NewAdmin: newAdmin.String(),
+				Contract: contractAddr.String(),
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgPropose)(ctx, msgPropose)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Nil(t, wasmApp.WasmKeeper.GetPendingAdminTransfer(ctx, contractAddr))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, myAddress.String(), wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr).Admin)
+
+			// and other addresses can not accept
+			msgAccept := &types.MsgAcceptContractAdmin{
+				Sender:   otherAddr.String(),
+				Contract: contractAddr.String(),
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgAccept)(ctx, msgAccept)
+			require.Error(t, err)
+
+			// and the new admin can accept
+			msgAccept.Sender = newAdmin.String()
+			_, err = wasmApp.MsgServiceRouter().Handler(msgAccept)(ctx, msgAccept)
+			require.NoError(t, err)
+			assert.Equal(t, newAdmin.String(), wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr).Admin)
+			assert.Nil(t, wasmApp.WasmKeeper.GetPendingAdminTransfer(ctx, contractAddr))
+		})
+	}
+}
+
+func TestRegisterContractName(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"admin can register": {
+			addr: myAddress.String(),
+		},
+		"authority can register": {
+			addr: authority,
+		},
+		"other address cannot register": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(xCtx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr := sdk.MustAccAddressFromBech32(storeAndInstantiateResponse.Address)
+
+			// when
+			msgRegister := &types.MsgRegisterContractName{
+				Sender:   spec.addr,
+				Contract: contractAddr.String(),
+				Name:     "mantra.dex.pool-factory",
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgRegister)(xCtx, msgRegister)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Nil(t, wasmApp.WasmKeeper.GetContractByName(xCtx, msgRegister.Name))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, contractAddr, wasmApp.WasmKeeper.GetContractByName(xCtx, msgRegister.Name))
+
+			// and other addresses can not release
+			msgRelease := &types.MsgReleaseContractName{
+				Sender: otherAddr.String(),
+				Name:   msgRegister.Name,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgRelease)(xCtx, msgRelease)
+			require.Error(t, err)
+
+			// and the sender can release
+			msgRelease.Sender = spec.addr
+			_, err = wasmApp.MsgServiceRouter().Handler(msgRelease)(xCtx, msgRelease)
+			require.NoError(t, err)
+			assert.Nil(t, wasmApp.WasmKeeper.GetContractByName(xCtx, msgRegister.Name))
+		})
+	}
+}
+
+func TestDeleteContract(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"admin can delete": {
+			addr: myAddress.String(),
+		},
+		"authority can delete": {
+			addr: authority,
+		},
+		"other address cannot delete": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(xCtx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr := sdk.MustAccAddressFromBech32(storeAndInstantiateResponse.Address)
+
+			// when
+			msgDelete := &types.MsgDeleteContract{
+				Sender:         spec.addr,
+				Contract:       contractAddr.String(),
+				SweepRecipient: otherAddr.String(),
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgDelete)(xCtx, msgDelete)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.NotNil(t, wasmApp.WasmKeeper.GetContractInfo(xCtx, contractAddr))
+				return
+			}
+			require.NoError(t, err)
+			assert.Nil(t, wasmApp.WasmKeeper.GetContractInfo(xCtx, contractAddr))
+			assert.True(t, wasmApp.WasmKeeper.IsContractDeleted(xCtx, contractAddr))
+		})
+	}
+}
+
+func TestSetCodeGasMultiplier(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false)
+
+	var (
+		myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                = wasmApp.WasmKeeper.GetAuthority()
+	)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"authority can set multiplier": {
+			addr: authority,
+		},
+		"other address cannot set multiplier": {
+			addr:   myAddress.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			// setup
+			_, _, sender := testdata.KeyTestPubAddr()
+			msg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = wasmContract
+				m.Sender = sender.String()
+			})
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(xCtx, msg)
+			require.NoError(t, err)
+			var result types.MsgStoreCodeResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+
+			// when
+			msgSet := &types.MsgSetCodeGasMultiplier{
+				Authority:     spec.addr,
+				CodeID:        result.CodeID,
+				MultiplierBps: 5_000,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgSet)(xCtx, msgSet)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Nil(t, wasmApp.WasmKeeper.GetCodeGasMultiplier(xCtx, result.CodeID))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &types.CodeGasMultiplier{CodeID: result.CodeID, MultiplierBps: 5_000}, wasmApp.WasmKeeper.GetCodeGasMultiplier(xCtx, result.CodeID))
+		})
+	}
+}
+
+func TestSetCodeSchema(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false)
+
+	_, _, creator := testdata.KeyTestPubAddr()
+	_, _, otherAddr := testdata.KeyTestPubAddr()
+	schema, err := ioutils.GzipIt([]byte(`{"execute":{"type":"object","required":["reflect_msg"]}}`))
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"creator can set schema": {
+			addr: creator.String(),
+		},
+		"other address cannot set schema": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			// setup
+			msg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = wasmContract
+				m.Sender = creator.String()
+			})
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(xCtx, msg)
+			require.NoError(t, err)
+			var result types.MsgStoreCodeResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+
+			// when
+			msgSet := &types.MsgSetCodeSchema{
+				Sender:           spec.addr,
+				CodeID:           result.CodeID,
+				Schema:           schema,
+				ValidateMessages: true,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgSet)(xCtx, msgSet)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Nil(t, wasmApp.WasmKeeper.GetCodeSchema(xCtx, result.CodeID))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &types.CodeSchema{CodeID: result.CodeID, Schema: schema, ValidateMessages: true}, wasmApp.WasmKeeper.GetCodeSchema(xCtx, result.CodeID))
+		})
+	}
+}
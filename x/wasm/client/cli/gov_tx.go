@@ -47,12 +47,31 @@ func SubmitProposalCmd() *cobra.Command {
 		ProposalSudoContractCmd(),
 		ProposalUpdateContractAdminCmd(),
 		ProposalClearContractAdminCmd(),
+		ProposalProposeContractAdminCmd(),
+		ProposalCancelContractAdminTransferCmd(),
 		ProposalPinCodesCmd(),
 		ProposalUnpinCodesCmd(),
 		ProposalUpdateInstantiateConfigCmd(),
 		ProposalAddCodeUploadParamsAddresses(),
 		ProposalRemoveCodeUploadParamsAddresses(),
 		ProposalStoreAndMigrateContractCmd(),
+		ProposalFreezeContractCmd(),
+		ProposalUnfreezeContractCmd(),
+		ProposalDeprecateCodesCmd(),
+		ProposalUndeprecateCodesCmd(),
+		ProposalRegisterCronScheduleCmd(),
+		ProposalRemoveCronScheduleCmd(),
+		ProposalSubscribeEpochHookCmd(),
+		ProposalUnsubscribeEpochHookCmd(),
+		ProposalRegisterFeeShareCmd(),
+		ProposalCancelFeeShareCmd(),
+		ProposalSetFeeSponsorshipCmd(),
+		ProposalRemoveFeeSponsorshipCmd(),
+		ProposalRegisterContractNameCmd(),
+		ProposalTransferContractNameCmd(),
+		ProposalReleaseContractNameCmd(),
+		ProposalDeleteContractCmd(),
+		ProposalSetCodeGasMultiplierCmd(),
 	)
 	return cmd
 }
@@ -80,6 +99,12 @@ func ProposalStoreCodeCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if storeCodeMsg.Metadata, err = parseCodeMetadataFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			if err = storeCodeMsg.ValidateBasic(); err != nil {
+				return err
+			}
 
 			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&storeCodeMsg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
 			if err != nil {
@@ -91,6 +116,7 @@ func ProposalStoreCodeCmd() *cobra.Command {
 		SilenceUsage: true,
 	}
 	addInstantiatePermissionFlags(cmd)
+	addCodeMetadataFlags(cmd)
 
 	// proposal flags
 	addCommonProposalFlags(cmd)
@@ -603,6 +629,84 @@ func ProposalClearContractAdminCmd() *cobra.Command {
 	return cmd
 }
 
+func ProposalProposeContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-contract-admin [contract_addr_bech32] [new_admin_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to propose a new admin for a contract that takes over when it accepts",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg, err := parseProposeContractAdminArgs(args, authority)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalCancelContractAdminTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-contract-admin-transfer [contract_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to cancel the pending admin transfer of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg, err := parseCancelContractAdminTransferArgs(args, authority)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
 func ProposalPinCodesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pin-codes [code-ids] --title [text] --summary [text] --authority [address]",
@@ -991,3 +1095,754 @@ func ProposalStoreAndMigrateContractCmd() *cobra.Command {
 	addCommonProposalFlags(cmd)
 	return cmd
 }
+
+func ProposalFreezeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-contract [contract_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to freeze a contract so that it can not be executed, migrated or called via IBC",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg := types.MsgFreezeContract{
+				Authority: authority,
+				Contract:  args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalUnfreezeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-contract [contract_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to unfreeze a frozen contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg := types.MsgUnfreezeContract{
+				Authority: authority,
+				Contract:  args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalDeprecateCodesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprecate-codes [code-ids] --checksums [hex checksums] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to deprecate codes so that they can not be used to instantiate or migrate contracts",
+		Args:  cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			codeIDs, checksums, err := parseDeprecateCodesArgs(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgDeprecateCodes{
+				Authority: authority,
+				CodeIDs:   codeIDs,
+				Checksums: checksums,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringSlice(flagChecksums, []string{}, "Hex encoded code checksums")
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalUndeprecateCodesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undeprecate-codes [code-ids] --checksums [hex checksums] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to remove the deprecation of codes",
+		Args:  cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			codeIDs, checksums, err := parseDeprecateCodesArgs(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUndeprecateCodes{
+				Authority: authority,
+				CodeIDs:   codeIDs,
+				Checksums: checksums,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringSlice(flagChecksums, []string{}, "Hex encoded code checksums")
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func parseDeprecateCodesArgs(flags *flag.FlagSet, args []string) ([]uint64, [][]byte, error) {
+	codeIDs, err := parsePinCodesArgs(args)
+	if err != nil {
+		return nil, nil, err
+	}
+	hexChecksums, err := flags.GetStringSlice(flagChecksums)
+	if err != nil {
+		return nil, nil, fmt.Errorf("checksums: %s", err)
+	}
+	checksums := make([][]byte, len(hexChecksums))
+	for i, c := range hexChecksums {
+		checksums[i], err = hex.DecodeString(c)
+		if err != nil {
+			return nil, nil, fmt.Errorf("checksum %d: %s", i, err)
+		}
+	}
+	return codeIDs, checksums, nil
+}
+
+func ProposalRegisterCronScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-cron-schedule [name] [contract_addr_bech32] [json_encoded_sudo_args] --interval [blocks] --gas-limit [gas] --max-errors [count] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to register a contract sudo call that is executed periodically at the end of a block",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			interval, err := cmd.Flags().GetUint64(flagInterval)
+			if err != nil {
+				return fmt.Errorf("interval: %s", err)
+			}
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return fmt.Errorf("gas limit: %s", err)
+			}
+			maxErrors, err := cmd.Flags().GetUint64(flagMaxErrors)
+			if err != nil {
+				return fmt.Errorf("max errors: %s", err)
+			}
+
+			msg := types.MsgRegisterCronSchedule{
+				Authority: authority,
+				Name:      args[0],
+				Contract:  args[1],
+				Msg:       []byte(args[2]),
+				Interval:  interval,
+				GasLimit:  gasLimit,
+				MaxErrors: maxErrors,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Uint64(flagInterval, 1, "Number of blocks between two executions")
+	cmd.Flags().Uint64(flagGasLimit, 0, "Max gas that can be consumed by a single execution")
+	cmd.Flags().Uint64(flagMaxErrors, 0, "Number of consecutive failed executions after which the schedule is disabled. Zero never disables it")
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalRemoveCronScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-cron-schedule [name] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to remove a cron schedule",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg := types.MsgRemoveCronSchedule{
+				Authority: authority,
+				Name:      args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalSubscribeEpochHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe-epoch-hook [contract_addr_bech32] [epoch_identifier] --gas-limit [gas] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to subscribe a contract to be called via sudo when an epoch ends",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg, err := parseSubscribeEpochHookArgs(cmd, args, authority)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Uint64(flagGasLimit, 0, "Max gas that can be consumed by a single hook call")
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalUnsubscribeEpochHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unsubscribe-epoch-hook [contract_addr_bech32] [epoch_identifier] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to remove a contract subscription to an epoch end",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg, err := parseUnsubscribeEpochHookArgs(args, authority)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalRegisterFeeShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-fee-share [contract_addr_bech32] [withdraw_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to register the address that receives a share of the fees of txs calling the contract",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg, err := parseRegisterFeeShareArgs(args, authority)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalCancelFeeShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-fee-share [contract_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to remove the fee share registration of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg, err := parseCancelFeeShareArgs(args, authority)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalSetFeeSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-sponsorship [contract_addr_bech32] --per-block-limit [coins] --per-sender-limit [coins] --approval-gas-limit [gas] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to let a contract pay the fees of txs that only execute the contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg, err := parseSetFeeSponsorshipArgs(cmd, args, authority)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	addFeeSponsorshipFlags(cmd)
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalRemoveFeeSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-fee-sponsorship [contract_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to stop a contract from paying the fees of txs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg, err := parseRemoveFeeSponsorshipArgs(args, authority)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalRegisterContractNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-contract-name [contract_addr_bech32] [name] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to claim a unique name for a contract",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg, err := parseRegisterContractNameArgs(args, authority)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalTransferContractNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-contract-name [name] [new_contract_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to move a claimed name to another contract",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg, err := parseTransferContractNameArgs(args, authority)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalReleaseContractNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-contract-name [name] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to release a claimed contract name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg, err := parseReleaseContractNameArgs(args, authority)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalDeleteContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-contract [contract_addr_bech32] [sweep_recipient_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to delete a contract with its storage",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg, err := parseDeleteContractArgs(args, authority)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalSetCodeGasMultiplierCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-code-gas-multiplier [code_id] [multiplier_bps] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to set a gas discount or surcharge for the contracts of a code",
+		Long: "Submit a proposal to set a gas discount or surcharge for the contracts of a code. The multiplier is in basis points " +
+			"so that 10000 is the regular gas price. A zero multiplier removes it.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("code id: %s", err)
+			}
+			multiplierBps, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("multiplier bps: %s", err)
+			}
+			msg := types.MsgSetCodeGasMultiplier{
+				Authority:     authority,
+				CodeID:        codeID,
+				MultiplierBps: uint32(multiplierBps),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
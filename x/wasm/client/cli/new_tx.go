@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+	"os"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -10,7 +12,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
@@ -119,6 +123,331 @@ func ClearContractAdminCmd() *cobra.Command {
 	return cmd
 }
 
+// ProposeContractAdminCmd proposes a new admin for a contract that must accept it
+func ProposeContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-contract-admin [contract_addr_bech32] [new_admin_addr_bech32]",
+		Short: "Propose a new admin for a contract that takes over when it accepts",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseProposeContractAdminArgs(args, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseProposeContractAdminArgs(args []string, sender string) (types.MsgProposeContractAdmin, error) {
+	msg := types.MsgProposeContractAdmin{
+		Sender:   sender,
+		Contract: args[0],
+		NewAdmin: args[1],
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// AcceptContractAdminCmd accepts a proposed admin transfer of a contract
+func AcceptContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-contract-admin [contract_addr_bech32]",
+		Short: "Accept the proposed admin transfer of a contract and become its admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAcceptContractAdmin{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelContractAdminTransferCmd removes a pending admin transfer of a contract
+func CancelContractAdminTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-contract-admin-transfer [contract_addr_bech32]",
+		Short: "Cancel the pending admin transfer of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseCancelContractAdminTransferArgs(args, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseCancelContractAdminTransferArgs(args []string, sender string) (types.MsgCancelContractAdminTransfer, error) {
+	msg := types.MsgCancelContractAdminTransfer{
+		Sender:   sender,
+		Contract: args[0],
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// SubscribeEpochHookCmd subscribes a contract to the end of an x/epochs epoch
+func SubscribeEpochHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe-epoch-hook [contract_addr_bech32] [epoch_identifier] --gas-limit [gas]",
+		Short: "Subscribe a contract to be called via sudo when an epoch ends",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseSubscribeEpochHookArgs(cmd, args, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Uint64(flagGasLimit, 0, "Max gas that can be consumed by a single hook call")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseSubscribeEpochHookArgs(cmd *cobra.Command, args []string, sender string) (types.MsgSubscribeEpochHook, error) {
+	gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+	if err != nil {
+		return types.MsgSubscribeEpochHook{}, errorsmod.Wrap(err, "gas limit")
+	}
+	msg := types.MsgSubscribeEpochHook{
+		Sender:          sender,
+		Contract:        args[0],
+		EpochIdentifier: args[1],
+		GasLimit:        gasLimit,
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// UnsubscribeEpochHookCmd removes a contract subscription to an x/epochs epoch
+func UnsubscribeEpochHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unsubscribe-epoch-hook [contract_addr_bech32] [epoch_identifier]",
+		Short: "Remove a contract subscription to an epoch end",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseUnsubscribeEpochHookArgs(args, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseUnsubscribeEpochHookArgs(args []string, sender string) (types.MsgUnsubscribeEpochHook, error) {
+	msg := types.MsgUnsubscribeEpochHook{
+		Sender:          sender,
+		Contract:        args[0],
+		EpochIdentifier: args[1],
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// RegisterFeeShareCmd registers the withdraw address for the fee share of a contract
+func RegisterFeeShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-fee-share [contract_addr_bech32] [withdraw_addr_bech32]",
+		Short: "Register the address that receives a share of the fees of txs calling the contract",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseRegisterFeeShareArgs(args, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseRegisterFeeShareArgs(args []string, sender string) (types.MsgRegisterFeeShare, error) {
+	msg := types.MsgRegisterFeeShare{
+		Sender:          sender,
+		Contract:        args[0],
+		WithdrawAddress: args[1],
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// CancelFeeShareCmd removes the fee share registration of a contract
+func CancelFeeShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-fee-share [contract_addr_bech32]",
+		Short: "Remove the fee share registration of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseCancelFeeShareArgs(args, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseCancelFeeShareArgs(args []string, sender string) (types.MsgCancelFeeShare, error) {
+	msg := types.MsgCancelFeeShare{
+		Sender:   sender,
+		Contract: args[0],
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// SetFeeSponsorshipCmd opts a contract in to pay the fees of txs that only execute the contract
+func SetFeeSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-sponsorship [contract_addr_bech32] --per-block-limit [coins] --per-sender-limit [coins] --approval-gas-limit [gas]",
+		Short: "Let a contract pay the fees of txs that only execute the contract",
+		Long: `Let a contract pay the fees of txs that only execute the contract. The contract is set as fee granter
+of the tx and approves every tx via sudo with {"approve_fee_sponsorship":{"fee_payer":..,"fee":..,"msgs":..}}.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseSetFeeSponsorshipArgs(cmd, args, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	addFeeSponsorshipFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addFeeSponsorshipFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagPerBlockLimit, "", "Max total fee paid by the contract in a block")
+	cmd.Flags().String(flagPerSenderLimit, "", "Max fee paid by the contract for a single sender in a block")
+	cmd.Flags().Uint64(flagApprovalGasLimit, 0, "Max gas that can be consumed by the sudo approval call")
+}
+
+func parseSetFeeSponsorshipArgs(cmd *cobra.Command, args []string, sender string) (types.MsgSetFeeSponsorship, error) {
+	perBlockLimitStr, err := cmd.Flags().GetString(flagPerBlockLimit)
+	if err != nil {
+		return types.MsgSetFeeSponsorship{}, errorsmod.Wrap(err, "per block limit")
+	}
+	perBlockLimit, err := sdk.ParseCoinsNormalized(perBlockLimitStr)
+	if err != nil {
+		return types.MsgSetFeeSponsorship{}, errorsmod.Wrap(err, "per block limit")
+	}
+	perSenderLimitStr, err := cmd.Flags().GetString(flagPerSenderLimit)
+	if err != nil {
+		return types.MsgSetFeeSponsorship{}, errorsmod.Wrap(err, "per sender limit")
+	}
+	perSenderLimit, err := sdk.ParseCoinsNormalized(perSenderLimitStr)
+	if err != nil {
+		return types.MsgSetFeeSponsorship{}, errorsmod.Wrap(err, "per sender limit")
+	}
+	gasLimit, err := cmd.Flags().GetUint64(flagApprovalGasLimit)
+	if err != nil {
+		return types.MsgSetFeeSponsorship{}, errorsmod.Wrap(err, "approval gas limit")
+	}
+	msg := types.MsgSetFeeSponsorship{
+		Sender:           sender,
+		Contract:         args[0],
+		PerBlockLimit:    perBlockLimit,
+		PerSenderLimit:   perSenderLimit,
+		ApprovalGasLimit: gasLimit,
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// RemoveFeeSponsorshipCmd opts a contract out of paying the fees of txs
+func RemoveFeeSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-fee-sponsorship [contract_addr_bech32]",
+		Short: "Stop a contract from paying the fees of txs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseRemoveFeeSponsorshipArgs(args, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseRemoveFeeSponsorshipArgs(args []string, sender string) (types.MsgRemoveFeeSponsorship, error) {
+	msg := types.MsgRemoveFeeSponsorship{
+		Sender:   sender,
+		Contract: args[0],
+	}
+	return msg, msg.ValidateBasic()
+}
+
 // UpdateInstantiateConfigCmd updates instantiate config for a smart contract.
 func UpdateInstantiateConfigCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -185,3 +514,229 @@ func UpdateContractLabelCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// SetCodeMetadataCmd sets the build metadata of a code
+func SetCodeMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-code-metadata [code_id] --source-repository [url] --commit [revision] --builder-image [image] --optimizer-version [version] --schema-hash [hex]",
+		Short: "Set the build metadata of a code. Unset fields are removed.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			metadata, err := parseCodeMetadataFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetCodeMetadata{
+				Sender: clientCtx.GetFromAddress().String(),
+				CodeID: codeID,
+			}
+			if metadata != nil {
+				msg.Metadata = *metadata
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	addCodeMetadataFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RegisterContractNameCmd registers a unique name for a contract
+func RegisterContractNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-contract-name [contract_addr_bech32] [name]",
+		Short: "Claim a unique name for a contract. An existing name of the contract is released",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseRegisterContractNameArgs(args, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseRegisterContractNameArgs(args []string, sender string) (types.MsgRegisterContractName, error) {
+	msg := types.MsgRegisterContractName{
+		Sender:   sender,
+		Contract: args[0],
+		Name:     args[1],
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// TransferContractNameCmd moves a claimed name to another contract
+func TransferContractNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-contract-name [name] [new_contract_addr_bech32]",
+		Short: "Move a claimed name to another contract",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseTransferContractNameArgs(args, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseTransferContractNameArgs(args []string, sender string) (types.MsgTransferContractName, error) {
+	msg := types.MsgTransferContractName{
+		Sender:      sender,
+		Name:        args[0],
+		NewContract: args[1],
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// ReleaseContractNameCmd releases a claimed contract name
+func ReleaseContractNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-contract-name [name]",
+		Short: "Release a claimed contract name so that it can be registered again",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseReleaseContractNameArgs(args, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseReleaseContractNameArgs(args []string, sender string) (types.MsgReleaseContractName, error) {
+	msg := types.MsgReleaseContractName{
+		Sender: sender,
+		Name:   args[0],
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// DeleteContractCmd deletes a contract with its storage
+func DeleteContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-contract [contract_addr_bech32] [sweep_recipient_addr_bech32]",
+		Short: "Delete a contract with its storage and optionally sweep its balance to a recipient",
+		Long: `Delete a contract with its storage, contract info and registrations. The address can not be used again.
+The contract balance is sent to the optional sweep recipient.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseDeleteContractArgs(args, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseDeleteContractArgs(args []string, sender string) (types.MsgDeleteContract, error) {
+	msg := types.MsgDeleteContract{
+		Sender:   sender,
+		Contract: args[0],
+	}
+	if len(args) > 1 {
+		msg.SweepRecipient = args[1]
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// SetCodeSchemaCmd sets the JSON schema of the contract messages of a code
+func SetCodeSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-code-schema [code_id] [schema_file,optional] --validate-messages",
+		Short: "Set the JSON schema of the contract messages of a code. Without a schema file, the schema is removed.",
+		Long: "Set the JSON schema of the contract messages of a code as generated by cosmwasm-schema. The schema file " +
+			"is compressed before it is sent. With --validate-messages, instantiate and execute messages are validated " +
+			"against the schema before the contract is called. Without a schema file, the schema is removed.",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			validateMessages, err := cmd.Flags().GetBool(flagValidateMessages)
+			if err != nil {
+				return fmt.Errorf("validate messages: %s", err)
+			}
+
+			msg := types.MsgSetCodeSchema{
+				Sender:           clientCtx.GetFromAddress().String(),
+				CodeID:           codeID,
+				ValidateMessages: validateMessages,
+			}
+			if len(args) == 2 {
+				schema, err := os.ReadFile(args[1])
+				if err != nil {
+					return err
+				}
+				if !ioutils.IsGzip(schema) {
+					if schema, err = ioutils.GzipIt(schema); err != nil {
+						return err
+					}
+				}
+				msg.Schema = schema
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Bool(flagValidateMessages, false, "Validate instantiate and execute messages against the schema before the contract is called")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/hex"
@@ -11,13 +12,20 @@ import (
 	"strconv"
 
 	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	abci "github.com/cometbft/cometbft/abci/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -34,8 +42,11 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdListCode(),
 		GetCmdListContractByCode(),
+		GetCmdListCodeByChecksum(),
+		GetCmdListContractByChecksum(),
 		GetCmdQueryCode(),
 		GetCmdQueryCodeInfo(),
+		GetCmdVerifyCode(),
 		GetCmdGetContractInfo(),
 		GetCmdGetContractHistory(),
 		GetCmdGetContractState(),
@@ -44,6 +55,22 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdBuildAddress(),
 		GetCmdListContractsByCreator(),
+		GetCmdListContractsByAdmin(),
+		GetCmdListLargestContracts(),
+		GetCmdTraceExecute(),
+		GetCmdSimulateExecute(),
+		GetCmdListCronSchedules(),
+		GetCmdQueryCronSchedule(),
+		GetCmdListEpochHookSubscriptions(),
+		GetCmdQueryFeeShare(),
+		GetCmdListFeeShares(),
+		GetCmdQueryFeeSponsorship(),
+		GetCmdListFeeSponsorships(),
+		GetCmdQueryContractByName(),
+		GetCmdListContractNames(),
+		GetCmdQueryCodeGasMultiplier(),
+		GetCmdListCodeGasMultipliers(),
+		GetCmdQueryCodeSchema(),
 	)
 	return queryCmd
 }
@@ -186,6 +213,86 @@ func GetCmdListContractByCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListCodeByChecksum lists all code ids stored with the given checksum
+func GetCmdListCodeByChecksum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-code-by-checksum [checksum]",
+		Short:   "List all code ids on the chain for given hex encoded checksum",
+		Long:    "List all code ids on the chain for given hex encoded checksum",
+		Aliases: []string{"list-codes-by-checksum"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := hex.DecodeString(args[0]); err != nil {
+				return fmt.Errorf("checksum: %s", err)
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodesByChecksum(
+				context.Background(),
+				&types.QueryCodesByChecksumRequest{
+					Checksum:   args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list codes by checksum")
+	return cmd
+}
+
+// GetCmdListContractByChecksum lists all contracts running a code with the given checksum
+func GetCmdListContractByChecksum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-contract-by-checksum [checksum]",
+		Short:   "List all contracts on the chain running a code with given hex encoded checksum",
+		Long:    "List all contracts on the chain running a code with given hex encoded checksum, across all code ids with the same checksum",
+		Aliases: []string{"list-contracts-by-checksum"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := hex.DecodeString(args[0]); err != nil {
+				return fmt.Errorf("checksum: %s", err)
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByChecksum(
+				context.Background(),
+				&types.QueryContractsByChecksumRequest{
+					Checksum:   args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list contracts by checksum")
+	return cmd
+}
+
 // GetCmdQueryCode returns the bytecode for a given contract
 func GetCmdQueryCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -265,6 +372,68 @@ func GetCmdQueryCodeInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdVerifyCode compares the checksum of a local wasm build with the on-chain code
+func GetCmdVerifyCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-code [code_id] [wasm file]",
+		Short: "Verifies that a local wasm build matches the on-chain code",
+		Long:  "Verifies that the checksum of a local wasm build, raw or gzipped, matches the checksum of the on-chain code",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			wasm, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodeInfo(
+				context.Background(),
+				&types.QueryCodeInfoRequest{
+					CodeId: codeID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			if err := verifyWasmChecksum(wasm, res.Checksum); err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("code id %d verified: checksum %s\n", codeID, hex.EncodeToString(res.Checksum)))
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// verifyWasmChecksum returns an error when the checksum of the raw or gzipped wasm code does not match
+func verifyWasmChecksum(wasm []byte, expChecksum []byte) error {
+	if ioutils.IsGzip(wasm) {
+		var err error
+		wasm, err = ioutils.Uncompress(wasm, int64(types.MaxWasmSize))
+		if err != nil {
+			return fmt.Errorf("uncompress wasm archive: %w", err)
+		}
+	}
+	checksum, err := wasmvm.CreateChecksum(wasm)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(checksum, expChecksum) {
+		return fmt.Errorf("checksum mismatch: local %s, on-chain %s", checksum, hex.EncodeToString(expChecksum))
+	}
+	return nil
+}
+
 // GetCmdGetContractInfo gets details about a given contract
 func GetCmdGetContractInfo() *cobra.Command {
 	cmd := &cobra.Command{
@@ -315,7 +484,12 @@ func GetCmdGetContractState() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdGetContractStateAll(),
 		GetCmdGetContractStateRaw(),
+		GetCmdGetContractStateRawProof(),
+		GetCmdGetContractStateRange(),
+		GetCmdGetContractStatePrefix(),
+		GetCmdGetContractStateDecode(),
 		GetCmdGetContractStateSmart(),
+		GetCmdGetContractStateSmartBatch(),
 	)
 	return cmd
 }
@@ -403,63 +577,102 @@ func GetCmdGetContractStateRaw() *cobra.Command {
 	return cmd
 }
 
-func GetCmdGetContractStateSmart() *cobra.Command {
-	decoder := newArgDecoder(asciiDecodeString)
+func GetCmdGetContractStateRawProof() *cobra.Command {
+	decoder := newArgDecoder(hex.DecodeString)
 	cmd := &cobra.Command{
-		Use:   "smart [bech32_address] [query]",
-		Short: "Calls contract with given address with query data and prints the returned result",
-		Long:  "Calls contract with given address with query data and prints the returned result",
-		Args:  cobra.ExactArgs(2),
+		Use:   "raw-proof [bech32_address] [key]",
+		Short: "Prints out internal state for key of a contract with its merkle proof against the app hash",
+		Long: `Prints out internal state for key of a contract given its address with the ICS23 merkle proof.
+The proof is the base64 encoded ibc.core.commitment.v1.MerkleProof of the wasm store path and verifies
+the value, or the absence of the key when the value is empty, against the app hash in the header of the
+block following the returned height. When a trusted --app-hash is given, the proof is verified locally.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			_, err = sdk.AccAddressFromBech32(args[0])
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
-			if args[1] == "" {
-				return errors.New("query data must not be empty")
+			key, err := decoder.DecodeString(args[1])
+			if err != nil {
+				return err
 			}
-
-			queryData, err := decoder.DecodeString(args[1])
+			appHashStr, err := cmd.Flags().GetString(flagAppHash)
 			if err != nil {
-				return fmt.Errorf("decode query: %s", err)
+				return err
 			}
-			if !json.Valid(queryData) {
-				return errors.New("query data must be json")
+			appHash, err := hex.DecodeString(appHashStr)
+			if err != nil {
+				return fmt.Errorf("app hash: %s", err)
 			}
 
-			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.SmartContractState(
-				context.Background(),
-				&types.QuerySmartContractStateRequest{
-					Address:   args[0],
-					QueryData: queryData,
-				},
-			)
+			storeKey := types.GetRawContractStateStoreKey(contractAddr, key)
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{
+				Path:   fmt.Sprintf("/store/%s/key", types.StoreKey),
+				Data:   storeKey,
+				Height: clientCtx.Height,
+				Prove:  true,
+			})
 			if err != nil {
 				return err
 			}
-			return clientCtx.PrintProto(res)
+			proof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+			if err != nil {
+				return err
+			}
+			proofBz, err := proof.Marshal()
+			if err != nil {
+				return err
+			}
+			out := rawContractStateProofOutput{
+				Height:   res.Height,
+				Key:      hex.EncodeToString(key),
+				StoreKey: hex.EncodeToString(storeKey),
+				Value:    res.Value,
+				Proof:    proofBz,
+			}
+			if len(appHash) != 0 {
+				if err := types.VerifyRawContractStateProof(appHash, contractAddr, key, res.Value, proof); err != nil {
+					return fmt.Errorf("verify proof: %w", err)
+				}
+				out.Verified = true
+			}
+			bz, err := json.MarshalIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(bz)
 		},
 		SilenceUsage: true,
 	}
-	decoder.RegisterFlags(cmd.PersistentFlags(), "query argument")
+	cmd.Flags().String(flagAppHash, "", "Hex encoded trusted app hash of the block following the queried height to verify the proof against")
+	decoder.RegisterFlags(cmd.PersistentFlags(), "key argument")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
-// GetCmdGetContractHistory prints the code history for a given contract
-func GetCmdGetContractHistory() *cobra.Command {
+// rawContractStateProofOutput is the printed result of the contract-state raw-proof command
+type rawContractStateProofOutput struct {
+	Height   int64  `json:"height"`
+	Key      string `json:"key"`
+	StoreKey string `json:"store_key"`
+	Value    []byte `json:"value"`
+	Proof    []byte `json:"proof"`
+	Verified bool   `json:"verified,omitempty"`
+}
+
+func GetCmdGetContractStateRange() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "contract-history [bech32_address]",
-		Short:   "Prints out the code history for a contract given its address",
-		Long:    "Prints out the code history for a contract given its address",
-		Aliases: []string{"history", "hist", "ch"},
-		Args:    cobra.ExactArgs(1),
+		Use:   "range [bech32_address]",
+		Short: "Prints out internal state for a key range of a contract given its address",
+		Long: `Prints out internal state for a key range of a contract given its address.
+The start bound is inclusive and the end bound exclusive, both are optional. To fetch the next page,
+pass the returned next_key as --start or as --end when --reverse is set.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -470,113 +683,1024 @@ func GetCmdGetContractHistory() *cobra.Command {
 			if err != nil {
 				return err
 			}
-
-			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			encoding, err := cmd.Flags().GetString(flagKeyEncoding)
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.ContractHistory(
-				context.Background(),
-				&types.QueryContractHistoryRequest{
-					Address:    args[0],
-					Pagination: pageReq,
-				},
-			)
+			var bounds [2][]byte
+			for i, f := range []string{flagStart, flagEnd} {
+				s, err := cmd.Flags().GetString(f)
+				if err != nil {
+					return err
+				}
+				if bounds[i], err = decodeStateKey(s, encoding); err != nil {
+					return fmt.Errorf("%s: %s", f, err)
+				}
+			}
+			limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
 			if err != nil {
 				return err
 			}
-
-			return clientCtx.PrintProto(res)
+			reverse, err := cmd.Flags().GetBool(flags.FlagReverse)
+			if err != nil {
+				return err
+			}
+			return queryContractStateRange(clientCtx, &types.QueryRawContractStateRangeRequest{
+				Address: args[0],
+				Start:   bounds[0],
+				End:     bounds[1],
+				Limit:   limit,
+				Reverse: reverse,
+			}, encoding)
 		},
 		SilenceUsage: true,
 	}
-
+	cmd.Flags().String(flagStart, "", "Inclusive start key of the range")
+	cmd.Flags().String(flagEnd, "", "Exclusive end key of the range")
+	addStateRangeFlags(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
-	addPaginationFlags(cmd, "contract history")
 	return cmd
 }
 
-// GetCmdListPinnedCode lists all wasm code ids that are pinned
-func GetCmdListPinnedCode() *cobra.Command {
+func GetCmdGetContractStatePrefix() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "pinned",
-		Short: "List all pinned code ids",
-		Long:  "List all pinned code ids",
-		Args:  cobra.ExactArgs(0),
+		Use:   "prefix [bech32_address] [prefix]",
+		Short: "Prints out internal state for all keys with the given prefix of a contract given its address",
+		Long: `Prints out internal state for all keys with the given prefix of a contract given its address.
+To fetch the next page, pass the returned next_key as --page-key.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			_, err = sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
-			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.PinnedCodes(
-				context.Background(),
-				&types.QueryPinnedCodesRequest{
-					Pagination: pageReq,
-				},
-			)
+			encoding, err := cmd.Flags().GetString(flagKeyEncoding)
 			if err != nil {
 				return err
 			}
-			return clientCtx.PrintProto(res)
+			keyPrefix, err := decodeStateKey(args[1], encoding)
+			if err != nil {
+				return fmt.Errorf("prefix: %s", err)
+			}
+			if len(keyPrefix) == 0 {
+				return errors.New("empty prefix")
+			}
+			pageKeyStr, err := cmd.Flags().GetString(flags.FlagPageKey)
+			if err != nil {
+				return err
+			}
+			pageKey, err := decodeStateKey(pageKeyStr, encoding)
+			if err != nil {
+				return fmt.Errorf("page key: %s", err)
+			}
+			limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+			reverse, err := cmd.Flags().GetBool(flags.FlagReverse)
+			if err != nil {
+				return err
+			}
+			start, end := keyPrefix, storetypes.PrefixEndBytes(keyPrefix)
+			switch {
+			case len(pageKey) != 0 && reverse:
+				end = pageKey
+			case len(pageKey) != 0:
+				start = pageKey
+			}
+			return queryContractStateRange(clientCtx, &types.QueryRawContractStateRangeRequest{
+				Address: args[0],
+				Start:   start,
+				End:     end,
+				Limit:   limit,
+				Reverse: reverse,
+			}, encoding)
 		},
 		SilenceUsage: true,
 	}
+	cmd.Flags().String(flags.FlagPageKey, "", "Next key of the previous page to continue from")
+	addStateRangeFlags(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
-	addPaginationFlags(cmd, "list codes")
 	return cmd
 }
 
-// GetCmdListContractsByCreator lists all contracts by creator
-func GetCmdListContractsByCreator() *cobra.Command {
+func addStateRangeFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagKeyEncoding, keyEncodingHex, "Encoding of the key arguments and the printed keys: hex, utf8 or base64")
+	cmd.Flags().Uint32(flags.FlagLimit, 0, "Maximum number of entries to return, capped by the server")
+	cmd.Flags().Bool(flags.FlagReverse, false, "Return the entries in descending key order")
+}
+
+const (
+	keyEncodingHex    = "hex"
+	keyEncodingUTF8   = "utf8"
+	keyEncodingBase64 = "base64"
+)
+
+// decodeStateKey decodes a raw contract state key argument with the given encoding
+func decodeStateKey(s, encoding string) ([]byte, error) {
+	switch encoding {
+	case keyEncodingHex:
+		return hex.DecodeString(s)
+	case keyEncodingUTF8:
+		return []byte(s), nil
+	case keyEncodingBase64:
+		return base64.StdEncoding.DecodeString(s)
+	default:
+		return nil, fmt.Errorf("unsupported key encoding: %q", encoding)
+	}
+}
+
+// encodeStateKey encodes a raw contract state key for output with the given encoding
+func encodeStateKey(key []byte, encoding string) (string, error) {
+	switch encoding {
+	case keyEncodingHex:
+		return hex.EncodeToString(key), nil
+	case keyEncodingUTF8:
+		return string(key), nil
+	case keyEncodingBase64:
+		return base64.StdEncoding.EncodeToString(key), nil
+	default:
+		return "", fmt.Errorf("unsupported key encoding: %q", encoding)
+	}
+}
+
+// stateRangeOutput is the printed result of a raw contract state range query with encoded keys
+type stateRangeOutput struct {
+	Models  []stateRangeModel `json:"models"`
+	NextKey string            `json:"next_key,omitempty"`
+}
+
+type stateRangeModel struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+func queryContractStateRange(clientCtx client.Context, req *types.QueryRawContractStateRangeRequest, encoding string) error {
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.RawContractStateRange(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	out := stateRangeOutput{Models: make([]stateRangeModel, len(res.Models))}
+	for i, m := range res.Models {
+		key, err := encodeStateKey(m.Key, encoding)
+		if err != nil {
+			return err
+		}
+		out.Models[i] = stateRangeModel{Key: key, Value: m.Value}
+	}
+	if len(res.NextKey) != 0 {
+		if out.NextKey, err = encodeStateKey(res.NextKey, encoding); err != nil {
+			return err
+		}
+	}
+	bz, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintRaw(bz)
+}
+
+func GetCmdGetContractStateDecode() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "list-contracts-by-creator [creator]",
-		Short: "List all contracts by creator",
-		Long:  "List all contracts by creator",
-		Args:  cobra.ExactArgs(1),
+		Use:   "decode [bech32_address]",
+		Short: "Prints out all internal state of a contract with keys decoded by cw-storage-plus conventions",
+		Long: `Prints out all internal state of a contract given its address. The raw keys are decoded by the
+cw-storage-plus namespace and length prefix conventions into "namespace/key-parts" form, non printable key
+parts are hex encoded with a 0x prefix. JSON values are pretty printed, all other values are base64 encoded.
+Decoding is best effort as raw keys carry no type information.`,
+		Example: fmt.Sprintf("$ %s query wasm contract-state decode [bech32_address] --namespace balances --export-file state.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
+
 			_, err = sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
-			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			namespaces, err := cmd.Flags().GetStringSlice(flagNamespace)
+			if err != nil {
+				return err
+			}
+			exportFile, err := cmd.Flags().GetString(flagExportFile)
 			if err != nil {
 				return err
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.ContractsByCreator(
-				context.Background(),
-				&types.QueryContractsByCreatorRequest{
-					CreatorAddress: args[0],
-					Pagination:     pageReq,
-				},
-			)
+			out := decodedState{Address: args[0], Entries: []decodedStateEntry{}}
+			var pageKey []byte
+			for {
+				res, err := queryClient.AllContractState(
+					context.Background(),
+					&types.QueryAllContractStateRequest{
+						Address:    args[0],
+						Pagination: &query.PageRequest{Key: pageKey},
+					},
+				)
+				if err != nil {
+					return err
+				}
+				for _, m := range res.Models {
+					out.Entries = append(out.Entries, decodeStateModel(m))
+				}
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageKey = res.Pagination.NextKey
+			}
+			out.Entries = filterByNamespace(out.Entries, namespaces)
+
+			bz, err := json.MarshalIndent(out, "", "  ")
 			if err != nil {
 				return err
 			}
-			return clientCtx.PrintProto(res)
+			if exportFile == "" {
+				return clientCtx.PrintRaw(bz)
+			}
+			if err := os.WriteFile(exportFile, bz, 0o600); err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("exported %d entries to %s\n", len(out.Entries), exportFile))
 		},
 		SilenceUsage: true,
 	}
+	cmd.Flags().StringSlice(flagNamespace, []string{}, "Only include entries within the given namespaces")
+	cmd.Flags().String(flagExportFile, "", "Write the decoded state as JSON to the given file instead of printing it")
 	flags.AddQueryFlagsToCmd(cmd)
-	addPaginationFlags(cmd, "list contracts by creator")
 	return cmd
 }
 
-type argumentDecoder struct {
-	// dec is the default decoder
-	dec                func(string) ([]byte, error)
+func GetCmdGetContractStateSmart() *cobra.Command {
+	decoder := newArgDecoder(asciiDecodeString)
+	cmd := &cobra.Command{
+		Use:   "smart [bech32_address] [query]",
+		Short: "Calls contract with given address with query data and prints the returned result",
+		Long:  "Calls contract with given address with query data and prints the returned result",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			if args[1] == "" {
+				return errors.New("query data must not be empty")
+			}
+
+			queryData, err := decoder.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("decode query: %s", err)
+			}
+			if !json.Valid(queryData) {
+				return errors.New("query data must be json")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SmartContractState(
+				context.Background(),
+				&types.QuerySmartContractStateRequest{
+					Address:   args[0],
+					QueryData: queryData,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	decoder.RegisterFlags(cmd.PersistentFlags(), "query argument")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdTraceExecute runs a contract execution without committing state changes and prints the call tree
+func GetCmdTraceExecute() *cobra.Command {
+	decoder := newArgDecoder(asciiDecodeString)
+	cmd := &cobra.Command{
+		Use:   "trace-execute [bech32_address] [json_encoded_send_args] --sender [address] --amount [coins,optional]",
+		Short: "Traces a contract execution and prints the tree of calls, submessages, replies and queries",
+		Long: `Runs the execute message against the current state without committing any changes and prints
+the tree of contract entry point calls, submessages, replies and queries with gas used and events emitted on each node`,
+		Aliases: []string{"trace"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, execData, amount, err := parseExecuteQueryArgs(cmd, decoder, args)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TraceExecute(
+				context.Background(),
+				&types.QueryTraceExecuteRequest{
+					Sender:   sender,
+					Contract: args[0],
+					Msg:      execData,
+					Funds:    amount,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagSender, "", "The address that sends the execute message")
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
+	decoder.RegisterFlags(cmd.PersistentFlags(), "execute argument")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSimulateExecute runs a contract execution without committing state changes and prints the result
+// with the contract storage changes
+func GetCmdSimulateExecute() *cobra.Command {
+	decoder := newArgDecoder(asciiDecodeString)
+	cmd := &cobra.Command{
+		Use:   "simulate-execute [bech32_address] [json_encoded_send_args] --sender [address] --amount [coins,optional]",
+		Short: "Simulates a contract execution and prints the result with the contract storage changes",
+		Long: `Runs the execute message against the current state without committing any changes and prints
+the response data, events, gas used and the contract storage entries written or deleted. The sender does not need to
+be an existing account and the amount is credited to it before the execution`,
+		Aliases: []string{"simulate"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, execData, amount, err := parseExecuteQueryArgs(cmd, decoder, args)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateExecute(
+				context.Background(),
+				&types.QuerySimulateExecuteRequest{
+					Sender:   sender,
+					Contract: args[0],
+					Msg:      execData,
+					Funds:    amount,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagSender, "", "The address that sends the execute message")
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
+	decoder.RegisterFlags(cmd.PersistentFlags(), "execute argument")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseExecuteQueryArgs returns the sender, execute msg and funds for queries that run a contract execution
+func parseExecuteQueryArgs(cmd *cobra.Command, decoder *argumentDecoder, args []string) (string, []byte, sdk.Coins, error) {
+	if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+		return "", nil, nil, err
+	}
+	if args[1] == "" {
+		return "", nil, nil, errors.New("execute data must not be empty")
+	}
+	execData, err := decoder.DecodeString(args[1])
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("decode execute msg: %s", err)
+	}
+	if !json.Valid(execData) {
+		return "", nil, nil, errors.New("execute data must be json")
+	}
+
+	sender, err := cmd.Flags().GetString(flagSender)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("sender: %s", err)
+	}
+	amountStr, err := cmd.Flags().GetString(flagAmount)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("amount: %s", err)
+	}
+	amount, err := sdk.ParseCoinsNormalized(amountStr)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("amount: %s", err)
+	}
+	return sender, execData, amount, nil
+}
+
+func GetCmdGetContractStateSmartBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "smart-batch [json_queries_or_file]",
+		Short: "Calls multiple contracts with query data at the same height and prints a result or error per query",
+		Long: `Calls multiple contracts with query data at the same height and prints a result or error per query.
+All queries share a single gas budget. The queries are a JSON array of contract address and query objects,
+either given inline or as path to a JSON file.`,
+		Example: fmt.Sprintf(`$ %s query wasm contract-state smart-batch '[{"address":"[bech32_address]","query":{"config":{}}}]'`, version.AppName),
+		Aliases: []string{"batch"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queries, err := parseBatchSmartQueries(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BatchSmartContractState(
+				context.Background(),
+				&types.QueryBatchSmartContractStateRequest{Queries: queries},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseBatchSmartQueries parses the batch smart queries from inline JSON or a JSON file
+func parseBatchSmartQueries(arg string) ([]types.BatchSmartQuery, error) {
+	bz := []byte(arg)
+	if !json.Valid(bz) {
+		var err error
+		if bz, err = os.ReadFile(arg); err != nil {
+			return nil, fmt.Errorf("queries must be json or a json file: %s", err)
+		}
+	}
+	var src []struct {
+		Address string          `json:"address"`
+		Query   json.RawMessage `json:"query"`
+	}
+	if err := json.Unmarshal(bz, &src); err != nil {
+		return nil, fmt.Errorf("queries: %s", err)
+	}
+	if len(src) == 0 {
+		return nil, errors.New("queries must not be empty")
+	}
+	r := make([]types.BatchSmartQuery, len(src))
+	for i, q := range src {
+		if _, err := sdk.AccAddressFromBech32(q.Address); err != nil {
+			return nil, fmt.Errorf("query %d: address: %s", i, err)
+		}
+		if len(q.Query) == 0 {
+			return nil, fmt.Errorf("query %d: query data must not be empty", i)
+		}
+		r[i] = types.BatchSmartQuery{Address: q.Address, QueryData: types.RawContractMessage(q.Query)}
+	}
+	return r, nil
+}
+
+// GetCmdGetContractHistory prints the code history for a given contract
+func GetCmdGetContractHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-history [bech32_address]",
+		Short:   "Prints out the code history for a contract given its address",
+		Long:    "Prints out the code history for a contract given its address",
+		Aliases: []string{"history", "hist", "ch"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractHistory(
+				context.Background(),
+				&types.QueryContractHistoryRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "contract history")
+	return cmd
+}
+
+// GetCmdListPinnedCode lists all wasm code ids that are pinned
+func GetCmdListPinnedCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pinned",
+		Short: "List all pinned code ids",
+		Long:  "List all pinned code ids",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PinnedCodes(
+				context.Background(),
+				&types.QueryPinnedCodesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list codes")
+	return cmd
+}
+
+// GetCmdListCronSchedules lists all cron schedules
+func GetCmdListCronSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cron-schedules",
+		Short: "List all contract sudo calls registered by governance to run at the end of a block",
+		Long:  "List all contract sudo calls registered by governance to run at the end of a block",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CronSchedules(
+				context.Background(),
+				&types.QueryCronSchedulesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list cron schedules")
+	return cmd
+}
+
+// GetCmdQueryCronSchedule gets a cron schedule by name
+func GetCmdQueryCronSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cron-schedule [name]",
+		Short: "Prints out a cron schedule with its execution state",
+		Long:  "Prints out a cron schedule with its execution state",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CronSchedule(
+				context.Background(),
+				&types.QueryCronScheduleRequest{
+					Name: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListEpochHookSubscriptions lists the contract subscriptions to epoch ends
+func GetCmdListEpochHookSubscriptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-hooks [epoch_identifier]",
+		Short: "List all contract subscriptions to epoch ends, optionally filtered by epoch identifier",
+		Long:  "List all contract subscriptions to epoch ends, optionally filtered by epoch identifier",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			var epochIdentifier string
+			if len(args) != 0 {
+				epochIdentifier = args[0]
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EpochHookSubscriptions(
+				context.Background(),
+				&types.QueryEpochHookSubscriptionsRequest{
+					EpochIdentifier: epochIdentifier,
+					Pagination:      pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list epoch hook subscriptions")
+	return cmd
+}
+
+// GetCmdQueryFeeShare gets the fee share registration of a contract
+func GetCmdQueryFeeShare() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-share [bech32_address]",
+		Short: "Prints out the fee share registration of a contract",
+		Long:  "Prints out the fee share registration of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeeShare(
+				context.Background(),
+				&types.QueryFeeShareRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListFeeShares lists all contract fee share registrations
+func GetCmdListFeeShares() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-shares",
+		Short: "List all contract fee share registrations",
+		Long:  "List all contract fee share registrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeeShares(
+				context.Background(),
+				&types.QueryFeeSharesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list fee shares")
+	return cmd
+}
+
+// GetCmdQueryFeeSponsorship gets the fee sponsorship of a contract
+func GetCmdQueryFeeSponsorship() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-sponsorship [bech32_address]",
+		Short: "Prints out the fee sponsorship of a contract",
+		Long:  "Prints out the fee sponsorship of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeeSponsorship(
+				context.Background(),
+				&types.QueryFeeSponsorshipRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListFeeSponsorships lists all contract fee sponsorships
+func GetCmdListFeeSponsorships() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-sponsorships",
+		Short: "List all contract fee sponsorships",
+		Long:  "List all contract fee sponsorships",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeeSponsorships(
+				context.Background(),
+				&types.QueryFeeSponsorshipsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list fee sponsorships")
+	return cmd
+}
+
+// GetCmdListContractsByCreator lists all contracts by creator
+func GetCmdListContractsByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-creator [creator]",
+		Short: "List all contracts by creator",
+		Long:  "List all contracts by creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByCreator(
+				context.Background(),
+				&types.QueryContractsByCreatorRequest{
+					CreatorAddress: args[0],
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list contracts by creator")
+	return cmd
+}
+
+// GetCmdListContractsByAdmin lists all contracts administrated by the given address
+func GetCmdListContractsByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-admin [admin]",
+		Short: "List all contracts by admin",
+		Long:  "List all contracts by admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByAdmin(
+				context.Background(),
+				&types.QueryContractsByAdminRequest{
+					AdminAddress: args[0],
+					Pagination:   pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list contracts by admin")
+	return cmd
+}
+
+// GetCmdListLargestContracts lists all contracts ordered by the size of their storage
+func GetCmdListLargestContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-largest-contracts",
+		Short: "List all contracts ordered by storage size, largest first",
+		Long:  "List all contracts ordered by storage size, largest first. Use --reverse for smallest first",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.LargestContracts(
+				context.Background(),
+				&types.QueryLargestContractsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list largest contracts")
+	return cmd
+}
+
+// GetCmdQueryContractByName resolves a claimed contract name to the contract address
+func GetCmdQueryContractByName() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-by-name [name]",
+		Short: "Prints out the address of the contract that claimed the name",
+		Long:  "Prints out the address of the contract that claimed the name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := types.ValidateContractName(args[0]); err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractByName(
+				context.Background(),
+				&types.QueryContractByNameRequest{
+					Name: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListContractNames lists all claimed contract names
+func GetCmdListContractNames() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-names",
+		Short: "List all claimed contract names",
+		Long:  "List all claimed contract names",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractNames(
+				context.Background(),
+				&types.QueryContractNamesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list contract names")
+	return cmd
+}
+
+type argumentDecoder struct {
+	// dec is the default decoder
+	dec                func(string) ([]byte, error)
 	asciiF, hexF, b64F bool
 }
 
@@ -670,3 +1794,109 @@ func addPaginationFlags(cmd *cobra.Command, query string) {
 	cmd.Flags().Uint64(flags.FlagLimit, 100, fmt.Sprintf("pagination limit of %s to query for", query))
 	cmd.Flags().Bool(flags.FlagReverse, false, "results are sorted in descending order")
 }
+
+// GetCmdQueryCodeGasMultiplier gets the gas multiplier of a code
+func GetCmdQueryCodeGasMultiplier() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-gas-multiplier [code_id]",
+		Short: "Prints out the gas multiplier of a code",
+		Long:  "Prints out the gas multiplier of a code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodeGasMultiplier(
+				context.Background(),
+				&types.QueryCodeGasMultiplierRequest{
+					CodeId: codeID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListCodeGasMultipliers lists the gas multipliers of all codes
+func GetCmdListCodeGasMultipliers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-gas-multipliers",
+		Short: "List the gas multipliers of all codes",
+		Long:  "List the gas multipliers of all codes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodeGasMultipliers(
+				context.Background(),
+				&types.QueryCodeGasMultipliersRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list code gas multipliers")
+	return cmd
+}
+
+// GetCmdQueryCodeSchema gets the JSON schema of the contract messages of a code
+func GetCmdQueryCodeSchema() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-schema [code_id]",
+		Short: "Prints out the JSON schema of the contract messages of a code",
+		Long:  "Prints out the JSON schema of the contract messages of a code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodeSchema(
+				context.Background(),
+				&types.QueryCodeSchemaRequest{
+					CodeId: codeID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
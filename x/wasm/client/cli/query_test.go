@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestVerifyWasmChecksum(t *testing.T) {
+	checksum, err := hex.DecodeString(testdata.ChecksumHackatom)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		srcPath     string
+		expChecksum []byte
+		expErr      bool
+	}{
+		"raw wasm": {
+			srcPath:     "../../keeper/testdata/hackatom.wasm",
+			expChecksum: checksum,
+		},
+		"gzipped wasm": {
+			srcPath:     "../../keeper/testdata/hackatom.wasm.gzip",
+			expChecksum: checksum,
+		},
+		"checksum mismatch": {
+			srcPath:     "../../keeper/testdata/hackatom_42.wasm",
+			expChecksum: checksum,
+			expErr:      true,
+		},
+		"not wasm": {
+			srcPath:     "../../keeper/testdata/download_releases.sh",
+			expChecksum: checksum,
+			expErr:      true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			wasm, err := os.ReadFile(spec.srcPath)
+			require.NoError(t, err)
+
+			gotErr := verifyWasmChecksum(wasm, spec.expChecksum)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestStateKeyEncoding(t *testing.T) {
+	specs := map[string]struct {
+		src      string
+		encoding string
+		exp      []byte
+		expErr   bool
+	}{
+		"hex": {
+			src:      "00036b6579",
+			encoding: keyEncodingHex,
+			exp:      []byte("\x00\x03key"),
+		},
+		"utf8": {
+			src:      "config",
+			encoding: keyEncodingUTF8,
+			exp:      []byte("config"),
+		},
+		"base64": {
+			src:      "Y29uZmln",
+			encoding: keyEncodingBase64,
+			exp:      []byte("config"),
+		},
+		"empty": {
+			src:      "",
+			encoding: keyEncodingHex,
+			exp:      []byte{},
+		},
+		"invalid hex": {
+			src:      "xyz",
+			encoding: keyEncodingHex,
+			expErr:   true,
+		},
+		"invalid base64": {
+			src:      "!!",
+			encoding: keyEncodingBase64,
+			expErr:   true,
+		},
+		"unsupported encoding": {
+			src:      "config",
+			encoding: "ascii",
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := decodeStateKey(spec.src, spec.encoding)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, spec.exp, got)
+			// and round trip
+			gotStr, gotErr := encodeStateKey(got, spec.encoding)
+			require.NoError(t, gotErr)
+			require.Equal(t, spec.src, gotStr)
+		})
+	}
+}
+
+func TestParseBatchSmartQueries(t *testing.T) {
+	addr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	queriesJSON := fmt.Sprintf(`[{"address":%q,"query":{"config":{}}},{"address":%q,"query":{"balance":{"address":"foo"}}}]`, addr, addr)
+	queryFile := filepath.Join(t.TempDir(), "queries.json")
+	require.NoError(t, os.WriteFile(queryFile, []byte(queriesJSON), 0o600))
+
+	exp := []types.BatchSmartQuery{
+		{Address: addr, QueryData: types.RawContractMessage(`{"config":{}}`)},
+		{Address: addr, QueryData: types.RawContractMessage(`{"balance":{"address":"foo"}}`)},
+	}
+	specs := map[string]struct {
+		src    string
+		exp    []types.BatchSmartQuery
+		expErr bool
+	}{
+		"inline json": {
+			src: queriesJSON,
+			exp: exp,
+		},
+		"json file": {
+			src: queryFile,
+			exp: exp,
+		},
+		"empty list": {
+			src:    `[]`,
+			expErr: true,
+		},
+		"invalid address": {
+			src:    `[{"address":"invalid","query":{}}]`,
+			expErr: true,
+		},
+		"missing query": {
+			src:    fmt.Sprintf(`[{"address":%q}]`, addr),
+			expErr: true,
+		},
+		"not a list": {
+			src:    `{}`,
+			expErr: true,
+		},
+		"unknown file": {
+			src:    filepath.Join(t.TempDir(), "unknown.json"),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := parseBatchSmartQueries(spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, spec.exp, got)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// decodedState is the printed result of the contract-state decode command
+type decodedState struct {
+	Address string              `json:"address"`
+	Entries []decodedStateEntry `json:"entries"`
+}
+
+// decodedStateEntry is a raw contract state entry with the key decoded by cw-storage-plus conventions
+type decodedStateEntry struct {
+	// Key is the human readable `namespace/key-parts` form of the raw key
+	Key       string   `json:"key"`
+	Namespace string   `json:"namespace,omitempty"`
+	KeyParts  []string `json:"key_parts,omitempty"`
+	// RawKey is the hex encoded raw key
+	RawKey string `json:"raw_key"`
+	// Value is the JSON value or a base64 encoded string when the value is not valid JSON
+	Value         json.RawMessage `json:"value"`
+	ValueEncoding string          `json:"value_encoding,omitempty"`
+}
+
+// decodeStateModel decodes a raw contract state entry
+func decodeStateModel(m types.Model) decodedStateEntry {
+	namespace, parts := decodeStorageKey(m.Key)
+	r := decodedStateEntry{
+		Namespace: namespace,
+		KeyParts:  parts,
+		RawKey:    hex.EncodeToString(m.Key),
+	}
+	switch {
+	case namespace == "":
+		r.Key = formatKeyPart(m.Key)
+	case len(parts) == 0:
+		r.Key = namespace
+	default:
+		r.Key = namespace + "/" + strings.Join(parts, "/")
+	}
+	if json.Valid(m.Value) {
+		r.Value = json.RawMessage(m.Value)
+	} else {
+		r.Value, _ = json.Marshal(base64.StdEncoding.EncodeToString(m.Value))
+		r.ValueEncoding = "base64"
+	}
+	return r
+}
+
+// decodeStorageKey splits a raw contract state key into namespace and key parts following the cw-storage-plus
+// conventions. An `Item` is stored under its plain namespace while a `Map` key starts with the 2 byte big endian
+// length of the namespace, followed by the namespace and the key parts. All key parts but the last one of a
+// composite key are length prefixed the same way. This is best effort as the raw key carries no type information.
+// An empty namespace is returned for keys that do not follow the conventions.
+func decodeStorageKey(key []byte) (string, []string) {
+	if len(key) > 2 {
+		n := int(binary.BigEndian.Uint16(key))
+		if n > 0 && 2+n <= len(key) && isPrintableKey(key[2:2+n]) {
+			return string(key[2 : 2+n]), decodeKeyParts(key[2+n:])
+		}
+	}
+	if len(key) != 0 && isPrintableKey(key) {
+		return string(key), nil
+	}
+	return "", nil
+}
+
+func decodeKeyParts(bz []byte) []string {
+	var parts []string
+	for len(bz) > 2 {
+		n := int(binary.BigEndian.Uint16(bz))
+		if n == 0 || 2+n >= len(bz) {
+			break
+		}
+		parts = append(parts, formatKeyPart(bz[2:2+n]))
+		bz = bz[2+n:]
+	}
+	if len(bz) != 0 {
+		parts = append(parts, formatKeyPart(bz))
+	}
+	return parts
+}
+
+// formatKeyPart returns printable key parts as string and all others hex encoded with a `0x` prefix
+func formatKeyPart(bz []byte) string {
+	if isPrintableKey(bz) {
+		return string(bz)
+	}
+	return "0x" + hex.EncodeToString(bz)
+}
+
+func isPrintableKey(bz []byte) bool {
+	if !utf8.Valid(bz) {
+		return false
+	}
+	for _, r := range string(bz) {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterByNamespace returns the entries within any of the given namespaces. All entries are returned without filter.
+func filterByNamespace(entries []decodedStateEntry, namespaces []string) []decodedStateEntry {
+	if len(namespaces) == 0 {
+		return entries
+	}
+	r := make([]decodedStateEntry, 0, len(entries))
+	for _, e := range entries {
+		for _, ns := range namespaces {
+			if e.Namespace == ns {
+				r = append(r, e)
+				break
+			}
+		}
+	}
+	return r
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestDecodeStorageKey(t *testing.T) {
+	specs := map[string]struct {
+		src          []byte
+		expNamespace string
+		expParts     []string
+	}{
+		"item": {
+			src:          []byte("config"),
+			expNamespace: "config",
+		},
+		"map with string key": {
+			src:          []byte("\x00\x08balancescosmos1abc"),
+			expNamespace: "balances",
+			expParts:     []string{"cosmos1abc"},
+		},
+		"map with integer key": {
+			src:          append([]byte("\x00\x06tokens"), 0, 0, 0, 0, 0, 0, 0, 5),
+			expNamespace: "tokens",
+			expParts:     []string{"0x0000000000000005"},
+		},
+		"map with composite key": {
+			src:          []byte("\x00\x0aallowances\x00\x05alicebob"),
+			expNamespace: "allowances",
+			expParts:     []string{"alice", "bob"},
+		},
+		"map with triple key": {
+			src:          []byte("\x00\x01a\x00\x01b\x00\x01cd"),
+			expNamespace: "a",
+			expParts:     []string{"b", "c", "d"},
+		},
+		"length prefix exceeds key": {
+			src:      []byte("\x00\x10short"),
+			expParts: nil,
+		},
+		"binary key": {
+			src: []byte{0xff, 0x01},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotNamespace, gotParts := decodeStorageKey(spec.src)
+			assert.Equal(t, spec.expNamespace, gotNamespace)
+			assert.Equal(t, spec.expParts, gotParts)
+		})
+	}
+}
+
+func TestDecodeStateModel(t *testing.T) {
+	specs := map[string]struct {
+		src types.Model
+		exp decodedStateEntry
+	}{
+		"item with json value": {
+			src: types.Model{Key: []byte("config"), Value: []byte(`{"owner":"alice"}`)},
+			exp: decodedStateEntry{
+				Key:       "config",
+				Namespace: "config",
+				RawKey:    "636f6e666967",
+				Value:     json.RawMessage(`{"owner":"alice"}`),
+			},
+		},
+		"map with composite key": {
+			src: types.Model{Key: []byte("\x00\x01a\x00\x01bc"), Value: []byte(`"1"`)},
+			exp: decodedStateEntry{
+				Key:       "a/b/c",
+				Namespace: "a",
+				KeyParts:  []string{"b", "c"},
+				RawKey:    "00016100016263",
+				Value:     json.RawMessage(`"1"`),
+			},
+		},
+		"binary key and value": {
+			src: types.Model{Key: []byte{0xff}, Value: []byte{0x01, 0x02}},
+			exp: decodedStateEntry{
+				Key:           "0xff",
+				RawKey:        "ff",
+				Value:         json.RawMessage(`"AQI="`),
+				ValueEncoding: "base64",
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got := decodeStateModel(spec.src)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestFilterByNamespace(t *testing.T) {
+	entries := []decodedStateEntry{{Namespace: "config"}, {Namespace: "balances"}, {}, {Namespace: "tokens"}}
+	assert.Equal(t, entries, filterByNamespace(entries, nil))
+	assert.Equal(t, []decodedStateEntry{{Namespace: "balances"}, {Namespace: "tokens"}}, filterByNamespace(entries, []string{"tokens", "balances"}))
+	assert.Empty(t, filterByNamespace(entries, []string{"other"}))
+}
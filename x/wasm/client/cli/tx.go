@@ -2,6 +2,7 @@ package cli
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -41,6 +42,7 @@ const (
 	flagUnpinCode                 = "unpin-code"
 	flagAllowedMsgKeys            = "allow-msg-keys"
 	flagAllowedRawMsgs            = "allow-raw-msgs"
+	flagAllowedMsgConstraint      = "allow-msg-constraint"
 	flagExpiration                = "expiration"
 	flagMaxCalls                  = "max-calls"
 	flagMaxFunds                  = "max-funds"
@@ -48,6 +50,27 @@ const (
 	flagNoTokenTransfer           = "no-token-transfer"
 	flagAuthority                 = "authority"
 	flagExpedite                  = "expedite"
+	flagChecksums                 = "checksums"
+	flagSender                    = "sender"
+	flagInterval                  = "interval"
+	flagGasLimit                  = "gas-limit"
+	flagMaxErrors                 = "max-errors"
+	flagPerBlockLimit             = "per-block-limit"
+	flagPerSenderLimit            = "per-sender-limit"
+	flagApprovalGasLimit          = "approval-gas-limit"
+	flagSourceRepository          = "source-repository"
+	flagCommit                    = "commit"
+	flagBuilderImage              = "builder-image"
+	flagOptimizerVersion          = "optimizer-version"
+	flagSchemaHash                = "schema-hash"
+	flagStart                     = "start"
+	flagEnd                       = "end"
+	flagKeyEncoding               = "key-encoding"
+	flagNamespace                 = "namespace"
+	flagExportFile                = "export-file"
+	flagAppHash                   = "app-hash"
+	flagSchemaHelp                = "schema-help"
+	flagValidateMessages          = "validate-messages"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -68,10 +91,25 @@ func GetTxCmd() *cobra.Command {
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
+		ProposeContractAdminCmd(),
+		AcceptContractAdminCmd(),
+		CancelContractAdminTransferCmd(),
 		GrantCmd(),
 		UpdateInstantiateConfigCmd(),
 		SubmitProposalCmd(),
 		UpdateContractLabelCmd(),
+		SetCodeMetadataCmd(),
+		SetCodeSchemaCmd(),
+		RegisterContractNameCmd(),
+		TransferContractNameCmd(),
+		ReleaseContractNameCmd(),
+		DeleteContractCmd(),
+		SubscribeEpochHookCmd(),
+		UnsubscribeEpochHookCmd(),
+		RegisterFeeShareCmd(),
+		CancelFeeShareCmd(),
+		SetFeeSponsorshipCmd(),
+		RemoveFeeSponsorshipCmd(),
 	)
 	return txCmd
 }
@@ -92,12 +130,19 @@ func StoreCodeCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if msg.Metadata, err = parseCodeMetadataFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 		SilenceUsage: true,
 	}
 
 	addInstantiatePermissionFlags(cmd)
+	addCodeMetadataFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -193,6 +238,39 @@ func addInstantiatePermissionFlags(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract from the code, optional")
 }
 
+func addCodeMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagSourceRepository, "", "URL of the source code repository, optional")
+	cmd.Flags().String(flagCommit, "", "Revision of the source code that was built, optional")
+	cmd.Flags().String(flagBuilderImage, "", "Container image used for the build, such as \"cosmwasm/optimizer:0.16.0\", optional")
+	cmd.Flags().String(flagOptimizerVersion, "", "Version of the optimizer used for the build, optional")
+	cmd.Flags().String(flagSchemaHash, "", "Hex encoded hash of the contract JSON schema, optional")
+}
+
+// parseCodeMetadataFlags returns the code metadata from the flags or nil when none is set
+func parseCodeMetadataFlags(flags *flag.FlagSet) (*types.CodeMetadata, error) {
+	var metadata types.CodeMetadata
+	for _, v := range []struct {
+		name string
+		dst  *string
+	}{
+		{flagSourceRepository, &metadata.SourceRepository},
+		{flagCommit, &metadata.Commit},
+		{flagBuilderImage, &metadata.BuilderImage},
+		{flagOptimizerVersion, &metadata.OptimizerVersion},
+		{flagSchemaHash, &metadata.SchemaHash},
+	} {
+		s, err := flags.GetString(v.name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", v.name, err)
+		}
+		*v.dst = s
+	}
+	if metadata.IsEmpty() {
+		return nil, nil
+	}
+	return &metadata, nil
+}
+
 // InstantiateContractCmd will instantiate a contract from previously uploaded code.
 func InstantiateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -363,13 +441,26 @@ func ExecuteContractCmd() *cobra.Command {
 		Use:     "execute [contract_addr_bech32] [json_encoded_send_args] --amount [coins,optional]",
 		Short:   "Execute a command on a wasm contract",
 		Aliases: []string{"run", "call", "exec", "ex", "e"},
-		Args:    cobra.ExactArgs(2),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if schemaHelp, _ := cmd.Flags().GetBool(flagSchemaHelp); schemaHelp {
+				return cobra.RangeArgs(1, 2)(cmd, args)
+			}
+			return cobra.ExactArgs(2)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			schemaHelp, err := cmd.Flags().GetBool(flagSchemaHelp)
+			if err != nil {
+				return fmt.Errorf("schema help: %s", err)
+			}
+			if schemaHelp {
+				return printExecuteSchemaHelp(cmd, clientCtx, args[0])
+			}
+
 			msg, err := parseExecuteArgs(args[0], args[1], clientCtx.GetFromAddress(), cmd.Flags())
 			if err != nil {
 				return err
@@ -380,10 +471,42 @@ func ExecuteContractCmd() *cobra.Command {
 	}
 
 	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
+	cmd.Flags().Bool(flagSchemaHelp, false, "List the valid execute messages from the schema of the contract code instead of executing")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// printExecuteSchemaHelp prints the execute message variants from the schema of the contract code
+func printExecuteSchemaHelp(cmd *cobra.Command, clientCtx client.Context, contractAddr string) error {
+	queryClient := types.NewQueryClient(clientCtx)
+	contractRes, err := queryClient.ContractInfo(cmd.Context(), &types.QueryContractInfoRequest{Address: contractAddr})
+	if err != nil {
+		return err
+	}
+	schemaRes, err := queryClient.CodeSchema(cmd.Context(), &types.QueryCodeSchemaRequest{CodeId: contractRes.CodeID})
+	if err != nil {
+		return err
+	}
+	schema, err := types.ParseContractSchema(schemaRes.Schema)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintString(formatSchemaVariants(schema, types.SchemaEntryPointExecute, contractRes.CodeID))
+}
+
+// formatSchemaVariants lists the message variants of the entry point one per line
+func formatSchemaVariants(schema *types.ContractSchema, entryPoint string, codeID uint64) string {
+	if !schema.HasEntryPoint(entryPoint) {
+		return fmt.Sprintf("code id %d has no %s schema\n", codeID, entryPoint)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "valid %s messages of code id %d:\n", entryPoint, codeID)
+	for _, v := range schema.Variants(entryPoint) {
+		fmt.Fprintf(&b, "  %s\n", v)
+	}
+	return b.String()
+}
+
 func parseExecuteArgs(contractAddr, execMsg string, sender sdk.AccAddress, flags *flag.FlagSet) (types.MsgExecuteContract, error) {
 	amountStr, err := flags.GetString(flagAmount)
 	if err != nil {
@@ -419,7 +542,7 @@ func GrantCmd() *cobra.Command {
 
 func GrantAuthorizationCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "contract [grantee] [message_type=\"execution\"|\"migration\"] [contract_addr_bech32] --allow-raw-msgs [msg1,msg2,...] --allow-msg-keys [key1,key2,...] --allow-all-messages",
+		Use:   "contract [grantee] [message_type=\"execution\"|\"migration\"] [contract_addr_bech32] --allow-raw-msgs [msg1,msg2,...] --allow-msg-keys [key1,key2,...] --allow-msg-constraint [constraint] --allow-all-messages",
 		Short: "Grant authorization to interact with a contract on behalf of you",
 		Long: fmt.Sprintf(`Grant authorization to an address.
 Examples:
@@ -428,7 +551,12 @@ $ %s tx grant contract <grantee_addr> execution <contract_addr> --allow-all-mess
 $ %s tx grant contract <grantee_addr> execution <contract_addr> --allow-all-messages --max-funds 100000uwasm --expiration 1667979596
 
 $ %s tx grant contract <grantee_addr> execution <contract_addr> --allow-all-messages --max-calls 5 --max-funds 100000uwasm --expiration 1667979596
-`, version.AppName, version.AppName, version.AppName),
+
+$ %s tx grant contract <grantee_addr> execution <contract_addr> --allow-msg-constraint 'swap.offer.amount <= 1000' --allow-msg-constraint 'swap.ask_asset in ["uatom","uosmo"]' --max-calls 5 --no-token-transfer --expiration 1667979596
+
+A constraint is "<json_path> <operator> <value>" with one of the operators ==, !=, <, <=, >, >=, in or not_in.
+The value is JSON, or a string when it is not valid JSON. The in and not_in operators require a JSON array.
+`, version.AppName, version.AppName, version.AppName, version.AppName),
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -456,6 +584,11 @@ $ %s tx grant contract <grantee_addr> execution <contract_addr> --allow-all-mess
 				return err
 			}
 
+			msgConstraints, err := cmd.Flags().GetStringArray(flagAllowedMsgConstraint)
+			if err != nil {
+				return err
+			}
+
 			maxFundsStr, err := cmd.Flags().GetString(flagMaxFunds)
 			if err != nil {
 				return fmt.Errorf("max funds: %s", err)
@@ -504,9 +637,16 @@ $ %s tx grant contract <grantee_addr> execution <contract_addr> --allow-all-mess
 				return errors.New("invalid limit setup")
 			}
 
+			var filtersSet int
+			for _, set := range []bool{allowAllMsgs, len(msgKeys) != 0, len(rawMsgs) != 0, len(msgConstraints) != 0} {
+				if set {
+					filtersSet++
+				}
+			}
+
 			var filter types.ContractAuthzFilterX
 			switch {
-			case allowAllMsgs && len(msgKeys) != 0 || allowAllMsgs && len(rawMsgs) != 0 || len(msgKeys) != 0 && len(rawMsgs) != 0:
+			case filtersSet > 1:
 				return errors.New("cannot set more than one filter within one grant")
 			case allowAllMsgs:
 				filter = types.NewAllowAllMessagesFilter()
@@ -518,6 +658,14 @@ $ %s tx grant contract <grantee_addr> execution <contract_addr> --allow-all-mess
 					msgs[i] = types.RawContractMessage(msg)
 				}
 				filter = types.NewAcceptedMessagesFilter(msgs...)
+			case len(msgConstraints) != 0:
+				constraints := make([]types.MessageFieldConstraint, len(msgConstraints))
+				for i, c := range msgConstraints {
+					if constraints[i], err = parseMessageFieldConstraint(c); err != nil {
+						return err
+					}
+				}
+				filter = types.NewAcceptedMessageConstraintsFilter(constraints...)
 			default:
 				return errors.New("invalid filter setup")
 			}
@@ -552,6 +700,7 @@ $ %s tx grant contract <grantee_addr> execution <contract_addr> --allow-all-mess
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().StringSlice(flagAllowedMsgKeys, []string{}, "Allowed msg keys")
 	cmd.Flags().StringSlice(flagAllowedRawMsgs, []string{}, "Allowed raw msgs")
+	cmd.Flags().StringArray(flagAllowedMsgConstraint, []string{}, "Constraint on a msg field, e.g. 'transfer.amount <= 1000'. Can be repeated")
 	cmd.Flags().Uint64(flagMaxCalls, 0, "Maximal number of calls to the contract")
 	cmd.Flags().String(flagMaxFunds, "", "Maximal amount of tokens transferable to the contract.")
 	cmd.Flags().Int64(flagExpiration, 0, "The Unix timestamp.")
@@ -560,6 +709,39 @@ $ %s tx grant contract <grantee_addr> execution <contract_addr> --allow-all-mess
 	return cmd
 }
 
+// parseMessageFieldConstraint parses a constraint in the form "<json_path> <operator> <value>"
+func parseMessageFieldConstraint(s string) (types.MessageFieldConstraint, error) {
+	path, rest, _ := strings.Cut(strings.TrimSpace(s), " ")
+	operator, value, _ := strings.Cut(strings.TrimSpace(rest), " ")
+	value = strings.TrimSpace(value)
+	if path == "" || operator == "" || value == "" {
+		return types.MessageFieldConstraint{}, fmt.Errorf("constraint %q: expected \"<json_path> <operator> <value>\"", s)
+	}
+
+	var values []types.RawContractMessage
+	switch operator {
+	case types.ConstraintOperatorIn, types.ConstraintOperatorNotIn:
+		var elems []json.RawMessage
+		if err := json.Unmarshal([]byte(value), &elems); err != nil {
+			return types.MessageFieldConstraint{}, fmt.Errorf("constraint %q: values must be a JSON array: %s", s, err)
+		}
+		for _, e := range elems {
+			values = append(values, types.RawContractMessage(e))
+		}
+	default:
+		if !json.Valid([]byte(value)) {
+			bz, err := json.Marshal(value)
+			if err != nil {
+				return types.MessageFieldConstraint{}, err
+			}
+			value = string(bz)
+		}
+		values = []types.RawContractMessage{types.RawContractMessage(value)}
+	}
+	constraint := types.MessageFieldConstraint{Path: path, Operator: operator, Values: values}
+	return constraint, constraint.ValidateBasic()
+}
+
 func GrantStoreCodeAuthorizationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "store-code [grantee] [code_hash:permission]",
@@ -125,6 +125,102 @@ func TestParseAccessConfigFlags(t *testing.T) {
 	}
 }
 
+func TestParseCodeMetadataFlags(t *testing.T) {
+	specs := map[string]struct {
+		args        []string
+		expMetadata *types.CodeMetadata
+	}{
+		"all set": {
+			args: []string{
+				"--source-repository=https://github.com/CosmWasm/cw-plus", "--commit=a1b2c3d",
+				"--builder-image=cosmwasm/optimizer:0.16.0", "--optimizer-version=0.16.0", "--schema-hash=0123456789abcdef",
+			},
+			expMetadata: &types.CodeMetadata{
+				SourceRepository: "https://github.com/CosmWasm/cw-plus",
+				Commit:           "a1b2c3d",
+				BuilderImage:     "cosmwasm/optimizer:0.16.0",
+				OptimizerVersion: "0.16.0",
+				SchemaHash:       "0123456789abcdef",
+			},
+		},
+		"some set": {
+			args:        []string{"--commit=a1b2c3d"},
+			expMetadata: &types.CodeMetadata{Commit: "a1b2c3d"},
+		},
+		"not set": {
+			args: []string{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			flags := StoreCodeCmd().Flags()
+			require.NoError(t, flags.Parse(spec.args))
+			gotMetadata, gotErr := parseCodeMetadataFlags(flags)
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMetadata, gotMetadata)
+		})
+	}
+}
+
+func TestFormatSchemaVariants(t *testing.T) {
+	schema, err := types.ParseContractSchema([]byte(`{"execute":{"oneOf":[
+		{"type":"string","enum":["reset"]},
+		{"type":"object","required":["transfer"],"properties":{"transfer":{"type":"object"}}}
+	]}}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "valid execute messages of code id 1:\n  reset\n  transfer\n", formatSchemaVariants(schema, types.SchemaEntryPointExecute, 1))
+	assert.Equal(t, "code id 1 has no query schema\n", formatSchemaVariants(schema, types.SchemaEntryPointQuery, 1))
+}
+
+func TestParseMessageFieldConstraint(t *testing.T) {
+	specs := map[string]struct {
+		src    string
+		exp    types.MessageFieldConstraint
+		expErr bool
+	}{
+		"number": {
+			src: "transfer.amount <= 1000",
+			exp: types.MessageFieldConstraint{Path: "transfer.amount", Operator: "<=", Values: []types.RawContractMessage{[]byte(`1000`)}},
+		},
+		"json string": {
+			src: `recipient == "bob"`,
+			exp: types.MessageFieldConstraint{Path: "recipient", Operator: "==", Values: []types.RawContractMessage{[]byte(`"bob"`)}},
+		},
+		"plain string": {
+			src: "recipient != cosmos1abc",
+			exp: types.MessageFieldConstraint{Path: "recipient", Operator: "!=", Values: []types.RawContractMessage{[]byte(`"cosmos1abc"`)}},
+		},
+		"list": {
+			src: `swap.ask_asset in ["uatom", "uosmo"]`,
+			exp: types.MessageFieldConstraint{Path: "swap.ask_asset", Operator: "in", Values: []types.RawContractMessage{[]byte(`"uatom"`), []byte(`"uosmo"`)}},
+		},
+		"list not an array": {
+			src:    `swap.ask_asset not_in uatom`,
+			expErr: true,
+		},
+		"missing value": {
+			src:    "transfer.amount <=",
+			expErr: true,
+		},
+		"unknown operator": {
+			src:    "transfer.amount =< 1000",
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := parseMessageFieldConstraint(spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
 func TestParseStoreCodeGrants(t *testing.T) {
 	specs := map[string]struct {
 		src    []string
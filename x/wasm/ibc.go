@@ -267,14 +267,16 @@ func (i IBCHandler) OnRecvPacket(
 
 	em := sdk.NewEventManager()
 	msg := wasmvmtypes.IBCPacketReceiveMsg{Packet: newIBCPacket(packet), Relayer: relayer.String()}
-	ack, err := i.keeper.OnRecvPacket(ctx.WithEventManager(em), contractAddr, msg)
+	recvCtx, commitTxContracts := types.WithTxContractsScope(ctx.WithEventManager(em))
+	ack, err := i.keeper.OnRecvPacket(recvCtx, contractAddr, msg)
 	if err != nil {
 		ack = CreateErrorAcknowledgement(err)
-		// the state gets reverted, so we drop all captured events
+		// the state gets reverted, so we drop all captured events and called contracts
 	} else if ack == nil || ack.Success() {
 		// emit all contract and submessage events on success
 		// nil ack is a success case, see: https://github.com/cosmos/ibc-go/blob/v7.0.0/modules/core/keeper/msg_server.go#L453
 		ctx.EventManager().EmitEvents(em.Events())
+		commitTxContracts()
 	}
 	types.EmitAcknowledgementEvent(ctx, contractAddr, ack, err)
 	return ack
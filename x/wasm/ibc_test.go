@@ -1,6 +1,7 @@
 package wasm
 
 import (
+	"context"
 	"testing"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
@@ -33,11 +34,13 @@ func TestOnRecvPacket(t *testing.T) {
 		expEvents            sdk.Events
 		expPanic             bool
 		expAck               ibcexported.Acknowledgement
+		expTracked           bool
 	}{
 		"contract returns success response": {
 			ibcPkg:      anyContractIBCPkg,
 			contractRsp: keeper.ContractConfirmStateAck([]byte{1}),
 			expAck:      keeper.ContractConfirmStateAck([]byte{1}),
+			expTracked:  true,
 			expEvents: sdk.Events{
 				myCustomEvent,
 				{
@@ -66,7 +69,8 @@ func TestOnRecvPacket(t *testing.T) {
 			},
 		},
 		"nil considered success response": { // regression only
-			ibcPkg: anyContractIBCPkg,
+			ibcPkg:     anyContractIBCPkg,
+			expTracked: true,
 			expEvents: sdk.Events{
 				myCustomEvent,
 				{
@@ -106,13 +110,18 @@ func TestOnRecvPacket(t *testing.T) {
 				OnRecvPacketFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, msg wasmvmtypes.IBCPacketReceiveMsg) (ibcexported.Acknowledgement, error) {
 					// additional custom event to confirm event handling on state commit/ rollback
 					ctx.EventManager().EmitEvent(myCustomEvent)
+					// called contracts are tracked for the fee share on state commit/ rollback
+					if txContracts, ok := types.TxContractsFromContext(ctx); ok {
+						txContracts.AddContractAddress(contractAddr)
+					}
 					return spec.contractRsp, spec.contractOkMsgExecErr
 				},
 			}
 			channelVersion := ""
 			h := NewIBCHandler(&mock, nil, nil, nil)
 			em := &sdk.EventManager{}
-			ctx := sdk.Context{}.WithEventManager(em)
+			txContracts := types.NewTxContracts()
+			ctx := types.WithTxContracts(sdk.Context{}.WithContext(context.Background()).WithEventManager(em), txContracts)
 			if spec.expPanic {
 				require.Panics(t, func() {
 					_ = h.OnRecvPacket(ctx, channelVersion, spec.ibcPkg, anyRelayerAddr)
@@ -122,6 +131,7 @@ func TestOnRecvPacket(t *testing.T) {
 			gotAck := h.OnRecvPacket(ctx, channelVersion, spec.ibcPkg, anyRelayerAddr)
 			assert.Equal(t, spec.expAck, gotAck)
 			assert.Equal(t, spec.expEvents, em.Events())
+			assert.Equal(t, spec.expTracked, len(txContracts.GetContractAddresses()) == 1)
 		})
 	}
 }
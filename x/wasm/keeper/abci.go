@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker runs the wasm module logic at the end of every block
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.executeCronSchedules(sdkCtx)
+	if err := k.pruneFeeSponsorshipSenderUsages(sdkCtx); err != nil {
+		return err
+	}
+	return k.autoPinCodes(sdkCtx)
+}
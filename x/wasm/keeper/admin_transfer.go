@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// proposeContractAdmin stores a pending admin transfer that replaces any existing one. The admin is changed
+// only when the new admin accepts the transfer before it expires.
+func (k Keeper) proposeContractAdmin(ctx context.Context, contractAddr, caller, newAdmin sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddr)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	transfer := types.PendingAdminTransfer{
+		Contract: contractAddr.String(),
+		NewAdmin: newAdmin.String(),
+	}
+	if expiry := k.GetParams(sdkCtx).AdminTransferExpirySeconds; expiry != 0 {
+		expiresAt := sdkCtx.BlockTime().Add(time.Duration(expiry) * time.Second)
+		transfer.ExpiresAt = &expiresAt
+	}
+	if err := k.storePendingAdminTransfer(sdkCtx, contractAddr, transfer); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeProposeContractAdmin,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, transfer.Contract),
+		sdk.NewAttribute(types.AttributeKeyNewAdmin, transfer.NewAdmin),
+	))
+	return nil
+}
+
+// acceptContractAdmin sets the caller as contract admin when it is the new admin of a pending transfer that
+// has not expired
+func (k Keeper) acceptContractAdmin(ctx context.Context, contractAddr, caller sdk.AccAddress) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddr)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	transfer := k.GetPendingAdminTransfer(sdkCtx, contractAddr)
+	switch {
+	case transfer == nil:
+		return errorsmod.Wrap(types.ErrNotFound, "pending admin transfer")
+	case transfer.NewAdmin != caller.String():
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not the proposed admin")
+	case transfer.IsExpired(sdkCtx.BlockTime()):
+		return errorsmod.Wrapf(types.ErrInvalid, "admin transfer expired at %s", transfer.ExpiresAt)
+	}
+	return k.changeContractAdmin(sdkCtx, contractAddr, contractInfo, caller)
+}
+
+// cancelContractAdminTransfer deletes the pending admin transfer of the contract
+func (k Keeper) cancelContractAdminTransfer(ctx context.Context, contractAddr, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddr)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if k.GetPendingAdminTransfer(sdkCtx, contractAddr) == nil {
+		return errorsmod.Wrap(types.ErrNotFound, "pending admin transfer")
+	}
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.GetPendingAdminTransferKey(contractAddr)); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelAdminTransfer,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+	))
+	return nil
+}
+
+// importPendingAdminTransfer stores the pending admin transfer for an existing contract
+func (k Keeper) importPendingAdminTransfer(ctx context.Context, transfer types.PendingAdminTransfer) error {
+	if err := transfer.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(transfer.Contract)
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return types.ErrNoSuchContractFn(transfer.Contract).Wrapf("address %s", transfer.Contract)
+	}
+	if k.GetPendingAdminTransfer(ctx, contractAddr) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "pending admin transfer %s", transfer.Contract)
+	}
+	return k.storePendingAdminTransfer(ctx, contractAddr, transfer)
+}
+
+func (k Keeper) storePendingAdminTransfer(ctx context.Context, contractAddr sdk.AccAddress, transfer types.PendingAdminTransfer) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetPendingAdminTransferKey(contractAddr), k.cdc.MustMarshal(&transfer))
+}
+
+// GetPendingAdminTransfer returns the pending admin transfer of the contract or nil when not found.
+// Expired transfers are returned until they are replaced or cancelled.
+func (k Keeper) GetPendingAdminTransfer(ctx context.Context, contractAddr sdk.AccAddress) *types.PendingAdminTransfer {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetPendingAdminTransferKey(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var transfer types.PendingAdminTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return &transfer
+}
+
+// IteratePendingAdminTransfers iterates over all pending admin transfers ordered by contract address.
+// Iteration stops when the callback returns true.
+func (k Keeper) IteratePendingAdminTransfers(ctx context.Context, cb func(types.PendingAdminTransfer) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingAdminTransferPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var transfer types.PendingAdminTransfer
+		k.cdc.MustUnmarshal(iter.Value(), &transfer)
+		if cb(transfer) {
+			break
+		}
+	}
+}
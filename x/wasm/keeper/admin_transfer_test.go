@@ -0,0 +1,283 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestProposeContractAdmin(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	newAdmin := RandomAccountAddress(t)
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	parentCtx = parentCtx.WithBlockTime(blockTime)
+	expiresAt := blockTime.Add(time.Minute)
+
+	specs := map[string]struct {
+		contract  sdk.AccAddress
+		caller    sdk.AccAddress
+		policy    types.AuthorizationPolicy
+		expiry    uint64
+		expExpiry *time.Time
+		expErr    *errorsmod.Error
+	}{
+		"admin": {
+			contract: example.Contract,
+			caller:   example.CreatorAddr,
+			policy:   DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			contract: example.Contract,
+			caller:   RandomAccountAddress(t),
+			policy:   GovAuthorizationPolicy{},
+		},
+		"with expiry": {
+			contract:  example.Contract,
+			caller:    example.CreatorAddr,
+			policy:    DefaultAuthorizationPolicy{},
+			expiry:    60,
+			expExpiry: &expiresAt,
+		},
+		"other address": {
+			contract: example.Contract,
+			caller:   RandomAccountAddress(t),
+			policy:   DefaultAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			contract: RandomAccountAddress(t),
+			caller:   example.CreatorAddr,
+			policy:   GovAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			params := k.GetParams(ctx)
+			params.AdminTransferExpirySeconds = spec.expiry
+			require.NoError(t, k.SetParams(ctx, params))
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.proposeContractAdmin(ctx.WithEventManager(em), spec.contract, spec.caller, newAdmin, spec.policy)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, em.Events())
+				assert.Nil(t, k.GetPendingAdminTransfer(ctx, spec.contract))
+				return
+			}
+			require.NoError(t, gotErr)
+			exp := types.PendingAdminTransfer{Contract: spec.contract.String(), NewAdmin: newAdmin.String(), ExpiresAt: spec.expExpiry}
+			assert.Equal(t, &exp, k.GetPendingAdminTransfer(ctx, spec.contract))
+			expEvt := sdk.NewEvent("propose_contract_admin",
+				sdk.NewAttribute("_contract_address", spec.contract.String()),
+				sdk.NewAttribute("new_admin_address", newAdmin.String()))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+			// and the admin is not changed before accepted
+			assert.Equal(t, example.CreatorAddr.String(), k.GetContractInfo(ctx, spec.contract).Admin)
+			// and shown in contract info
+			rsp, err := Querier(k).ContractInfo(ctx, &types.QueryContractInfoRequest{Address: spec.contract.String()})
+			require.NoError(t, err)
+			assert.Equal(t, &exp, rsp.PendingAdminTransfer)
+		})
+	}
+}
+
+func TestAcceptContractAdmin(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	withoutTransfer := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	newAdmin := RandomAccountAddress(t)
+	expiresAt := time.Unix(1_700_000_000, 0).UTC()
+	require.NoError(t, k.importPendingAdminTransfer(parentCtx, types.PendingAdminTransfer{
+		Contract:  example.Contract.String(),
+		NewAdmin:  newAdmin.String(),
+		ExpiresAt: &expiresAt,
+	}))
+
+	specs := map[string]struct {
+		contract  sdk.AccAddress
+		caller    sdk.AccAddress
+		blockTime time.Time
+		expErr    *errorsmod.Error
+	}{
+		"new admin": {
+			contract:  example.Contract,
+			caller:    newAdmin,
+			blockTime: expiresAt.Add(-time.Second),
+		},
+		"new admin at expiry time": {
+			contract:  example.Contract,
+			caller:    newAdmin,
+			blockTime: expiresAt,
+		},
+		"expired": {
+			contract:  example.Contract,
+			caller:    newAdmin,
+			blockTime: expiresAt.Add(time.Second),
+			expErr:    types.ErrInvalid,
+		},
+		"current admin": {
+			contract:  example.Contract,
+			caller:    example.CreatorAddr,
+			blockTime: expiresAt,
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+		"other address": {
+			contract:  example.Contract,
+			caller:    RandomAccountAddress(t),
+			blockTime: expiresAt,
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+		"no pending transfer": {
+			contract:  withoutTransfer.Contract,
+			caller:    newAdmin,
+			blockTime: expiresAt,
+			expErr:    types.ErrNotFound,
+		},
+		"unknown contract": {
+			contract:  RandomAccountAddress(t),
+			caller:    newAdmin,
+			blockTime: expiresAt,
+			expErr:    sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithBlockTime(spec.blockTime)
+
+			// when
+			gotErr := k.acceptContractAdmin(ctx.WithEventManager(em), spec.contract, spec.caller)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, newAdmin.String(), k.GetContractInfo(ctx, spec.contract).Admin)
+			assert.Nil(t, k.GetPendingAdminTransfer(ctx, spec.contract))
+			expEvt := sdk.NewEvent("update_contract_admin",
+				sdk.NewAttribute("_contract_address", spec.contract.String()),
+				sdk.NewAttribute("new_admin_address", newAdmin.String()))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+		})
+	}
+}
+
+func TestCancelContractAdminTransfer(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	withoutTransfer := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	newAdmin := RandomAccountAddress(t)
+	require.NoError(t, k.importPendingAdminTransfer(parentCtx, types.PendingAdminTransfer{
+		Contract: example.Contract.String(),
+		NewAdmin: newAdmin.String(),
+	}))
+
+	specs := map[string]struct {
+		contract sdk.AccAddress
+		caller   sdk.AccAddress
+		policy   types.AuthorizationPolicy
+		expErr   *errorsmod.Error
+	}{
+		"admin": {
+			contract: example.Contract,
+			caller:   example.CreatorAddr,
+			policy:   DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			contract: example.Contract,
+			caller:   RandomAccountAddress(t),
+			policy:   GovAuthorizationPolicy{},
+		},
+		"proposed new admin": {
+			contract: example.Contract,
+			caller:   newAdmin,
+			policy:   DefaultAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"no pending transfer": {
+			contract: withoutTransfer.Contract,
+			caller:   withoutTransfer.CreatorAddr,
+			policy:   DefaultAuthorizationPolicy{},
+			expErr:   types.ErrNotFound,
+		},
+		"unknown contract": {
+			contract: RandomAccountAddress(t),
+			caller:   example.CreatorAddr,
+			policy:   GovAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.cancelContractAdminTransfer(ctx.WithEventManager(em), spec.contract, spec.caller, spec.policy)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, k.GetPendingAdminTransfer(ctx, spec.contract))
+			assert.Equal(t, example.CreatorAddr.String(), k.GetContractInfo(ctx, spec.contract).Admin)
+			expEvt := sdk.NewEvent("cancel_contract_admin_transfer",
+				sdk.NewAttribute("_contract_address", spec.contract.String()))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+			// and can not be accepted anymore
+			err := k.acceptContractAdmin(ctx, spec.contract, newAdmin)
+			require.ErrorIs(t, err, types.ErrNotFound)
+		})
+	}
+}
+
+func TestSetContractAdminRemovesPendingAdminTransfer(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	newAdmin := RandomAccountAddress(t)
+	require.NoError(t, k.proposeContractAdmin(ctx, example.Contract, example.CreatorAddr, newAdmin, DefaultAuthorizationPolicy{}))
+
+	// when
+	err := k.setContractAdmin(ctx, example.Contract, example.CreatorAddr, RandomAccountAddress(t), DefaultAuthorizationPolicy{})
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, k.GetPendingAdminTransfer(ctx, example.Contract))
+	err = k.acceptContractAdmin(ctx, example.Contract, newAdmin)
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+	"context"
 	"encoding/binary"
 
 	corestoretypes "cosmossdk.io/core/store"
@@ -106,9 +108,15 @@ func (d LimitSimulationGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 	return next(ctx, tx, simulate)
 }
 
+// GasRegisterSource provides the gas register for the current block
+type GasRegisterSource interface {
+	GasRegister(ctx context.Context) types.GasRegister
+}
+
 // GasRegisterDecorator ante decorator to store gas register in the context
 type GasRegisterDecorator struct {
 	gasRegister types.GasRegister
+	source      GasRegisterSource
 }
 
 // NewGasRegisterDecorator constructor.
@@ -116,9 +124,19 @@ func NewGasRegisterDecorator(gr types.GasRegister) *GasRegisterDecorator {
 	return &GasRegisterDecorator{gasRegister: gr}
 }
 
+// NewGasRegisterSourceDecorator constructor that reads the gas register from the source for every tx,
+// so that gas costs changed in the params are applied without restart.
+func NewGasRegisterSourceDecorator(source GasRegisterSource) *GasRegisterDecorator {
+	return &GasRegisterDecorator{source: source}
+}
+
 // AnteHandle adds the gas register to the context.
 func (g GasRegisterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	return next(types.WithGasRegister(ctx, g.gasRegister), tx, simulate)
+	gasRegister := g.gasRegister
+	if g.source != nil {
+		gasRegister = g.source.GasRegister(ctx)
+	}
+	return next(types.WithGasRegister(ctx, gasRegister), tx, simulate)
 }
 
 // TxContractsDecorator implements an AnteHandler that keeps track of which contracts were already accessed during the current transaction. This allows discounting further calls to those contracts, as they are likely to be in the memory cache of the VM already.
@@ -134,3 +152,63 @@ func (d TxContractsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	txContracts := types.NewTxContracts()
 	return next(types.WithTxContracts(ctx, txContracts), tx, simulate)
 }
+
+// FeeShareDecorator implements a PostHandler that pays a share of the tx fee to the withdraw addresses of the
+// registered contracts that were called in the tx. See `types.Params.DeveloperFeeShareBps` for the share.
+type FeeShareDecorator struct {
+	keeper *Keeper
+}
+
+// NewFeeShareDecorator constructor.
+func NewFeeShareDecorator(k *Keeper) *FeeShareDecorator {
+	if k == nil {
+		panic("keeper must not be nil")
+	}
+	return &FeeShareDecorator{keeper: k}
+}
+
+// PostHandle distributes the fee share for successful txs. Payout failures never fail the tx.
+func (d FeeShareDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !success {
+		return next(ctx, tx, simulate, success)
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return next(ctx, tx, simulate, success)
+	}
+	if txContracts, ok := types.TxContractsFromContext(ctx); ok {
+		d.keeper.distributeFeeShares(ctx, feeTx.GetFee(), txContracts.GetContractAddresses())
+	}
+	return next(ctx, tx, simulate, success)
+}
+
+// FeeSponsorshipDecorator implements an AnteHandler that lets a sponsoring contract approve the fee of a tx that it
+// was set as fee granter for. The budgets are checked and the fee is deducted before by the FeeSponsorGrantKeeper.
+// It must run after the signature verification so that contracts are never called for unauthenticated txs.
+type FeeSponsorshipDecorator struct {
+	keeper *Keeper
+}
+
+// NewFeeSponsorshipDecorator constructor.
+func NewFeeSponsorshipDecorator(k *Keeper) *FeeSponsorshipDecorator {
+	if k == nil {
+		panic("keeper must not be nil")
+	}
+	return &FeeSponsorshipDecorator{keeper: k}
+}
+
+// AnteHandle calls the sponsoring contract to approve the fee. The tx is rejected when the contract does not approve.
+func (d FeeSponsorshipDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.FeeGranter() == nil || bytes.Equal(feeTx.FeeGranter(), feeTx.FeePayer()) {
+		return next(ctx, tx, simulate)
+	}
+	sponsorship := d.keeper.GetFeeSponsorship(ctx, feeTx.FeeGranter())
+	if sponsorship == nil {
+		return next(ctx, tx, simulate)
+	}
+	if err := d.keeper.approveFeeSponsorship(ctx, *sponsorship, feeTx.FeePayer(), feeTx.GetFee(), tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
@@ -34,31 +34,65 @@ var (
 )
 
 func humanizeAddress(canon []byte) (string, uint64, error) {
+	return apiCosts{humanize: costHumanize, canonical: costCanonical, validate: costValidate}.humanizeAddress(canon)
+}
+
+func canonicalizeAddress(human string) ([]byte, uint64, error) {
+	return apiCosts{humanize: costHumanize, canonical: costCanonical, validate: costValidate}.canonicalizeAddress(human)
+}
+
+func validateAddress(human string) (uint64, error) {
+	return apiCosts{humanize: costHumanize, canonical: costCanonical, validate: costValidate}.validateAddress(human)
+}
+
+var cosmwasmAPI = wasmvm.GoAPI{
+	HumanizeAddress:     humanizeAddress,
+	CanonicalizeAddress: canonicalizeAddress,
+	ValidateAddress:     validateAddress,
+}
+
+// apiCosts are the costs in CosmWasm gas charged by the address functions of the contract API
+type apiCosts struct {
+	humanize  uint64
+	canonical uint64
+	validate  uint64
+}
+
+// newCosmwasmAPI returns the contract API with the given costs in SDK gas. They are
+// converted to CosmWasm gas with the multiplier.
+func newCosmwasmAPI(humanizeCost, canonicalizeCost, gasMultiplier uint64) wasmvm.GoAPI {
+	c := apiCosts{
+		humanize:  humanizeCost * gasMultiplier,
+		canonical: canonicalizeCost * gasMultiplier,
+		validate:  (humanizeCost + canonicalizeCost) * gasMultiplier,
+	}
+	return wasmvm.GoAPI{
+		HumanizeAddress:     c.humanizeAddress,
+		CanonicalizeAddress: c.canonicalizeAddress,
+		ValidateAddress:     c.validateAddress,
+	}
+}
+
+func (c apiCosts) humanizeAddress(canon []byte) (string, uint64, error) {
 	if err := sdk.VerifyAddressFormat(canon); err != nil {
-		return "", costHumanize, err
+		return "", c.humanize, err
 	}
-	return sdk.AccAddress(canon).String(), costHumanize, nil
+	return sdk.AccAddress(canon).String(), c.humanize, nil
 }
 
-func canonicalizeAddress(human string) ([]byte, uint64, error) {
+func (c apiCosts) canonicalizeAddress(human string) ([]byte, uint64, error) {
 	bz, err := sdk.AccAddressFromBech32(human)
-	return bz, costCanonical, err
+	return bz, c.canonical, err
 }
 
-func validateAddress(human string) (uint64, error) {
+func (c apiCosts) validateAddress(human string) (uint64, error) {
 	canonicalized, err := sdk.AccAddressFromBech32(human)
 	if err != nil {
-		return costCanonical, err
+		return c.canonical, err
 	}
 	// AccAddressFromBech32 already calls VerifyAddressFormat, so we can just humanize and compare
 	if canonicalized.String() != human {
-		return costValidate, errors.New("address not normalized")
+		return c.validate, errors.New("address not normalized")
 	}
-	return costValidate, nil
-}
-
-var cosmwasmAPI = wasmvm.GoAPI{
-	HumanizeAddress:     humanizeAddress,
-	CanonicalizeAddress: canonicalizeAddress,
-	ValidateAddress:     validateAddress,
+	return c.validate, nil
 }
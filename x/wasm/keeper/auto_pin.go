@@ -0,0 +1,346 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// codeExecutionStats is the number of executions of a code within the auto pin window. The size of the wasm code
+// is loaded once when the code becomes a pin candidate and kept while the code has executions in the window.
+type codeExecutionStats struct {
+	executions uint64
+	codeSize   uint64
+}
+
+func (s codeExecutionStats) bytes() []byte {
+	return append(sdk.Uint64ToBigEndian(s.executions), sdk.Uint64ToBigEndian(s.codeSize)...)
+}
+
+func parseCodeExecutionStats(bz []byte) codeExecutionStats {
+	return codeExecutionStats{
+		executions: sdk.BigEndianToUint64(bz[:8]),
+		codeSize:   sdk.BigEndianToUint64(bz[8:]),
+	}
+}
+
+// recordCodeExecution counts an execution of the code in the current block for automatic pinning.
+// The counters are charged to the caller when automatic pinning is enabled.
+func (k Keeper) recordCodeExecution(ctx sdk.Context, codeID uint64) {
+	if !k.GetParams(withoutGasCharge(ctx)).AutoPinEnabled() {
+		return
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	key := types.GetCodeExecutionCountKey(ctx.BlockHeight(), codeID)
+	bz, err := store.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	var executions uint64
+	if bz != nil {
+		executions = sdk.BigEndianToUint64(bz)
+	}
+	if err := store.Set(key, sdk.Uint64ToBigEndian(executions+1)); err != nil {
+		panic(err)
+	}
+	if err := k.addCodeExecutions(ctx, codeID, 1); err != nil {
+		panic(err)
+	}
+}
+
+// addCodeExecutions applies the delta to the executions of the code within the window
+func (k Keeper) addCodeExecutions(ctx context.Context, codeID uint64, delta int64) error {
+	stats := k.getCodeExecutionStats(ctx, codeID)
+	stats.executions = uint64(int64(stats.executions) + delta)
+	return k.setCodeExecutionStats(ctx, codeID, stats)
+}
+
+// setCodeExecutionStats stores the executions of the code within the window and updates the executions index.
+// Codes without executions are removed from the store.
+func (k Keeper) setCodeExecutionStats(ctx context.Context, codeID uint64, stats codeExecutionStats) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if old := k.getCodeExecutionStats(ctx, codeID); old.executions != 0 {
+		if err := store.Delete(types.GetCodeByExecutionCountSecondaryIndexKey(old.executions, codeID)); err != nil {
+			return err
+		}
+	}
+	if stats.executions == 0 {
+		return store.Delete(types.GetCodeExecutionStatsKey(codeID))
+	}
+	if err := store.Set(types.GetCodeExecutionStatsKey(codeID), stats.bytes()); err != nil {
+		return err
+	}
+	return store.Set(types.GetCodeByExecutionCountSecondaryIndexKey(stats.executions, codeID), []byte{})
+}
+
+func (k Keeper) getCodeExecutionStats(ctx context.Context, codeID uint64) codeExecutionStats {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetCodeExecutionStatsKey(codeID))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return codeExecutionStats{}
+	}
+	return parseCodeExecutionStats(bz)
+}
+
+// GetCodeExecutions returns the number of executions of the code within the auto pin window
+func (k Keeper) GetCodeExecutions(ctx context.Context, codeID uint64) uint64 {
+	return k.getCodeExecutionStats(ctx, codeID).executions
+}
+
+// autoPinCodes removes the executions that left the window and pins the most executed codes that fit into the
+// memory budget. Codes that were pinned automatically before and are no longer selected are unpinned. Codes
+// pinned by governance are not touched. Codes with the same number of executions are ordered by the higher
+// code id first. Codes that fail to load, pin or unpin are skipped with an event and retried in the next block.
+func (k Keeper) autoPinCodes(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	// executions of this height and before are outside of the window
+	cutoff := ctx.BlockHeight() - int64(params.AutoPinWindowBlocks)
+	if !params.AutoPinEnabled() {
+		cutoff = ctx.BlockHeight()
+	}
+	if err := k.expireCodeExecutions(ctx, cutoff); err != nil {
+		return errorsmod.Wrap(err, "expire code executions")
+	}
+
+	var (
+		selected = make(map[uint64]struct{})
+		toPin    []types.AutoPinnedCode
+		used     uint64
+	)
+	if params.AutoPinEnabled() {
+		k.iterateCodesByExecutions(ctx, func(codeID, executions uint64) bool {
+			if executions < params.AutoPinMinExecutions {
+				return true
+			}
+			codeSize := k.getAutoPinnedCodeSize(ctx, codeID)
+			if codeSize == 0 {
+				if k.IsPinnedCode(ctx, codeID) {
+					// pinned by governance
+					return false
+				}
+				var err error
+				if codeSize, err = k.loadCodeSize(ctx, codeID); err != nil {
+					k.emitAutoPinFailure(ctx, types.EventTypeAutoPinCodeFailed, codeID, err)
+					return false
+				}
+			}
+			if used+codeSize > params.AutoPinMemoryBudget {
+				return false
+			}
+			used += codeSize
+			selected[codeID] = struct{}{}
+			toPin = append(toPin, types.AutoPinnedCode{CodeID: codeID, CodeSize: codeSize})
+			return uint32(len(selected)) >= params.AutoPinMaxCodes
+		})
+	}
+
+	var toUnpin []types.AutoPinnedCode
+	k.IterateAutoPinnedCodes(ctx, func(c types.AutoPinnedCode) bool {
+		if _, ok := selected[c.CodeID]; !ok {
+			toUnpin = append(toUnpin, c)
+		}
+		return false
+	})
+	for _, c := range toUnpin {
+		if err := k.unpinCode(ctx, c.CodeID); err != nil {
+			k.emitAutoPinFailure(ctx, types.EventTypeAutoUnpinCodeFailed, c.CodeID, err)
+			continue
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeAutoUnpinCode,
+			sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(c.CodeID, 10)),
+			sdk.NewAttribute(types.AttributeKeyExecutions, strconv.FormatUint(k.GetCodeExecutions(ctx, c.CodeID), 10)),
+		))
+	}
+	for _, c := range toPin {
+		if k.getAutoPinnedCodeSize(ctx, c.CodeID) != 0 {
+			continue
+		}
+		cacheCtx, commit := ctx.CacheContext()
+		err := k.pinCode(cacheCtx, c.CodeID)
+		if err == nil {
+			err = k.setAutoPinnedCode(cacheCtx, c)
+		}
+		if err != nil {
+			k.emitAutoPinFailure(ctx, types.EventTypeAutoPinCodeFailed, c.CodeID, err)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeAutoPinCode,
+			sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(c.CodeID, 10)),
+			sdk.NewAttribute(types.AttributeKeyExecutions, strconv.FormatUint(k.GetCodeExecutions(ctx, c.CodeID), 10)),
+			sdk.NewAttribute(types.AttributeKeyCodeSize, strconv.FormatUint(c.CodeSize, 10)),
+		))
+	}
+	return nil
+}
+
+// emitAutoPinFailure logs the error and emits an event for the code that could not be pinned or unpinned
+func (k Keeper) emitAutoPinFailure(ctx sdk.Context, eventType string, codeID uint64, err error) {
+	k.Logger(ctx).Error("automatic code pinning failed", "code_id", codeID, "error", err)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyAutoPinError, redactError(err).Error()),
+	))
+}
+
+// expireCodeExecutions removes the executions recorded at the cutoff height and before
+func (k Keeper) expireCodeExecutions(ctx context.Context, cutoff int64) error {
+	if cutoff <= 0 {
+		return nil
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CodeExecutionCountPrefix)
+	var expired []types.CodeExecutionCount
+	iter := prefixStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(cutoff+1)))
+	for ; iter.Valid(); iter.Next() {
+		expired = append(expired, parseCodeExecutionCount(iter.Key(), iter.Value()))
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	for _, c := range expired {
+		if err := store.Delete(types.GetCodeExecutionCountKey(c.Height, c.CodeID)); err != nil {
+			return err
+		}
+		if err := k.addCodeExecutions(ctx, c.CodeID, -int64(c.Executions)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadCodeSize returns the size of the wasm code and keeps it with the execution stats of the code.
+// The executions index is not modified so that this is safe to call while iterating it.
+func (k Keeper) loadCodeSize(ctx context.Context, codeID uint64) (uint64, error) {
+	stats := k.getCodeExecutionStats(ctx, codeID)
+	if stats.codeSize != 0 {
+		return stats.codeSize, nil
+	}
+	code, err := k.GetByteCode(ctx, codeID)
+	switch {
+	case err != nil:
+		return 0, errorsmod.Wrapf(err, "code id %d", codeID)
+	case len(code) == 0:
+		return 0, types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	stats.codeSize = uint64(len(code))
+	return stats.codeSize, k.storeService.OpenKVStore(ctx).Set(types.GetCodeExecutionStatsKey(codeID), stats.bytes())
+}
+
+// iterateCodesByExecutions iterates over all codes with executions in the window, most executed first.
+// The callback method can return true to abort early.
+func (k Keeper) iterateCodesByExecutions(ctx context.Context, cb func(codeID, executions uint64) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CodesByExecutionCountPrefix)
+	iter := prefixStore.ReverseIterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if cb(sdk.BigEndianToUint64(key[8:]), sdk.BigEndianToUint64(key[:8])) {
+			return
+		}
+	}
+}
+
+// IterateCodeExecutionCounts iterates over the executions per block and code within the window.
+// The callback method can return true to abort early.
+func (k Keeper) IterateCodeExecutionCounts(ctx context.Context, cb func(types.CodeExecutionCount) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CodeExecutionCountPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(parseCodeExecutionCount(iter.Key(), iter.Value())) {
+			return
+		}
+	}
+}
+
+func parseCodeExecutionCount(key, value []byte) types.CodeExecutionCount {
+	return types.CodeExecutionCount{
+		Height:     int64(sdk.BigEndianToUint64(key[:8])),
+		CodeID:     sdk.BigEndianToUint64(key[8:]),
+		Executions: sdk.BigEndianToUint64(value),
+	}
+}
+
+// getAutoPinnedCodeSize returns the accounted code size when the code was pinned automatically or zero
+func (k Keeper) getAutoPinnedCodeSize(ctx context.Context, codeID uint64) uint64 {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetAutoPinnedCodeKey(codeID))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IsAutoPinnedCode returns true when the code was pinned automatically for its usage
+func (k Keeper) IsAutoPinnedCode(ctx context.Context, codeID uint64) bool {
+	return k.getAutoPinnedCodeSize(ctx, codeID) != 0
+}
+
+func (k Keeper) setAutoPinnedCode(ctx context.Context, c types.AutoPinnedCode) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.GetAutoPinnedCodeKey(c.CodeID), sdk.Uint64ToBigEndian(c.CodeSize))
+}
+
+// IterateAutoPinnedCodes iterates over all codes that were pinned automatically ordered by code id.
+// The callback method can return true to abort early.
+func (k Keeper) IterateAutoPinnedCodes(ctx context.Context, cb func(types.AutoPinnedCode) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.AutoPinnedCodePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		c := types.AutoPinnedCode{
+			CodeID:   sdk.BigEndianToUint64(iter.Key()),
+			CodeSize: sdk.BigEndianToUint64(iter.Value()),
+		}
+		if cb(c) {
+			return
+		}
+	}
+}
+
+func (k Keeper) importCodeExecutionCount(ctx context.Context, c types.CodeExecutionCount) error {
+	if err := c.ValidateBasic(); err != nil {
+		return err
+	}
+	if k.GetCodeInfo(ctx, c.CodeID) == nil {
+		return types.ErrNoSuchCodeFn(c.CodeID).Wrapf("code id %d", c.CodeID)
+	}
+	key := types.GetCodeExecutionCountKey(c.Height, c.CodeID)
+	store := k.storeService.OpenKVStore(ctx)
+	if ok, err := store.Has(key); err != nil {
+		return err
+	} else if ok {
+		return errorsmod.Wrapf(types.ErrDuplicate, "code execution count: %d %d", c.Height, c.CodeID)
+	}
+	if err := store.Set(key, sdk.Uint64ToBigEndian(c.Executions)); err != nil {
+		return err
+	}
+	return k.addCodeExecutions(ctx, c.CodeID, int64(c.Executions))
+}
+
+func (k Keeper) importAutoPinnedCode(ctx context.Context, c types.AutoPinnedCode) error {
+	if err := c.ValidateBasic(); err != nil {
+		return err
+	}
+	if !k.IsPinnedCode(ctx, c.CodeID) {
+		return errorsmod.Wrapf(types.ErrInvalid, "auto pinned code %d is not pinned", c.CodeID)
+	}
+	return k.setAutoPinnedCode(ctx, c)
+}
@@ -0,0 +1,306 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestAutoPinCodes(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := k.GetParams(parentCtx)
+	params.AutoPinWindowBlocks = 3
+	params.AutoPinMaxCodes = 1
+	params.AutoPinMemoryBudget = 10 << 20
+	params.AutoPinMinExecutions = 2
+	require.NoError(t, k.SetParams(parentCtx, params))
+
+	codeA := StoreHackatomExampleContract(t, parentCtx, keepers).CodeID
+	codeB := StoreBurnerExampleContract(t, parentCtx, keepers).CodeID
+	govPinned := StoreReflectContract(t, parentCtx, keepers).CodeID
+	require.NoError(t, k.pinCode(parentCtx, govPinned))
+
+	endBlock := func(height int64, executions map[uint64]int) sdk.Events {
+		ctx := parentCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		for codeID, n := range executions {
+			for range n {
+				k.recordCodeExecution(ctx, codeID)
+			}
+		}
+		require.NoError(t, k.autoPinCodes(ctx))
+		return ctx.EventManager().Events()
+	}
+	autoPinEvent := func(codeID uint64, executions string) sdk.Event {
+		code, err := k.GetByteCode(parentCtx, codeID)
+		require.NoError(t, err)
+		return sdk.NewEvent(types.EventTypeAutoPinCode,
+			sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+			sdk.NewAttribute(types.AttributeKeyExecutions, executions),
+			sdk.NewAttribute(types.AttributeKeyCodeSize, strconv.Itoa(len(code))),
+		)
+	}
+
+	// most executed code is pinned, the governance pinned code is skipped
+	events := endBlock(1, map[uint64]int{codeA: 2, codeB: 1, govPinned: 10})
+	assert.True(t, k.IsPinnedCode(parentCtx, codeA))
+	assert.True(t, k.IsAutoPinnedCode(parentCtx, codeA))
+	assert.False(t, k.IsPinnedCode(parentCtx, codeB))
+	assert.False(t, k.IsAutoPinnedCode(parentCtx, govPinned))
+	assert.Contains(t, events, autoPinEvent(codeA, "2"))
+
+	// a code that is executed more replaces it
+	events = endBlock(2, map[uint64]int{codeB: 3})
+	assert.Equal(t, uint64(4), k.GetCodeExecutions(parentCtx, codeB))
+	assert.False(t, k.IsPinnedCode(parentCtx, codeA))
+	assert.True(t, k.IsAutoPinnedCode(parentCtx, codeB))
+	assert.Contains(t, events, sdk.NewEvent(types.EventTypeAutoUnpinCode,
+		sdk.NewAttribute(types.AttributeKeyCodeID, "1"),
+		sdk.NewAttribute(types.AttributeKeyExecutions, "2"),
+	))
+	assert.Contains(t, events, autoPinEvent(codeB, "4"))
+
+	// unchanged within the window
+	events = endBlock(3, nil)
+	assert.True(t, k.IsAutoPinnedCode(parentCtx, codeB))
+	assert.Empty(t, events)
+
+	// executions leave the window
+	endBlock(5, nil)
+	assert.Equal(t, uint64(0), k.GetCodeExecutions(parentCtx, codeB))
+	assert.False(t, k.IsPinnedCode(parentCtx, codeB))
+	assert.False(t, k.IsAutoPinnedCode(parentCtx, codeB))
+	assert.True(t, k.IsPinnedCode(parentCtx, govPinned))
+	var counts []types.CodeExecutionCount
+	k.IterateCodeExecutionCounts(parentCtx, func(c types.CodeExecutionCount) bool {
+		counts = append(counts, c)
+		return false
+	})
+	assert.Empty(t, counts)
+}
+
+func TestRecordCodeExecutionGas(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	codeID := StoreHackatomExampleContract(t, ctx, keepers).CodeID
+	recordGas := func() uint64 {
+		gasBefore := ctx.GasMeter().GasConsumed()
+		k.recordCodeExecution(ctx, codeID)
+		return ctx.GasMeter().GasConsumed() - gasBefore
+	}
+	// disabled by default
+	assert.Zero(t, recordGas())
+
+	// when enabled then the counters are charged
+	params := k.GetParams(ctx)
+	params.AutoPinWindowBlocks = 3
+	params.AutoPinMaxCodes = 1
+	params.AutoPinMemoryBudget = 10 << 20
+	require.NoError(t, k.SetParams(ctx, params))
+	assert.NotZero(t, recordGas())
+}
+
+func TestAutoPinCodesLimits(t *testing.T) {
+	specs := map[string]struct {
+		mutator  func(*types.Params)
+		expCodes int
+	}{
+		"all fit": {
+			mutator:  func(p *types.Params) {},
+			expCodes: 2,
+		},
+		"max codes": {
+			mutator:  func(p *types.Params) { p.AutoPinMaxCodes = 1 },
+			expCodes: 1,
+		},
+		"memory budget": {
+			mutator:  func(p *types.Params) { p.AutoPinMemoryBudget = 1 },
+			expCodes: 0,
+		},
+		"min executions": {
+			mutator:  func(p *types.Params) { p.AutoPinMinExecutions = 2 },
+			expCodes: 0,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			params := k.GetParams(parentCtx)
+			params.AutoPinWindowBlocks = 10
+			params.AutoPinMaxCodes = 10
+			params.AutoPinMemoryBudget = 10 << 20
+			spec.mutator(&params)
+			require.NoError(t, k.SetParams(parentCtx, params))
+			codeIDs := []uint64{
+				StoreHackatomExampleContract(t, parentCtx, keepers).CodeID,
+				StoreBurnerExampleContract(t, parentCtx, keepers).CodeID,
+			}
+			ctx := parentCtx.WithBlockHeight(1)
+			for _, codeID := range codeIDs {
+				k.recordCodeExecution(ctx, codeID)
+			}
+
+			// when
+			require.NoError(t, k.autoPinCodes(ctx))
+
+			// then
+			var pinned int
+			k.IterateAutoPinnedCodes(ctx, func(types.AutoPinnedCode) bool {
+				pinned++
+				return false
+			})
+			assert.Equal(t, spec.expCodes, pinned)
+		})
+	}
+}
+
+func TestAutoPinCodesFailures(t *testing.T) {
+	ok := func(wasmvm.Checksum) error { return nil }
+	fails := func(wasmvm.Checksum) error { return errors.New("testing") }
+	specs := map[string]struct {
+		getCodeErr     error
+		pinFn, unpinFn func(wasmvm.Checksum) error
+		expPinned      bool
+		expEvent       string
+	}{
+		"pinned and unpinned": {
+			pinFn:   ok,
+			unpinFn: ok,
+		},
+		"load code fails": {
+			getCodeErr: errors.New("testing"),
+			expEvent:   types.EventTypeAutoPinCodeFailed,
+		},
+		"pin fails": {
+			pinFn:    fails,
+			expEvent: types.EventTypeAutoPinCodeFailed,
+		},
+		"unpin fails": {
+			pinFn:     ok,
+			unpinFn:   fails,
+			expPinned: true,
+			expEvent:  types.EventTypeAutoUnpinCodeFailed,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var mock wasmtesting.MockWasmEngine
+			wasmtesting.MakeInstantiable(&mock)
+			mock.GetCodeFn = func(wasmvm.Checksum) (wasmvm.WasmCode, error) {
+				return []byte("code"), spec.getCodeErr
+			}
+			mock.PinFn, mock.UnpinFn = spec.pinFn, spec.unpinFn
+			parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			params := k.GetParams(parentCtx)
+			params.AutoPinWindowBlocks = 1
+			params.AutoPinMaxCodes = 1
+			params.AutoPinMemoryBudget = 10 << 20
+			require.NoError(t, k.SetParams(parentCtx, params))
+			codeID := StoreRandomContract(t, parentCtx, keepers, &mock).CodeID
+			ctx := parentCtx.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())
+			k.recordCodeExecution(ctx, codeID)
+
+			// when pinned in the first block and unpinned in the second block
+			require.NoError(t, k.autoPinCodes(ctx))
+			events := ctx.EventManager().Events()
+			ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+			require.NoError(t, k.autoPinCodes(ctx))
+			events = append(events, ctx.EventManager().Events()...)
+
+			// then
+			assert.Equal(t, spec.expPinned, k.IsPinnedCode(ctx, codeID))
+			assert.Equal(t, spec.expPinned, k.IsAutoPinnedCode(ctx, codeID))
+			var gotEvents []string
+			for _, e := range events {
+				if e.Type == types.EventTypeAutoPinCodeFailed || e.Type == types.EventTypeAutoUnpinCodeFailed {
+					gotEvents = append(gotEvents, e.Type)
+				}
+			}
+			if spec.expEvent == "" {
+				assert.Empty(t, gotEvents)
+				return
+			}
+			assert.Equal(t, []string{spec.expEvent}, gotEvents)
+		})
+	}
+}
+
+func TestAutoPinCodesDisabled(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := k.GetParams(parentCtx)
+	params.AutoPinWindowBlocks = 10
+	params.AutoPinMaxCodes = 1
+	params.AutoPinMemoryBudget = 10 << 20
+	require.NoError(t, k.SetParams(parentCtx, params))
+	codeID := StoreHackatomExampleContract(t, parentCtx, keepers).CodeID
+	ctx := parentCtx.WithBlockHeight(1)
+	k.recordCodeExecution(ctx, codeID)
+	require.NoError(t, k.autoPinCodes(ctx))
+	require.True(t, k.IsAutoPinnedCode(ctx, codeID))
+
+	// when
+	params.AutoPinWindowBlocks = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(2)
+	k.recordCodeExecution(ctx, codeID)
+	require.NoError(t, k.autoPinCodes(ctx))
+
+	// then
+	assert.False(t, k.IsPinnedCode(ctx, codeID))
+	assert.False(t, k.IsAutoPinnedCode(ctx, codeID))
+	assert.Equal(t, uint64(0), k.GetCodeExecutions(ctx, codeID))
+}
+
+func TestGovPinTakesOverAutoPinnedCode(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := k.GetParams(parentCtx)
+	params.AutoPinWindowBlocks = 2
+	params.AutoPinMaxCodes = 1
+	params.AutoPinMemoryBudget = 10 << 20
+	require.NoError(t, k.SetParams(parentCtx, params))
+	codeID := StoreHackatomExampleContract(t, parentCtx, keepers).CodeID
+	ctx := parentCtx.WithBlockHeight(1)
+	k.recordCodeExecution(ctx, codeID)
+	require.NoError(t, k.autoPinCodes(ctx))
+	require.True(t, k.IsAutoPinnedCode(ctx, codeID))
+
+	// when
+	require.NoError(t, k.pinCode(ctx, codeID))
+	require.NoError(t, k.autoPinCodes(ctx.WithBlockHeight(10)))
+
+	// then
+	assert.True(t, k.IsPinnedCode(ctx, codeID))
+	assert.False(t, k.IsAutoPinnedCode(ctx, codeID))
+}
+
+func TestRecordCodeExecution(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := k.GetParams(ctx)
+	params.AutoPinWindowBlocks = 10
+	params.AutoPinMaxCodes = 1
+	params.AutoPinMemoryBudget = 10 << 20
+	require.NoError(t, k.SetParams(ctx, params))
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.Equal(t, uint64(1), k.GetCodeExecutions(ctx, example.CodeID))
+
+	// when
+	_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, uint64(2), k.GetCodeExecutions(ctx, example.CodeID))
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// setCodeGasMultiplier stores the gas multiplier of the code. A zero multiplier removes it
+// so that the regular gas price applies again.
+func (k Keeper) setCodeGasMultiplier(ctx context.Context, codeID uint64, multiplierBps uint32) error {
+	if k.GetCodeInfo(ctx, codeID) == nil {
+		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if multiplierBps == 0 {
+		if err := store.Delete(types.GetCodeGasMultiplierKey(codeID)); err != nil {
+			return err
+		}
+	} else {
+		multiplier := types.CodeGasMultiplier{CodeID: codeID, MultiplierBps: multiplierBps}
+		if err := multiplier.ValidateBasic(); err != nil {
+			return err
+		}
+		if err := store.Set(types.GetCodeGasMultiplierKey(codeID), k.cdc.MustMarshal(&multiplier)); err != nil {
+			return err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetCodeGasMultiplier,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyMultiplierBps, strconv.FormatUint(uint64(multiplierBps), 10)),
+	))
+	return nil
+}
+
+// GetCodeGasMultiplier returns the gas multiplier of the code or nil when not set
+func (k Keeper) GetCodeGasMultiplier(ctx context.Context, codeID uint64) *types.CodeGasMultiplier {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetCodeGasMultiplierKey(codeID))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var multiplier types.CodeGasMultiplier
+	k.cdc.MustUnmarshal(bz, &multiplier)
+	return &multiplier
+}
+
+// IterateCodeGasMultipliers iterates over all code gas multipliers ordered by code id.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateCodeGasMultipliers(ctx context.Context, cb func(types.CodeGasMultiplier) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CodeGasMultiplierPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var multiplier types.CodeGasMultiplier
+		k.cdc.MustUnmarshal(iter.Value(), &multiplier)
+		if cb(multiplier) {
+			break
+		}
+	}
+}
+
+// importCodeGasMultiplier stores the gas multiplier for an existing code
+func (k Keeper) importCodeGasMultiplier(ctx context.Context, multiplier types.CodeGasMultiplier) error {
+	if err := multiplier.ValidateBasic(); err != nil {
+		return err
+	}
+	if k.GetCodeInfo(ctx, multiplier.CodeID) == nil {
+		return types.ErrNoSuchCodeFn(multiplier.CodeID).Wrapf("code id %d", multiplier.CodeID)
+	}
+	if k.GetCodeGasMultiplier(ctx, multiplier.CodeID) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "code gas multiplier %d", multiplier.CodeID)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetCodeGasMultiplierKey(multiplier.CodeID), k.cdc.MustMarshal(&multiplier))
+}
+
+// codeGasRegister returns the gas register for the contracts of the code with the gas multiplier
+// of the code applied.
+func (k Keeper) codeGasRegister(ctx context.Context, codeID uint64) types.GasRegister {
+	gasRegister := k.GasRegister(ctx)
+	if multiplier := k.GetCodeGasMultiplier(withoutGasCharge(ctx), codeID); multiplier != nil {
+		return types.NewCodeGasRegister(gasRegister, multiplier.MultiplierBps)
+	}
+	return gasRegister
+}
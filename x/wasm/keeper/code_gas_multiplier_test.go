@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSetCodeGasMultiplier(t *testing.T) {
+	specs := map[string]struct {
+		codeID        uint64
+		multiplierBps uint32
+		preset        bool
+		exp           *types.CodeGasMultiplier
+		expErr        error
+	}{
+		"set": {
+			codeID:        1,
+			multiplierBps: 5_000,
+			exp:           &types.CodeGasMultiplier{CodeID: 1, MultiplierBps: 5_000},
+		},
+		"update": {
+			codeID:        1,
+			multiplierBps: 20_000,
+			preset:        true,
+			exp:           &types.CodeGasMultiplier{CodeID: 1, MultiplierBps: 20_000},
+		},
+		"remove": {
+			codeID: 1,
+			preset: true,
+		},
+		"out of bounds": {
+			codeID:        1,
+			multiplierBps: types.MaxCodeGasMultiplierBps + 1,
+			expErr:        types.ErrInvalid,
+		},
+		"unknown code": {
+			codeID:        99,
+			multiplierBps: 5_000,
+			expErr:        types.ErrNoSuchCodeFn(99),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			StoreHackatomExampleContract(t, parentCtx, keepers)
+			if spec.preset {
+				require.NoError(t, k.setCodeGasMultiplier(parentCtx, 1, 15_000))
+			}
+			ctx := parentCtx.WithEventManager(sdk.NewEventManager())
+
+			// when
+			gotErr := k.setCodeGasMultiplier(ctx, spec.codeID, spec.multiplierBps)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, k.GetCodeGasMultiplier(ctx, spec.codeID))
+			assert.Len(t, ctx.EventManager().Events(), 1)
+		})
+	}
+}
+
+func TestCodeGasMultiplierApplied(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	querySmart := func() storetypes.Gas {
+		qCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := k.QuerySmart(qCtx, example.Contract, []byte(`{"verifier":{}}`))
+		require.NoError(t, err)
+		return qCtx.GasMeter().GasConsumed()
+	}
+	regularGas := querySmart()
+
+	// when discounted
+	require.NoError(t, k.setCodeGasMultiplier(ctx, example.CodeID, 5_000))
+
+	// then
+	assert.Equal(t, types.DefaultInstanceCost/2, k.codeGasRegister(ctx, example.CodeID).SetupContractCost(false, 0))
+	discountedGas := querySmart()
+	assert.Less(t, discountedGas, regularGas)
+
+	// when surcharged
+	require.NoError(t, k.setCodeGasMultiplier(ctx, example.CodeID, 20_000))
+
+	// then
+	assert.Equal(t, types.DefaultInstanceCost*2, k.codeGasRegister(ctx, example.CodeID).SetupContractCost(false, 0))
+	assert.Greater(t, querySmart(), regularGas)
+
+	// and other codes are not affected
+	otherCodeID := StoreReflectContract(t, ctx, keepers).CodeID
+	assert.Equal(t, types.DefaultInstanceCost, k.codeGasRegister(ctx, otherCodeID).SetupContractCost(false, 0))
+
+	// and regular price when removed
+	require.NoError(t, k.setCodeGasMultiplier(ctx, example.CodeID, 0))
+	assert.Equal(t, regularGas, querySmart())
+}
+
+func TestCodeGasMultiplierAppliedToReply(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	mock.ReplyFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, reply wasmvmtypes.Reply, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+	}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	reply := wasmvmtypes.Reply{ID: 1, Result: wasmvmtypes.SubMsgResult{Ok: &wasmvmtypes.SubMsgResponse{Data: []byte("data")}}}
+	replyGas := func() storetypes.Gas {
+		rCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := k.reply(rCtx, example.Contract, reply)
+		require.NoError(t, err)
+		return rCtx.GasMeter().GasConsumed()
+	}
+	regularGas := replyGas()
+
+	// when surcharged
+	require.NoError(t, k.setCodeGasMultiplier(ctx, example.CodeID, 20_000))
+
+	// then the reply costs are doubled
+	assert.Equal(t, regularGas+k.GasRegister(ctx).ReplyCosts(true, reply), replyGas())
+}
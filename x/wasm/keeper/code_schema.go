@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+const (
+	// schemaCompileCostPerByte is the SDK gas charged per uncompressed schema byte to parse and compile the schema
+	schemaCompileCostPerByte storetypes.Gas = 1
+	// schemaValidationStepCost is the SDK gas charged per schema node visited to validate a contract message
+	schemaValidationStepCost storetypes.Gas = 10
+)
+
+// setCodeSchema replaces the JSON schema of the contract messages of a code. Only the code creator is
+// authorized. An empty schema removes it.
+func (k Keeper) setCodeSchema(ctx context.Context, codeID uint64, caller sdk.AccAddress, schema []byte, validateMessages bool) error {
+	info := k.GetCodeInfo(ctx, codeID)
+	if info == nil {
+		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	if info.Creator != caller.String() {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify code schema")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if len(schema) == 0 {
+		if validateMessages {
+			return errorsmod.Wrap(types.ErrInvalid, "message validation requires a schema")
+		}
+		if err := store.Delete(types.GetCodeSchemaKey(codeID)); err != nil {
+			return err
+		}
+	} else {
+		if _, err := k.parseCodeSchema(ctx, schema); err != nil {
+			return err
+		}
+		codeSchema := types.CodeSchema{CodeID: codeID, Schema: schema, ValidateMessages: validateMessages}
+		if err := store.Set(types.GetCodeSchemaKey(codeID), k.cdc.MustMarshal(&codeSchema)); err != nil {
+			return err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetCodeSchema,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyValidateMessages, strconv.FormatBool(validateMessages)),
+	))
+	return nil
+}
+
+// GetCodeSchema returns the compressed JSON schema of the code or nil when not set
+func (k Keeper) GetCodeSchema(ctx context.Context, codeID uint64) *types.CodeSchema {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetCodeSchemaKey(codeID))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var schema types.CodeSchema
+	k.cdc.MustUnmarshal(bz, &schema)
+	return &schema
+}
+
+// IterateCodeSchemas iterates over all code schemas ordered by code id.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateCodeSchemas(ctx context.Context, cb func(types.CodeSchema) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CodeSchemaPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var schema types.CodeSchema
+		k.cdc.MustUnmarshal(iter.Value(), &schema)
+		if cb(schema) {
+			break
+		}
+	}
+}
+
+// importCodeSchema stores the schema for an existing code
+func (k Keeper) importCodeSchema(ctx context.Context, schema types.CodeSchema) error {
+	if err := schema.ValidateBasic(); err != nil {
+		return err
+	}
+	if k.GetCodeInfo(ctx, schema.CodeID) == nil {
+		return types.ErrNoSuchCodeFn(schema.CodeID).Wrapf("code id %d", schema.CodeID)
+	}
+	if k.GetCodeSchema(ctx, schema.CodeID) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "code schema %d", schema.CodeID)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetCodeSchemaKey(schema.CodeID), k.cdc.MustMarshal(&schema))
+}
+
+// parseCodeSchema uncompresses and compiles a gzip compressed schema. The gas for the compressed
+// and the uncompressed size is charged before the schema is parsed.
+func (k Keeper) parseCodeSchema(ctx context.Context, schema []byte) (*types.ContractSchema, error) {
+	gasMeter := sdk.UnwrapSDKContext(ctx).GasMeter()
+	gasMeter.ConsumeGas(k.GasRegister(ctx).UncompressCosts(len(schema)), "Uncompress gzip schema")
+	bz, err := types.UncompressContractSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	gasMeter.ConsumeGas(schemaCompileCostPerByte*storetypes.Gas(len(bz)), "Compile contract schema")
+	return types.ParseContractSchema(bz)
+}
+
+// validateContractMsg validates the message against the schema of the code when message validation is
+// enabled for the code.
+func (k Keeper) validateContractMsg(ctx context.Context, codeID uint64, entryPoint string, msg []byte) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	codeSchema := k.GetCodeSchema(withoutGasCharge(ctx), codeID)
+	if codeSchema == nil || !codeSchema.ValidateMessages {
+		return nil
+	}
+	schema, err := k.parseCodeSchema(ctx, codeSchema.Schema)
+	if err != nil {
+		return errorsmod.Wrapf(err, "code schema %d", codeID)
+	}
+	steps, err := schema.ValidateMessage(entryPoint, msg)
+	sdkCtx.GasMeter().ConsumeGas(steps*schemaValidationStepCost, "Validate contract message with schema")
+	return err
+}
+
+// validateExecuteMsg validates the execute message against the schema of the contract code. See
+// validateContractMsg.
+func (k Keeper) validateExecuteMsg(ctx context.Context, contractAddr sdk.AccAddress, msg []byte) error {
+	contractInfo := k.GetContractInfo(withoutGasCharge(ctx), contractAddr)
+	if contractInfo == nil {
+		// handled by the execution
+		return nil
+	}
+	return k.validateContractMsg(ctx, contractInfo.CodeID, types.SchemaEntryPointExecute, msg)
+}
@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+const hackatomTestSchema = `{
+  "contract_name": "hackatom",
+  "instantiate": {
+    "type": "object",
+    "required": ["beneficiary", "verifier"],
+    "properties": {
+      "beneficiary": {"type": "string"},
+      "verifier": {"type": "string"}
+    },
+    "additionalProperties": false
+  },
+  "execute": {
+    "oneOf": [
+      {
+        "type": "object",
+        "required": ["release"],
+        "properties": {"release": {"type": "object", "additionalProperties": false}},
+        "additionalProperties": false
+      }
+    ]
+  }
+}`
+
+func compressedHackatomTestSchema(t testing.TB) []byte {
+	t.Helper()
+	compressed, err := ioutils.GzipIt([]byte(hackatomTestSchema))
+	require.NoError(t, err)
+	return compressed
+}
+
+func TestSetCodeSchema(t *testing.T) {
+	specs := map[string]struct {
+		codeID           uint64
+		caller           func(example ExampleContract) sdk.AccAddress
+		schema           []byte
+		validateMessages bool
+		preset           bool
+		expErr           error
+	}{
+		"set": {
+			codeID:           1,
+			caller:           func(example ExampleContract) sdk.AccAddress { return example.CreatorAddr },
+			schema:           compressedHackatomTestSchema(t),
+			validateMessages: true,
+		},
+		"update": {
+			codeID: 1,
+			caller: func(example ExampleContract) sdk.AccAddress { return example.CreatorAddr },
+			schema: compressedHackatomTestSchema(t),
+			preset: true,
+		},
+		"remove": {
+			codeID: 1,
+			caller: func(example ExampleContract) sdk.AccAddress { return example.CreatorAddr },
+			preset: true,
+		},
+		"remove with validation": {
+			codeID:           1,
+			caller:           func(example ExampleContract) sdk.AccAddress { return example.CreatorAddr },
+			validateMessages: true,
+			preset:           true,
+			expErr:           types.ErrInvalid,
+		},
+		"not creator": {
+			codeID: 1,
+			caller: func(ExampleContract) sdk.AccAddress { return RandomAccountAddress(t) },
+			schema: compressedHackatomTestSchema(t),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown code": {
+			codeID: 99,
+			caller: func(example ExampleContract) sdk.AccAddress { return example.CreatorAddr },
+			schema: compressedHackatomTestSchema(t),
+			expErr: types.ErrNoSuchCodeFn(99),
+		},
+		"not compressed": {
+			codeID: 1,
+			caller: func(example ExampleContract) sdk.AccAddress { return example.CreatorAddr },
+			schema: []byte(hackatomTestSchema),
+			expErr: types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			example := StoreHackatomExampleContract(t, parentCtx, keepers)
+			if spec.preset {
+				require.NoError(t, k.setCodeSchema(parentCtx, 1, example.CreatorAddr, compressedHackatomTestSchema(t), true))
+			}
+			ctx := parentCtx.WithEventManager(sdk.NewEventManager())
+
+			// when
+			gotErr := k.setCodeSchema(ctx, spec.codeID, spec.caller(example), spec.schema, spec.validateMessages)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Len(t, ctx.EventManager().Events(), 1)
+			if len(spec.schema) == 0 {
+				assert.Nil(t, k.GetCodeSchema(ctx, spec.codeID))
+				return
+			}
+			exp := &types.CodeSchema{CodeID: spec.codeID, Schema: spec.schema, ValidateMessages: spec.validateMessages}
+			assert.Equal(t, exp, k.GetCodeSchema(ctx, spec.codeID))
+		})
+	}
+}
+
+func TestCodeSchemaMessageValidation(t *testing.T) {
+	specs := map[string]struct {
+		validateMessages bool
+		msg              []byte
+		expErr           error
+	}{
+		"valid msg": {
+			validateMessages: true,
+			msg:              []byte(`{"release":{}}`),
+		},
+		"unknown variant rejected": {
+			validateMessages: true,
+			msg:              []byte(`{"burn":{}}`),
+			expErr:           types.ErrSchemaViolation,
+		},
+		"unknown variant handled by contract without validation": {
+			msg:    []byte(`{"burn":{}}`),
+			expErr: types.ErrExecuteFailed,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			require.NoError(t, k.setCodeSchema(ctx, example.CodeID, example.CreatorAddr, compressedHackatomTestSchema(t), spec.validateMessages))
+
+			// when
+			_, gotErr := NewMsgServerImpl(k).ExecuteContract(ctx, &types.MsgExecuteContract{
+				Sender:   example.VerifierAddr.String(),
+				Contract: example.Contract.String(),
+				Msg:      spec.msg,
+			})
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestCodeSchemaInstantiateValidation(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, k.setCodeSchema(ctx, example.CodeID, example.CreatorAddr, compressedHackatomTestSchema(t), true))
+
+	_, err := NewMsgServerImpl(k).InstantiateContract(ctx, &types.MsgInstantiateContract{
+		Sender: example.CreatorAddr.String(),
+		CodeID: example.CodeID,
+		Label:  "invalid",
+		Msg:    []byte(`{"verifier":"foo"}`),
+	})
+	require.ErrorIs(t, err, types.ErrSchemaViolation)
+	assert.Contains(t, err.Error(), `missing field "beneficiary"`)
+}
+
+func TestCodeSchemaWithoutValidationGas(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	validateGas := func() storetypes.Gas {
+		gCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		require.NoError(t, k.validateExecuteMsg(gCtx, example.Contract, []byte(`{"release":{}}`)))
+		return gCtx.GasMeter().GasConsumed()
+	}
+	// no schema
+	assert.Zero(t, validateGas())
+
+	// schema without validation
+	require.NoError(t, k.setCodeSchema(ctx, example.CodeID, example.CreatorAddr, compressedHackatomTestSchema(t), false))
+	assert.Zero(t, validateGas())
+
+	// schema with validation charges for the uncompressed schema
+	require.NoError(t, k.setCodeSchema(ctx, example.CodeID, example.CreatorAddr, compressedHackatomTestSchema(t), true))
+	assert.Greater(t, validateGas(), schemaCompileCostPerByte*storetypes.Gas(len(hackatomTestSchema)))
+}
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// registerContractName claims the name for the contract. A name that was claimed by the contract before is
+// released. Registering the current name of the contract again is a no-op.
+func (k Keeper) registerContractName(ctx context.Context, contractAddr, caller sdk.AccAddress, name string, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddr)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if err := types.ValidateContractName(name); err != nil {
+		return errorsmod.Wrap(err, "name")
+	}
+	if owner := k.GetContractByName(sdkCtx, name); owner != nil {
+		if owner.Equals(contractAddr) {
+			return nil
+		}
+		return errorsmod.Wrapf(types.ErrDuplicate, "contract name %s", name)
+	}
+	if oldName := k.GetContractName(sdkCtx, contractAddr); oldName != "" {
+		if err := k.deleteContractName(sdkCtx, contractAddr, oldName); err != nil {
+			return err
+		}
+	}
+	if err := k.storeContractName(sdkCtx, contractAddr, name); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterContractName,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyContractName, name),
+	))
+	return nil
+}
+
+// transferContractName moves the name to the new contract. The caller must be authorized to modify the
+// current and the new contract. The new contract must not have a name claimed.
+func (k Keeper) transferContractName(ctx context.Context, name string, caller, newContractAddr sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	owner := k.GetContractByName(sdkCtx, name)
+	if owner == nil {
+		return errorsmod.Wrapf(types.ErrNotFound, "contract name %s", name)
+	}
+	if owner.Equals(newContractAddr) {
+		return errorsmod.Wrap(types.ErrInvalid, "name is claimed by the new contract already")
+	}
+	ownerInfo := k.GetContractInfo(sdkCtx, owner)
+	if ownerInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(ownerInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	newContractInfo := k.GetContractInfo(sdkCtx, newContractAddr)
+	if newContractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown new contract")
+	}
+	if !authZ.CanModifyContract(newContractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify new contract")
+	}
+	if existing := k.GetContractName(sdkCtx, newContractAddr); existing != "" {
+		return errorsmod.Wrapf(types.ErrDuplicate, "new contract has name %s", existing)
+	}
+	if err := k.deleteContractName(sdkCtx, owner, name); err != nil {
+		return err
+	}
+	if err := k.storeContractName(sdkCtx, newContractAddr, name); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferContractName,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, newContractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyContractName, name),
+		sdk.NewAttribute(types.AttributeKeyPreviousContract, owner.String()),
+	))
+	return nil
+}
+
+// releaseContractName removes the claimed name so that it can be registered again
+func (k Keeper) releaseContractName(ctx context.Context, name string, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	owner := k.GetContractByName(sdkCtx, name)
+	if owner == nil {
+		return errorsmod.Wrapf(types.ErrNotFound, "contract name %s", name)
+	}
+	contractInfo := k.GetContractInfo(sdkCtx, owner)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if err := k.deleteContractName(sdkCtx, owner, name); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReleaseContractName,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyContractName, name),
+	))
+	return nil
+}
+
+// importContractName stores the name claim for an existing contract
+func (k Keeper) importContractName(ctx context.Context, contractName types.ContractName) error {
+	if err := contractName.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(contractName.Contract)
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return types.ErrNoSuchContractFn(contractName.Contract).Wrapf("address %s", contractName.Contract)
+	}
+	if k.GetContractByName(ctx, contractName.Name) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "contract name %s", contractName.Name)
+	}
+	if k.GetContractName(ctx, contractAddr) != "" {
+		return errorsmod.Wrapf(types.ErrDuplicate, "named contract %s", contractName.Contract)
+	}
+	return k.storeContractName(ctx, contractAddr, contractName.Name)
+}
+
+// storeContractName persists the name with the reverse lookup index
+func (k Keeper) storeContractName(ctx context.Context, contractAddr sdk.AccAddress, name string) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetContractNameKey(name), contractAddr); err != nil {
+		return err
+	}
+	return store.Set(types.GetContractNameByAddressKey(contractAddr), []byte(name))
+}
+
+// deleteContractName removes the name with the reverse lookup index
+func (k Keeper) deleteContractName(ctx context.Context, contractAddr sdk.AccAddress, name string) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetContractNameKey(name)); err != nil {
+		return err
+	}
+	return store.Delete(types.GetContractNameByAddressKey(contractAddr))
+}
+
+// GetContractByName returns the address of the contract that claimed the name or nil when not found
+func (k Keeper) GetContractByName(ctx context.Context, name string) sdk.AccAddress {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetContractNameKey(name))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	return bz
+}
+
+// GetContractName returns the name claimed by the contract or an empty string when none
+func (k Keeper) GetContractName(ctx context.Context, contractAddr sdk.AccAddress) string {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetContractNameByAddressKey(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// IterateContractNames iterates over all claimed contract names ordered by name.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateContractNames(ctx context.Context, cb func(types.ContractName) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ContractNamePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		contractName := types.ContractName{
+			Name:     string(iter.Key()),
+			Contract: sdk.AccAddress(iter.Value()).String(),
+		}
+		if cb(contractName) {
+			break
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestRegisterContractName(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	named := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	require.NoError(t, k.importContractName(parentCtx, types.ContractName{Name: "taken", Contract: named.Contract.String()}))
+
+	specs := map[string]struct {
+		contract   sdk.AccAddress
+		caller     sdk.AccAddress
+		policy     types.AuthorizationPolicy
+		name       string
+		expErr     *errorsmod.Error
+		expNoEvent bool
+		expOldFree string
+	}{
+		"admin": {
+			contract: example.Contract,
+			caller:   example.CreatorAddr,
+			policy:   DefaultAuthorizationPolicy{},
+			name:     "mantra.dex.pool-factory",
+		},
+		"gov": {
+			contract: example.Contract,
+			caller:   RandomAccountAddress(t),
+			policy:   GovAuthorizationPolicy{},
+			name:     "mantra.dex.pool-factory",
+		},
+		"replaces existing name": {
+			contract:   named.Contract,
+			caller:     named.CreatorAddr,
+			policy:     DefaultAuthorizationPolicy{},
+			name:       "new-name",
+			expOldFree: "taken",
+		},
+		"same name again": {
+			contract:   named.Contract,
+			caller:     named.CreatorAddr,
+			policy:     DefaultAuthorizationPolicy{},
+			name:       "taken",
+			expNoEvent: true,
+		},
+		"name taken by other contract": {
+			contract: example.Contract,
+			caller:   example.CreatorAddr,
+			policy:   DefaultAuthorizationPolicy{},
+			name:     "taken",
+			expErr:   types.ErrDuplicate,
+		},
+		"invalid name": {
+			contract: example.Contract,
+			caller:   example.CreatorAddr,
+			policy:   DefaultAuthorizationPolicy{},
+			name:     "Invalid",
+			expErr:   types.ErrInvalid,
+		},
+		"other address": {
+			contract: example.Contract,
+			caller:   RandomAccountAddress(t),
+			policy:   DefaultAuthorizationPolicy{},
+			name:     "mantra.dex.pool-factory",
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			contract: RandomAccountAddress(t),
+			caller:   example.CreatorAddr,
+			policy:   GovAuthorizationPolicy{},
+			name:     "mantra.dex.pool-factory",
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.registerContractName(ctx.WithEventManager(em), spec.contract, spec.caller, spec.name, spec.policy)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.contract, k.GetContractByName(ctx, spec.name))
+			assert.Equal(t, spec.name, k.GetContractName(ctx, spec.contract))
+			if spec.expOldFree != "" {
+				assert.Nil(t, k.GetContractByName(ctx, spec.expOldFree))
+			}
+			if spec.expNoEvent {
+				assert.Empty(t, em.Events())
+				return
+			}
+			expEvt := sdk.NewEvent("register_contract_name",
+				sdk.NewAttribute("_contract_address", spec.contract.String()),
+				sdk.NewAttribute("contract_name", spec.name))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+			// and resolved by queries
+			rsp, err := Querier(k).ContractByName(ctx, &types.QueryContractByNameRequest{Name: spec.name})
+			require.NoError(t, err)
+			assert.Equal(t, spec.contract.String(), rsp.Address)
+			infoRsp, err := Querier(k).ContractInfo(ctx, &types.QueryContractInfoRequest{Address: spec.contract.String()})
+			require.NoError(t, err)
+			assert.Equal(t, spec.name, infoRsp.Name)
+		})
+	}
+}
+
+func TestTransferContractName(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	const myName = "mantra.dex.pool-factory"
+	require.NoError(t, k.importContractName(parentCtx, types.ContractName{Name: myName, Contract: example.Contract.String()}))
+	// a contract with the same admin
+	newContract := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	require.NoError(t, k.setContractAdmin(parentCtx, newContract.Contract, newContract.CreatorAddr, example.CreatorAddr, DefaultAuthorizationPolicy{}))
+	otherAdminContract := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	namedContract := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	require.NoError(t, k.setContractAdmin(parentCtx, namedContract.Contract, namedContract.CreatorAddr, example.CreatorAddr, DefaultAuthorizationPolicy{}))
+	require.NoError(t, k.importContractName(parentCtx, types.ContractName{Name: "other", Contract: namedContract.Contract.String()}))
+
+	specs := map[string]struct {
+		name        string
+		caller      sdk.AccAddress
+		newContract sdk.AccAddress
+		policy      types.AuthorizationPolicy
+		expErr      *errorsmod.Error
+	}{
+		"admin of both contracts": {
+			name:        myName,
+			caller:      example.CreatorAddr,
+			newContract: newContract.Contract,
+			policy:      DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			name:        myName,
+			caller:      RandomAccountAddress(t),
+			newContract: otherAdminContract.Contract,
+			policy:      GovAuthorizationPolicy{},
+		},
+		"not admin of new contract": {
+			name:        myName,
+			caller:      example.CreatorAddr,
+			newContract: otherAdminContract.Contract,
+			policy:      DefaultAuthorizationPolicy{},
+			expErr:      sdkerrors.ErrUnauthorized,
+		},
+		"not admin of current contract": {
+			name:        myName,
+			caller:      newContract.CreatorAddr,
+			newContract: newContract.Contract,
+			policy:      DefaultAuthorizationPolicy{},
+			expErr:      sdkerrors.ErrUnauthorized,
+		},
+		"new contract has a name": {
+			name:        myName,
+			caller:      example.CreatorAddr,
+			newContract: namedContract.Contract,
+			policy:      DefaultAuthorizationPolicy{},
+			expErr:      types.ErrDuplicate,
+		},
+		"same contract": {
+			name:        myName,
+			caller:      example.CreatorAddr,
+			newContract: example.Contract,
+			policy:      DefaultAuthorizationPolicy{},
+			expErr:      types.ErrInvalid,
+		},
+		"unknown new contract": {
+			name:        myName,
+			caller:      example.CreatorAddr,
+			newContract: RandomAccountAddress(t),
+			policy:      GovAuthorizationPolicy{},
+			expErr:      sdkerrors.ErrInvalidRequest,
+		},
+		"unknown name": {
+			name:        "unknown",
+			caller:      example.CreatorAddr,
+			newContract: newContract.Contract,
+			policy:      GovAuthorizationPolicy{},
+			expErr:      types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.transferContractName(ctx.WithEventManager(em), spec.name, spec.caller, spec.newContract, spec.policy)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, em.Events())
+				assert.Equal(t, example.Contract, k.GetContractByName(ctx, myName))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.newContract, k.GetContractByName(ctx, spec.name))
+			assert.Equal(t, spec.name, k.GetContractName(ctx, spec.newContract))
+			assert.Empty(t, k.GetContractName(ctx, example.Contract))
+			expEvt := sdk.NewEvent("transfer_contract_name",
+				sdk.NewAttribute("_contract_address", spec.newContract.String()),
+				sdk.NewAttribute("contract_name", spec.name),
+				sdk.NewAttribute("previous_contract_address", example.Contract.String()))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+		})
+	}
+}
+
+func TestReleaseContractName(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	const myName = "mantra.dex.pool-factory"
+	require.NoError(t, k.importContractName(parentCtx, types.ContractName{Name: myName, Contract: example.Contract.String()}))
+
+	specs := map[string]struct {
+		name   string
+		caller sdk.AccAddress
+		policy types.AuthorizationPolicy
+		expErr *errorsmod.Error
+	}{
+		"admin": {
+			name:   myName,
+			caller: example.CreatorAddr,
+			policy: DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			name:   myName,
+			caller: RandomAccountAddress(t),
+			policy: GovAuthorizationPolicy{},
+		},
+		"other address": {
+			name:   myName,
+			caller: RandomAccountAddress(t),
+			policy: DefaultAuthorizationPolicy{},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown name": {
+			name:   "unknown",
+			caller: example.CreatorAddr,
+			policy: GovAuthorizationPolicy{},
+			expErr: types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.releaseContractName(ctx.WithEventManager(em), spec.name, spec.caller, spec.policy)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, k.GetContractByName(ctx, spec.name))
+			assert.Empty(t, k.GetContractName(ctx, example.Contract))
+			expEvt := sdk.NewEvent("release_contract_name",
+				sdk.NewAttribute("_contract_address", example.Contract.String()),
+				sdk.NewAttribute("contract_name", spec.name))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+			// and can be registered again
+			require.NoError(t, k.registerContractName(ctx, example.Contract, example.CreatorAddr, spec.name, DefaultAuthorizationPolicy{}))
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// registerCronSchedule stores a new schedule. The first execution is one interval after the current block.
+func (k Keeper) registerCronSchedule(ctx context.Context, schedule types.CronSchedule) error {
+	if err := k.validateNewCronSchedule(ctx, schedule); err != nil {
+		return err
+	}
+	schedule.NextHeight = uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) + schedule.Interval
+	schedule.ErrorCount = 0
+	schedule.Disabled = false
+	if err := k.setCronSchedule(ctx, schedule); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterCronSchedule,
+		sdk.NewAttribute(types.AttributeKeyCronSchedule, schedule.Name),
+		sdk.NewAttribute(types.AttributeKeyContractAddr, schedule.Contract),
+	))
+	return nil
+}
+
+// removeCronSchedule deletes the schedule
+func (k Keeper) removeCronSchedule(ctx context.Context, name string) error {
+	schedule := k.GetCronSchedule(ctx, name)
+	if schedule == nil {
+		return errorsmod.Wrapf(types.ErrNotFound, "cron schedule %s", name)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetCronScheduleKey(name)); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveCronSchedule,
+		sdk.NewAttribute(types.AttributeKeyCronSchedule, name),
+		sdk.NewAttribute(types.AttributeKeyContractAddr, schedule.Contract),
+	))
+	return nil
+}
+
+// importCronSchedule stores the schedule with its execution state as is
+func (k Keeper) importCronSchedule(ctx context.Context, schedule types.CronSchedule) error {
+	if err := k.validateNewCronSchedule(ctx, schedule); err != nil {
+		return err
+	}
+	return k.setCronSchedule(ctx, schedule)
+}
+
+// validateNewCronSchedule ensures the schedule is valid, the contract exists and the name is not used
+func (k Keeper) validateNewCronSchedule(ctx context.Context, schedule types.CronSchedule) error {
+	if err := schedule.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(schedule.Contract)
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return types.ErrNoSuchContractFn(schedule.Contract).Wrapf("address %s", schedule.Contract)
+	}
+	if k.GetCronSchedule(ctx, schedule.Name) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "cron schedule %s", schedule.Name)
+	}
+	return nil
+}
+
+func (k Keeper) setCronSchedule(ctx context.Context, schedule types.CronSchedule) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetCronScheduleKey(schedule.Name), k.cdc.MustMarshal(&schedule))
+}
+
+// GetCronSchedule returns the schedule for the given name or nil when not found
+func (k Keeper) GetCronSchedule(ctx context.Context, name string) *types.CronSchedule {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetCronScheduleKey(name))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var schedule types.CronSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return &schedule
+}
+
+// IterateCronSchedules iterates over all schedules ordered by name. Iteration stops when the callback returns true.
+func (k Keeper) IterateCronSchedules(ctx context.Context, cb func(types.CronSchedule) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CronSchedulePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var schedule types.CronSchedule
+		k.cdc.MustUnmarshal(iter.Value(), &schedule)
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// executeCronSchedules runs all enabled schedules that are due in the current block, longest overdue first and
+// then ordered by name. Each execution is isolated so that a failure does not affect other schedules or the block.
+// Schedules that do not fit into MaxCronGasPerBlock are deferred to the next block.
+func (k Keeper) executeCronSchedules(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+	var due []types.CronSchedule
+	k.IterateCronSchedules(ctx, func(schedule types.CronSchedule) bool {
+		if !schedule.Disabled && schedule.NextHeight <= height {
+			due = append(due, schedule)
+		}
+		return false
+	})
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].NextHeight < due[j].NextHeight
+	})
+	var reservedGas uint64
+	for _, schedule := range due {
+		if reservedGas+schedule.GasLimit > types.MaxCronGasPerBlock {
+			k.Logger(ctx).Debug("cron schedule deferred", "name", schedule.Name, "height", height)
+			continue
+		}
+		reservedGas += schedule.GasLimit
+		err := k.executeCronSchedule(ctx, schedule)
+
+		schedule.NextHeight = height + schedule.Interval
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCronSchedule, schedule.Name),
+			sdk.NewAttribute(types.AttributeKeyContractAddr, schedule.Contract),
+			sdk.NewAttribute(types.AttributeKeyCronSuccess, strconv.FormatBool(err == nil)),
+		}
+		if err == nil {
+			schedule.ErrorCount = 0
+		} else {
+			schedule.ErrorCount++
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyCronError, redactError(err).Error()))
+			if schedule.MaxErrors != 0 && schedule.ErrorCount >= schedule.MaxErrors {
+				schedule.Disabled = true
+				attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyCronDisabled, "true"))
+			}
+			k.Logger(ctx).Debug("cron schedule execution failed", "name", schedule.Name, "error", err)
+		}
+		if err := k.setCronSchedule(ctx, schedule); err != nil {
+			panic(err)
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCronExecution, attrs...))
+	}
+}
+
+// executeCronSchedule calls sudo on the contract limited to the schedule gas limit
+func (k Keeper) executeCronSchedule(ctx sdk.Context, schedule types.CronSchedule) error {
+	contractAddr, err := sdk.AccAddressFromBech32(schedule.Contract)
+	if err != nil {
+		return err
+	}
+	_, err = k.sudoWithGasLimit(ctx, contractAddr, schedule.Msg, schedule.GasLimit)
+	return err
+}
@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestRegisterCronSchedule(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	parentCtx = parentCtx.WithBlockHeight(10)
+	require.NoError(t, k.registerCronSchedule(parentCtx, types.CronSchedule{
+		Name: "existing", Contract: example.Contract.String(), Msg: []byte(`{}`), Interval: 1, GasLimit: 1,
+	}))
+
+	specs := map[string]struct {
+		src    types.CronSchedule
+		expErr *errorsmod.Error
+	}{
+		"all good": {
+			src: types.CronSchedule{Name: "my-schedule", Contract: example.Contract.String(), Msg: []byte(`{}`), Interval: 5, GasLimit: 100_000, MaxErrors: 3},
+		},
+		"execution state is reset": {
+			src: types.CronSchedule{Name: "my-schedule", Contract: example.Contract.String(), Msg: []byte(`{}`), Interval: 5, GasLimit: 100_000, NextHeight: 1, ErrorCount: 2, Disabled: true},
+		},
+		"unknown contract": {
+			src:    types.CronSchedule{Name: "my-schedule", Contract: RandomBech32AccountAddress(t), Msg: []byte(`{}`), Interval: 5, GasLimit: 100_000},
+			expErr: types.ErrNoSuchContractFn("").Unwrap().(*errorsmod.Error),
+		},
+		"duplicate name": {
+			src:    types.CronSchedule{Name: "existing", Contract: example.Contract.String(), Msg: []byte(`{}`), Interval: 5, GasLimit: 100_000},
+			expErr: types.ErrDuplicate,
+		},
+		"invalid schedule": {
+			src:    types.CronSchedule{Name: "my-schedule", Contract: example.Contract.String(), Msg: []byte(`{}`), GasLimit: 100_000},
+			expErr: types.ErrEmpty,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.registerCronSchedule(ctx.WithEventManager(em), spec.src)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			exp := spec.src
+			exp.NextHeight, exp.ErrorCount, exp.Disabled = 15, 0, false
+			assert.Equal(t, &exp, k.GetCronSchedule(ctx, spec.src.Name))
+			expEvt := sdk.NewEvent("register_cron_schedule",
+				sdk.NewAttribute("schedule_name", spec.src.Name),
+				sdk.NewAttribute("_contract_address", spec.src.Contract))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+
+			// and when removed
+			em = sdk.NewEventManager()
+			require.NoError(t, k.removeCronSchedule(ctx.WithEventManager(em), spec.src.Name))
+			assert.Nil(t, k.GetCronSchedule(ctx, spec.src.Name))
+			expEvt = sdk.NewEvent("remove_cron_schedule",
+				sdk.NewAttribute("schedule_name", spec.src.Name),
+				sdk.NewAttribute("_contract_address", spec.src.Contract))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+			// and can not be removed twice
+			require.ErrorIs(t, k.removeCronSchedule(ctx, spec.src.Name), types.ErrNotFound)
+		})
+	}
+}
+
+func TestExecuteCronSchedules(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	recipient := RandomAccountAddress(t)
+	stealMsg := []byte(fmt.Sprintf(`{"steal_funds":{"recipient":%q,"amount":[{"denom":"denom","amount":"10"}]}}`, recipient.String()))
+	const gasLimit = 500_000
+
+	ctx = ctx.WithBlockHeight(1)
+	for _, s := range []types.CronSchedule{
+		{Name: "a-every-second-block", Contract: example.Contract.String(), Msg: stealMsg, Interval: 2, GasLimit: gasLimit},
+		{Name: "b-invalid-msg", Contract: example.Contract.String(), Msg: []byte(`{"unknown":{}}`), Interval: 1, GasLimit: gasLimit, MaxErrors: 2},
+		{Name: "c-out-of-gas", Contract: example.Contract.String(), Msg: stealMsg, Interval: 1, GasLimit: 1},
+	} {
+		require.NoError(t, k.registerCronSchedule(ctx, s))
+	}
+
+	executionEvent := func(name string, success bool, extraAttrs ...sdk.Attribute) sdk.Event {
+		return sdk.NewEvent("cron_execution", append([]sdk.Attribute{
+			sdk.NewAttribute("module", "wasm"),
+			sdk.NewAttribute("schedule_name", name),
+			sdk.NewAttribute("_contract_address", example.Contract.String()),
+			sdk.NewAttribute("success", fmt.Sprintf("%t", success)),
+		}, extraAttrs...)...)
+	}
+	// contract errors are deterministic and not redacted
+	contractErrAttr := sdk.NewAttribute("error", "Error parsing into type hackatom::msg::SudoMsg: unknown variant `unknown`, expected `steal_funds`: execute wasm contract failed")
+	oogAttr := sdk.NewAttribute("error", "codespace: sdk, code: 11")
+
+	specs := []struct {
+		height        int64
+		expExecutions []sdk.Event
+		expBalance    int64
+	}{
+		{
+			height: 2,
+			expExecutions: []sdk.Event{
+				executionEvent("b-invalid-msg", false, contractErrAttr),
+				executionEvent("c-out-of-gas", false, oogAttr),
+			},
+		},
+		{
+			height: 3,
+			expExecutions: []sdk.Event{
+				executionEvent("a-every-second-block", true),
+				executionEvent("b-invalid-msg", false, contractErrAttr, sdk.NewAttribute("schedule_disabled", "true")),
+				executionEvent("c-out-of-gas", false, oogAttr),
+			},
+			expBalance: 10,
+		},
+		{
+			height: 4,
+			expExecutions: []sdk.Event{
+				executionEvent("c-out-of-gas", false, oogAttr),
+			},
+			expBalance: 10,
+		},
+		{
+			height: 5,
+			expExecutions: []sdk.Event{
+				executionEvent("a-every-second-block", true),
+				executionEvent("c-out-of-gas", false, oogAttr),
+			},
+			expBalance: 20,
+		},
+	}
+	for _, spec := range specs {
+		t.Run(fmt.Sprintf("height %d", spec.height), func(t *testing.T) {
+			em := sdk.NewEventManager()
+			ctx = ctx.WithBlockHeight(spec.height).WithEventManager(em)
+
+			// when
+			require.NoError(t, k.EndBlocker(ctx))
+
+			// then
+			var gotExecutions []sdk.Event
+			for _, e := range em.Events() {
+				if e.Type == "cron_execution" {
+					gotExecutions = append(gotExecutions, e)
+				}
+			}
+			assert.Equal(t, spec.expExecutions, gotExecutions)
+			assert.Equal(t, spec.expBalance, keepers.BankKeeper.GetBalance(ctx, recipient, "denom").Amount.Int64())
+		})
+	}
+
+	// and execution state was updated
+	got := k.GetCronSchedule(ctx, "a-every-second-block")
+	assert.Equal(t, uint64(7), got.NextHeight)
+	assert.Zero(t, got.ErrorCount)
+	got = k.GetCronSchedule(ctx, "b-invalid-msg")
+	assert.True(t, got.Disabled)
+	assert.Equal(t, uint64(2), got.ErrorCount)
+	got = k.GetCronSchedule(ctx, "c-out-of-gas")
+	assert.False(t, got.Disabled)
+	assert.Equal(t, uint64(4), got.ErrorCount)
+	assert.Equal(t, uint64(6), got.NextHeight)
+}
+
+func TestExecuteCronSchedulesGasPerBlock(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	const gasLimit = 500_000
+	originalMaxCronGasPerBlock := types.MaxCronGasPerBlock
+	types.MaxCronGasPerBlock = 2 * gasLimit
+	t.Cleanup(func() { types.MaxCronGasPerBlock = originalMaxCronGasPerBlock })
+
+	ctx = ctx.WithBlockHeight(1)
+	for _, name := range []string{"a", "b", "c"} {
+		s := types.CronSchedule{Name: name, Contract: example.Contract.String(), Msg: []byte(`{"unknown":{}}`), Interval: 1, GasLimit: gasLimit}
+		require.NoError(t, k.registerCronSchedule(ctx, s))
+	}
+	executions := func(height int64) []string {
+		em := sdk.NewEventManager()
+		require.NoError(t, k.EndBlocker(ctx.WithBlockHeight(height).WithEventManager(em)))
+		var names []string
+		for _, e := range em.Events() {
+			if e.Type != "cron_execution" {
+				continue
+			}
+			for _, a := range e.Attributes {
+				if a.Key == "schedule_name" {
+					names = append(names, a.Value)
+				}
+			}
+		}
+		return names
+	}
+
+	// when the gas limits exceed the max gas per block then the last schedule is deferred
+	assert.Equal(t, []string{"a", "b"}, executions(2))
+	assert.Equal(t, uint64(2), k.GetCronSchedule(ctx, "c").NextHeight)
+	// and the deferred schedule goes first in the next block
+	assert.Equal(t, []string{"c", "a"}, executions(3))
+	assert.Equal(t, []string{"b", "a"}, executions(4))
+}
+
+func TestExecuteCronSchedulesFrozenContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	recipient := RandomAccountAddress(t)
+	stealMsg := []byte(fmt.Sprintf(`{"steal_funds":{"recipient":%q,"amount":[{"denom":"denom","amount":"10"}]}}`, recipient.String()))
+
+	ctx = ctx.WithBlockHeight(1)
+	require.NoError(t, k.registerCronSchedule(ctx, types.CronSchedule{Name: "a", Contract: example.Contract.String(), Msg: stealMsg, Interval: 1, GasLimit: 500_000}))
+	require.NoError(t, k.freezeContract(ctx, example.Contract))
+	em := sdk.NewEventManager()
+
+	// when
+	require.NoError(t, k.EndBlocker(ctx.WithBlockHeight(2).WithEventManager(em)))
+
+	// then
+	expEvt := sdk.NewEvent("cron_execution",
+		sdk.NewAttribute("module", "wasm"),
+		sdk.NewAttribute("schedule_name", "a"),
+		sdk.NewAttribute("_contract_address", example.Contract.String()),
+		sdk.NewAttribute("success", "false"),
+		sdk.NewAttribute("error", "codespace: wasm, code: 31"),
+	)
+	assert.Equal(t, sdk.Events{expEvt}, em.Events())
+	assert.True(t, keepers.BankKeeper.GetBalance(ctx, recipient, "denom").IsZero())
+	assert.Equal(t, uint64(1), k.GetCronSchedule(ctx, "a").ErrorCount)
+}
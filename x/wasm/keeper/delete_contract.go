@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// deleteContract removes the storage, contract info, secondary indexes and all registrations of the contract.
+// The contract balance is sent to the sweep recipient when set. A tombstone entry is appended to the contract
+// history and the address can not be used for a new contract again.
+func (k Keeper) deleteContract(ctx context.Context, contractAddr, caller, sweepRecipient sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddr)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+
+	if err := k.wipeContractStorage(sdkCtx, contractAddr); err != nil {
+		return errorsmod.Wrap(err, "storage")
+	}
+	if err := k.removeContractRegistrations(sdkCtx, contractAddr); err != nil {
+		return errorsmod.Wrap(err, "registrations")
+	}
+
+	history := k.GetContractHistory(sdkCtx, contractAddr)
+	if len(history) != 0 {
+		if err := k.removeFromContractCodeSecondaryIndex(sdkCtx, contractAddr, history[len(history)-1]); err != nil {
+			return err
+		}
+		creatorAddr, err := sdk.AccAddressFromBech32(contractInfo.Creator)
+		if err != nil {
+			return errorsmod.Wrap(err, "creator")
+		}
+		if err := k.removeFromContractCreatorSecondaryIndex(sdkCtx, creatorAddr, history[0].Updated, contractAddr); err != nil {
+			return err
+		}
+	}
+	if err := k.removeFromContractAdminSecondaryIndex(sdkCtx, contractInfo.AdminAddr(), contractAddr); err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(sdkCtx)
+	if err := store.Delete(types.GetContractAddressKey(contractAddr)); err != nil {
+		return err
+	}
+	if err := store.Delete(types.GetFrozenContractKey(contractAddr)); err != nil {
+		return err
+	}
+
+	tombstone := types.ContractCodeHistoryEntry{
+		Operation: types.ContractCodeHistoryOperationTypeDelete,
+		CodeID:    contractInfo.CodeID,
+		Updated:   types.NewAbsoluteTxPosition(sdkCtx),
+		Msg:       types.RawContractMessage(`{}`),
+	}
+	if err := k.appendToContractHistory(sdkCtx, contractAddr, tombstone); err != nil {
+		return err
+	}
+	if err := store.Set(types.GetDeletedContractKey(contractAddr), []byte{}); err != nil {
+		return err
+	}
+
+	// the storage is empty now so that any deposit held is refunded
+	if err := k.settleStorageDeposit(sdkCtx, contractAddr, nil); err != nil {
+		return err
+	}
+	attrs := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String())}
+	if len(sweepRecipient) != 0 {
+		if balance := k.bankView.GetAllBalances(sdkCtx, contractAddr); !balance.IsZero() {
+			if err := k.bank.TransferCoins(sdkCtx, contractAddr, sweepRecipient, balance); err != nil {
+				return errorsmod.Wrap(err, "sweep balance")
+			}
+		}
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeySweepRecipient, sweepRecipient.String()))
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDeleteContract, attrs...))
+	return nil
+}
+
+// wipeContractStorage deletes all entries of the contract store and resets the storage usage
+func (k Keeper) wipeContractStorage(ctx context.Context, contractAddr sdk.AccAddress) error {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractStorePrefix(contractAddr))
+	var keys [][]byte
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+	return k.setContractStorageUsage(ctx, contractAddr, types.ContractStorageUsage{})
+}
+
+// removeContractRegistrations deletes the cron schedules, epoch hook subscriptions, fee share, fee sponsorship,
+// pending admin transfer and name of the contract
+func (k Keeper) removeContractRegistrations(ctx context.Context, contractAddr sdk.AccAddress) error {
+	var cronSchedules []string
+	k.IterateCronSchedules(ctx, func(schedule types.CronSchedule) bool {
+		if schedule.Contract == contractAddr.String() {
+			cronSchedules = append(cronSchedules, schedule.Name)
+		}
+		return false
+	})
+	for _, name := range cronSchedules {
+		if err := k.removeCronSchedule(ctx, name); err != nil {
+			return err
+		}
+	}
+	var epochIdentifiers []string
+	k.IterateEpochHookSubscriptions(ctx, func(subscription types.EpochHookSubscription) bool {
+		if subscription.Contract == contractAddr.String() {
+			epochIdentifiers = append(epochIdentifiers, subscription.EpochIdentifier)
+		}
+		return false
+	})
+	store := k.storeService.OpenKVStore(ctx)
+	for _, epochIdentifier := range epochIdentifiers {
+		if err := store.Delete(types.GetEpochHookSubscriptionKey(epochIdentifier, contractAddr)); err != nil {
+			return err
+		}
+	}
+	for _, key := range [][]byte{
+		types.GetFeeShareKey(contractAddr),
+		types.GetFeeSponsorshipKey(contractAddr),
+		types.GetPendingAdminTransferKey(contractAddr),
+	} {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := k.deleteFeeSponsorshipUsage(ctx, contractAddr); err != nil {
+		return err
+	}
+	if name := k.GetContractName(ctx, contractAddr); name != "" {
+		return k.deleteContractName(ctx, contractAddr, name)
+	}
+	return nil
+}
+
+// IsContractDeleted returns true when the contract was deleted and its address can not be used again
+func (k Keeper) IsContractDeleted(ctx context.Context, contractAddr sdk.AccAddress) bool {
+	ok, err := k.storeService.OpenKVStore(ctx).Has(types.GetDeletedContractKey(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	return ok
+}
+
+// IterateDeletedContracts iterates over the addresses of all deleted contracts.
+// The callback method can return true to abort early.
+func (k Keeper) IterateDeletedContracts(ctx context.Context, cb func(sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DeletedContractPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()) {
+			break
+		}
+	}
+}
+
+// importDeletedContract stores the tombstone and history of a deleted contract
+func (k Keeper) importDeletedContract(ctx context.Context, deleted types.DeletedContract) error {
+	if err := deleted.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(deleted.ContractAddress)
+	if k.HasContractInfo(ctx, contractAddr) || k.IsContractDeleted(ctx, contractAddr) {
+		return errorsmod.Wrapf(types.ErrDuplicate, "contract: %s", deleted.ContractAddress)
+	}
+	if err := k.appendToContractHistory(ctx, contractAddr, deleted.ContractCodeHistory...); err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(types.GetDeletedContractKey(contractAddr), []byte{})
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestDeleteContract(t *testing.T) {
+	specs := map[string]struct {
+		caller         func(example HackatomExampleInstance) sdk.AccAddress
+		policy         types.AuthorizationPolicy
+		unknown        bool
+		sweepRecipient sdk.AccAddress
+		expErr         *errorsmod.Error
+	}{
+		"admin": {
+			caller: func(example HackatomExampleInstance) sdk.AccAddress { return example.CreatorAddr },
+			policy: DefaultAuthorizationPolicy{},
+		},
+		"admin with sweep": {
+			caller:         func(example HackatomExampleInstance) sdk.AccAddress { return example.CreatorAddr },
+			policy:         DefaultAuthorizationPolicy{},
+			sweepRecipient: RandomAccountAddress(t),
+		},
+		"gov": {
+			caller:         func(HackatomExampleInstance) sdk.AccAddress { return RandomAccountAddress(t) },
+			policy:         GovAuthorizationPolicy{},
+			sweepRecipient: RandomAccountAddress(t),
+		},
+		"not admin": {
+			caller: func(example HackatomExampleInstance) sdk.AccAddress { return example.VerifierAddr },
+			policy: DefaultAuthorizationPolicy{},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			caller:  func(example HackatomExampleInstance) sdk.AccAddress { return example.CreatorAddr },
+			policy:  DefaultAuthorizationPolicy{},
+			unknown: true,
+			expErr:  sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+			contractAddr := example.Contract
+			if spec.unknown {
+				contractAddr = RandomAccountAddress(t)
+			}
+			require.NoError(t, k.importContractName(parentCtx, types.ContractName{Name: "hackatom", Contract: example.Contract.String()}))
+			require.NoError(t, k.importCronSchedule(parentCtx, types.CronSchedule{Name: "my-schedule", Contract: example.Contract.String(), Msg: []byte(`{}`), Interval: 5, GasLimit: 100_000, NextHeight: 10}))
+			require.NoError(t, k.importEpochHookSubscription(parentCtx, types.EpochHookSubscription{Contract: example.Contract.String(), EpochIdentifier: "week", GasLimit: 100_000}))
+			require.NoError(t, k.importFeeShare(parentCtx, types.FeeShare{Contract: example.Contract.String(), WithdrawAddress: RandomBech32AccountAddress(t)}))
+			ctx := parentCtx.WithEventManager(sdk.NewEventManager())
+
+			// when
+			gotErr := k.deleteContract(ctx, contractAddr, spec.caller(example), spec.sweepRecipient, spec.policy)
+
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				assert.NotNil(t, k.GetContractInfo(ctx, example.Contract))
+				assert.False(t, k.IsContractDeleted(ctx, example.Contract))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, k.GetContractInfo(ctx, contractAddr))
+			assert.True(t, k.IsContractDeleted(ctx, contractAddr))
+			var stateEntries int
+			k.IterateContractState(ctx, contractAddr, func(_, _ []byte) bool {
+				stateEntries++
+				return false
+			})
+			assert.Zero(t, stateEntries)
+			assert.Equal(t, types.ContractStorageUsage{}, k.GetContractStorageUsage(ctx, contractAddr))
+			var indexed int
+			k.IterateContractsByCode(ctx, example.CodeID, func(sdk.AccAddress) bool {
+				indexed++
+				return false
+			})
+			k.IterateContractsByCreator(ctx, example.CreatorAddr, func(sdk.AccAddress) bool {
+				indexed++
+				return false
+			})
+			assert.Zero(t, indexed)
+			assert.Empty(t, k.GetContractName(ctx, contractAddr))
+			assert.Nil(t, k.GetContractByName(ctx, "hackatom"))
+			assert.Nil(t, k.GetCronSchedule(ctx, "my-schedule"))
+			assert.Nil(t, k.GetEpochHookSubscription(ctx, "week", contractAddr))
+			assert.Nil(t, k.GetFeeShare(ctx, contractAddr))
+
+			// and tombstone kept in history
+			history := k.GetContractHistory(ctx, contractAddr)
+			require.Len(t, history, 2)
+			assert.Equal(t, types.ContractCodeHistoryOperationTypeDelete, history[1].Operation)
+			assert.Equal(t, example.CodeID, history[1].CodeID)
+
+			// and balance swept
+			if spec.sweepRecipient != nil {
+				assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, contractAddr).IsZero())
+				assert.Equal(t, example.Deposit.String(), keepers.BankKeeper.GetAllBalances(ctx, spec.sweepRecipient).String())
+			} else {
+				assert.Equal(t, example.Deposit.String(), keepers.BankKeeper.GetAllBalances(ctx, contractAddr).String())
+			}
+			expAttrs := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String())}
+			if spec.sweepRecipient != nil {
+				expAttrs = append(expAttrs, sdk.NewAttribute(types.AttributeKeySweepRecipient, spec.sweepRecipient.String()))
+			}
+			assert.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(types.EventTypeDeleteContract, expAttrs...))
+		})
+	}
+}
+
+func TestDeletedContractAddressNotReusable(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	})
+	require.NoError(t, err)
+	salt := []byte("my salt")
+	contractAddr, _, err := keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, example.CreatorAddr, initMsgBz, "label", nil, salt, false)
+	require.NoError(t, err)
+	require.NoError(t, k.deleteContract(ctx, contractAddr, example.CreatorAddr, nil, DefaultAuthorizationPolicy{}))
+
+	// when
+	_, _, err = keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, example.CreatorAddr, initMsgBz, "label", nil, salt, false)
+
+	// then
+	require.ErrorIs(t, err, types.ErrDuplicate)
+	assert.Nil(t, k.GetContractInfo(ctx, contractAddr))
+}
@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks calls the subscribed contracts via sudo when an x/epochs epoch ends
+type EpochHooks struct {
+	k Keeper
+}
+
+// NewEpochHooks constructor
+func NewEpochHooks(k Keeper) EpochHooks {
+	return EpochHooks{k: k}
+}
+
+// AfterEpochEnd calls all contracts that are subscribed to the epoch identifier.
+// Failures are isolated per contract and never returned so that other hooks are not affected.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	h.k.executeEpochHooks(sdk.UnwrapSDKContext(ctx), epochIdentifier, epochNumber)
+	return nil
+}
+
+// BeforeEpochStart is a no-op
+func (h EpochHooks) BeforeEpochStart(context.Context, string, int64) error {
+	return nil
+}
+
+// subscribeEpochHook stores a new or updates an existing contract subscription to the epoch identifier
+func (k Keeper) subscribeEpochHook(ctx context.Context, caller sdk.AccAddress, subscription types.EpochHookSubscription, authZ types.AuthorizationPolicy) error {
+	if err := subscription.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(subscription.Contract)
+	if err := k.authorizeEpochHookChange(ctx, contractAddr, caller, authZ); err != nil {
+		return err
+	}
+	if k.epochsKeeper != nil {
+		if _, err := k.epochsKeeper.GetEpochInfo(sdk.UnwrapSDKContext(ctx), subscription.EpochIdentifier); err != nil {
+			return errorsmod.Wrapf(types.ErrNotFound, "epoch identifier %s", subscription.EpochIdentifier)
+		}
+	}
+	if err := k.checkEpochHookGasBudget(ctx, contractAddr, subscription); err != nil {
+		return err
+	}
+	if err := k.setEpochHookSubscription(ctx, contractAddr, subscription); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSubscribeEpochHook,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, subscription.Contract),
+		sdk.NewAttribute(types.AttributeKeyEpochIdentifier, subscription.EpochIdentifier),
+	))
+	return nil
+}
+
+// unsubscribeEpochHook deletes the contract subscription to the epoch identifier
+func (k Keeper) unsubscribeEpochHook(ctx context.Context, caller, contractAddr sdk.AccAddress, epochIdentifier string, authZ types.AuthorizationPolicy) error {
+	if err := k.authorizeEpochHookChange(ctx, contractAddr, caller, authZ); err != nil {
+		return err
+	}
+	if k.GetEpochHookSubscription(ctx, epochIdentifier, contractAddr) == nil {
+		return errorsmod.Wrapf(types.ErrNotFound, "epoch hook subscription %s", epochIdentifier)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetEpochHookSubscriptionKey(epochIdentifier, contractAddr)); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnsubscribeEpochHook,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyEpochIdentifier, epochIdentifier),
+	))
+	return nil
+}
+
+// authorizeEpochHookChange ensures the contract exists and the caller is allowed to modify it
+func (k Keeper) authorizeEpochHookChange(ctx context.Context, contractAddr, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	return nil
+}
+
+// checkEpochHookGasBudget ensures that the gas limits of all subscriptions to the epoch identifier, including the
+// new or updated one, do not exceed the epoch hook gas budget in the params
+func (k Keeper) checkEpochHookGasBudget(ctx context.Context, contractAddr sdk.AccAddress, subscription types.EpochHookSubscription) error {
+	budget := k.GetParams(ctx).EpochHookGasBudget
+	total := subscription.GasLimit
+	k.iterateEpochHookSubscriptions(ctx, types.GetEpochHookSubscriptionsPrefix(subscription.EpochIdentifier), func(s types.EpochHookSubscription) bool {
+		if s.Contract != contractAddr.String() {
+			total += s.GasLimit
+		}
+		return total > budget
+	})
+	if total > budget {
+		return errorsmod.Wrapf(types.ErrLimit, "epoch hook gas budget of %d exceeded", budget)
+	}
+	return nil
+}
+
+// importEpochHookSubscription stores the subscription for an existing contract
+func (k Keeper) importEpochHookSubscription(ctx context.Context, subscription types.EpochHookSubscription) error {
+	if err := subscription.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(subscription.Contract)
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return types.ErrNoSuchContractFn(subscription.Contract).Wrapf("address %s", subscription.Contract)
+	}
+	if k.GetEpochHookSubscription(ctx, subscription.EpochIdentifier, contractAddr) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "epoch hook subscription %s %s", subscription.EpochIdentifier, subscription.Contract)
+	}
+	return k.setEpochHookSubscription(ctx, contractAddr, subscription)
+}
+
+func (k Keeper) setEpochHookSubscription(ctx context.Context, contractAddr sdk.AccAddress, subscription types.EpochHookSubscription) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetEpochHookSubscriptionKey(subscription.EpochIdentifier, contractAddr), k.cdc.MustMarshal(&subscription))
+}
+
+// GetEpochHookSubscription returns the contract subscription to the epoch identifier or nil when not found
+func (k Keeper) GetEpochHookSubscription(ctx context.Context, epochIdentifier string, contractAddr sdk.AccAddress) *types.EpochHookSubscription {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetEpochHookSubscriptionKey(epochIdentifier, contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var subscription types.EpochHookSubscription
+	k.cdc.MustUnmarshal(bz, &subscription)
+	return &subscription
+}
+
+// IterateEpochHookSubscriptions iterates over all subscriptions ordered by epoch identifier and contract address.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateEpochHookSubscriptions(ctx context.Context, cb func(types.EpochHookSubscription) bool) {
+	k.iterateEpochHookSubscriptions(ctx, types.EpochHookSubscriptionPrefix, cb)
+}
+
+func (k Keeper) iterateEpochHookSubscriptions(ctx context.Context, keyPrefix []byte, cb func(types.EpochHookSubscription) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var subscription types.EpochHookSubscription
+		k.cdc.MustUnmarshal(iter.Value(), &subscription)
+		if cb(subscription) {
+			break
+		}
+	}
+}
+
+// executeEpochHooks calls all contracts subscribed to the epoch identifier, ordered by contract address.
+// Each call is isolated so that a failure does not affect other contracts or the block. Contracts are skipped
+// once the sum of their gas limits exceeds the epoch hook gas budget in the params. Frozen contracts are not called
+// and reported as failed.
+func (k Keeper) executeEpochHooks(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	var subscriptions []types.EpochHookSubscription
+	k.iterateEpochHookSubscriptions(ctx, types.GetEpochHookSubscriptionsPrefix(epochIdentifier), func(s types.EpochHookSubscription) bool {
+		subscriptions = append(subscriptions, s)
+		return false
+	})
+	if len(subscriptions) == 0 {
+		return
+	}
+	msg, err := json.Marshal(types.EpochHookSudoMsg{EpochEnd: &types.EpochEndMsg{
+		Identifier: epochIdentifier,
+		Number:     uint64(epochNumber),
+	}})
+	if err != nil {
+		panic(err)
+	}
+	var (
+		budget      = k.GetParams(ctx).EpochHookGasBudget
+		reservedGas uint64
+	)
+	for _, subscription := range subscriptions {
+		var err error
+		reservedGas += subscription.GasLimit
+		if reservedGas > budget {
+			err = errorsmod.Wrapf(types.ErrLimit, "epoch hook gas budget of %d exceeded", budget)
+		} else {
+			err = k.executeEpochHook(ctx, subscription, msg)
+		}
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContractAddr, subscription.Contract),
+			sdk.NewAttribute(types.AttributeKeyEpochIdentifier, epochIdentifier),
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(epochNumber, 10)),
+			sdk.NewAttribute(types.AttributeKeyEpochHookSuccess, strconv.FormatBool(err == nil)),
+		}
+		if err != nil {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEpochHookError, redactError(err).Error()))
+			k.Logger(ctx).Debug("epoch hook execution failed", "contract", subscription.Contract, "epoch", epochIdentifier, "error", err)
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeEpochHookExecution, attrs...))
+	}
+}
+
+// executeEpochHook calls sudo on the contract limited to the subscription gas limit
+func (k Keeper) executeEpochHook(ctx sdk.Context, subscription types.EpochHookSubscription, msg []byte) error {
+	contractAddr, err := sdk.AccAddressFromBech32(subscription.Contract)
+	if err != nil {
+		return err
+	}
+	_, err = k.sudoWithGasLimit(ctx, contractAddr, msg, subscription.GasLimit)
+	return err
+}
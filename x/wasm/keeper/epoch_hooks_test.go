@@ -0,0 +1,274 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSubscribeEpochHook(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	k.epochsKeeper = wasmtesting.MockEpochsKeeper{GetEpochInfoFn: func(_ sdk.Context, identifier string) (epochstypes.EpochInfo, error) {
+		if identifier != "week" {
+			return epochstypes.EpochInfo{}, collections.ErrNotFound
+		}
+		return epochstypes.EpochInfo{Identifier: identifier}, nil
+	}}
+	params := types.DefaultParams()
+	params.EpochHookGasBudget = 1_000_000
+	require.NoError(t, k.SetParams(parentCtx, params))
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	other := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+
+	specs := map[string]struct {
+		src      types.EpochHookSubscription
+		caller   sdk.AccAddress
+		policy   types.AuthorizationPolicy
+		otherGas uint64
+		expErr   *errorsmod.Error
+	}{
+		"admin": {
+			src:    types.EpochHookSubscription{Contract: example.Contract.String(), EpochIdentifier: "week", GasLimit: 100_000},
+			caller: example.CreatorAddr,
+			policy: DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			src:    types.EpochHookSubscription{Contract: example.Contract.String(), EpochIdentifier: "week", GasLimit: 100_000},
+			caller: RandomAccountAddress(t),
+			policy: GovAuthorizationPolicy{},
+		},
+		"other address": {
+			src:    types.EpochHookSubscription{Contract: example.Contract.String(), EpochIdentifier: "week", GasLimit: 100_000},
+			caller: RandomAccountAddress(t),
+			policy: DefaultAuthorizationPolicy{},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			src:    types.EpochHookSubscription{Contract: RandomBech32AccountAddress(t), EpochIdentifier: "week", GasLimit: 100_000},
+			caller: example.CreatorAddr,
+			policy: GovAuthorizationPolicy{},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		"unknown epoch identifier": {
+			src:    types.EpochHookSubscription{Contract: example.Contract.String(), EpochIdentifier: "fortnight", GasLimit: 100_000},
+			caller: example.CreatorAddr,
+			policy: GovAuthorizationPolicy{},
+			expErr: types.ErrNotFound,
+		},
+		"within gas budget with other subscriptions": {
+			src:      types.EpochHookSubscription{Contract: example.Contract.String(), EpochIdentifier: "week", GasLimit: 100_000},
+			caller:   example.CreatorAddr,
+			policy:   DefaultAuthorizationPolicy{},
+			otherGas: 899_999,
+		},
+		"gas budget exceeded with other subscriptions": {
+			src:      types.EpochHookSubscription{Contract: example.Contract.String(), EpochIdentifier: "week", GasLimit: 100_000},
+			caller:   example.CreatorAddr,
+			policy:   DefaultAuthorizationPolicy{},
+			otherGas: 900_001,
+			expErr:   types.ErrLimit,
+		},
+		"gas budget exceeded": {
+			src:    types.EpochHookSubscription{Contract: example.Contract.String(), EpochIdentifier: "week", GasLimit: 1_000_001},
+			caller: example.CreatorAddr,
+			policy: GovAuthorizationPolicy{},
+			expErr: types.ErrLimit,
+		},
+		"gas limit exceeds max": {
+			src:    types.EpochHookSubscription{Contract: example.Contract.String(), EpochIdentifier: "week", GasLimit: types.MaxEpochHookGasLimit + 1},
+			caller: example.CreatorAddr,
+			policy: GovAuthorizationPolicy{},
+			expErr: types.ErrLimit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if spec.otherGas != 0 {
+				require.NoError(t, k.importEpochHookSubscription(ctx, types.EpochHookSubscription{Contract: other.Contract.String(), EpochIdentifier: "week", GasLimit: spec.otherGas}))
+			}
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.subscribeEpochHook(ctx.WithEventManager(em), spec.caller, spec.src, spec.policy)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			contractAddr := sdk.MustAccAddressFromBech32(spec.src.Contract)
+			assert.Equal(t, &spec.src, k.GetEpochHookSubscription(ctx, spec.src.EpochIdentifier, contractAddr))
+			expEvt := sdk.NewEvent("subscribe_epoch_hook",
+				sdk.NewAttribute("_contract_address", spec.src.Contract),
+				sdk.NewAttribute("epoch_identifier", spec.src.EpochIdentifier))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+
+			// and when subscribed again then the gas limit is updated
+			update := spec.src
+			update.GasLimit++
+			require.NoError(t, k.subscribeEpochHook(ctx, spec.caller, update, spec.policy))
+			assert.Equal(t, &update, k.GetEpochHookSubscription(ctx, spec.src.EpochIdentifier, contractAddr))
+
+			// and when unsubscribed by other address
+			err := k.unsubscribeEpochHook(ctx, RandomAccountAddress(t), contractAddr, spec.src.EpochIdentifier, DefaultAuthorizationPolicy{})
+			require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+			// and when unsubscribed
+			em = sdk.NewEventManager()
+			require.NoError(t, k.unsubscribeEpochHook(ctx.WithEventManager(em), spec.caller, contractAddr, spec.src.EpochIdentifier, spec.policy))
+			assert.Nil(t, k.GetEpochHookSubscription(ctx, spec.src.EpochIdentifier, contractAddr))
+			expEvt = sdk.NewEvent("unsubscribe_epoch_hook",
+				sdk.NewAttribute("_contract_address", spec.src.Contract),
+				sdk.NewAttribute("epoch_identifier", spec.src.EpochIdentifier))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+			// and can not be unsubscribed twice
+			err = k.unsubscribeEpochHook(ctx, spec.caller, contractAddr, spec.src.EpochIdentifier, spec.policy)
+			require.ErrorIs(t, err, types.ErrNotFound)
+		})
+	}
+}
+
+func TestEpochHooksAfterEpochEnd(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	succeeding := SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	failing := SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	outOfGas := SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	otherEpoch := SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	frozen := SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	params := types.DefaultParams()
+	params.EpochHookGasBudget = 400_000
+	require.NoError(t, k.SetParams(ctx, params))
+
+	var gotMsgs []string
+	mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		gotMsgs = append(gotMsgs, string(sudoMsg))
+		store.Set([]byte("called"), sudoMsg)
+		switch env.Contract.Address {
+		case failing.String():
+			return &wasmvmtypes.ContractResult{Err: "testing"}, 1, nil
+		case outOfGas.String():
+			return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, gasLimit + 1, nil
+		}
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 1, nil
+	}
+	for _, s := range []types.EpochHookSubscription{
+		{Contract: succeeding.String(), EpochIdentifier: "week", GasLimit: 100_000},
+		{Contract: failing.String(), EpochIdentifier: "week", GasLimit: 100_000},
+		{Contract: outOfGas.String(), EpochIdentifier: "week", GasLimit: 100_000},
+		{Contract: otherEpoch.String(), EpochIdentifier: "day", GasLimit: 100_000},
+		{Contract: frozen.String(), EpochIdentifier: "week", GasLimit: 100_000},
+	} {
+		require.NoError(t, k.importEpochHookSubscription(ctx, s))
+	}
+	require.NoError(t, k.freezeContract(ctx, frozen))
+	em := sdk.NewEventManager()
+	ctx = ctx.WithEventManager(em)
+
+	// when
+	gotErr := NewEpochHooks(*k).AfterEpochEnd(ctx, "week", 3)
+
+	// then
+	require.NoError(t, gotErr)
+	const expMsg = `{"epoch_end":{"identifier":"week","number":3}}`
+	assert.Equal(t, []string{expMsg, expMsg, expMsg}, gotMsgs)
+	// only successful calls are committed
+	assert.Equal(t, []byte(expMsg), k.QueryRaw(ctx, succeeding, []byte("called")))
+	assert.Nil(t, k.QueryRaw(ctx, failing, []byte("called")))
+	assert.Nil(t, k.QueryRaw(ctx, outOfGas, []byte("called")))
+	assert.Nil(t, k.QueryRaw(ctx, otherEpoch, []byte("called")))
+	assert.Nil(t, k.QueryRaw(ctx, frozen, []byte("called")))
+
+	gotResults := make(map[string]map[string]string)
+	for _, e := range em.Events() {
+		if e.Type != "epoch_hook_execution" {
+			continue
+		}
+		attrs := make(map[string]string)
+		for _, a := range e.Attributes {
+			attrs[a.Key] = a.Value
+		}
+		gotResults[attrs["_contract_address"]] = attrs
+	}
+	require.Len(t, gotResults, 4)
+	assert.Equal(t, map[string]string{
+		"module": "wasm", "_contract_address": succeeding.String(), "epoch_identifier": "week", "epoch_number": "3", "success": "true",
+	}, gotResults[succeeding.String()])
+	assert.Equal(t, map[string]string{
+		"module": "wasm", "_contract_address": failing.String(), "epoch_identifier": "week", "epoch_number": "3", "success": "false",
+		"error": "testing: execute wasm contract failed",
+	}, gotResults[failing.String()])
+	assert.Equal(t, map[string]string{
+		"module": "wasm", "_contract_address": outOfGas.String(), "epoch_identifier": "week", "epoch_number": "3", "success": "false",
+		"error": "codespace: sdk, code: 11",
+	}, gotResults[outOfGas.String()])
+	assert.Equal(t, map[string]string{
+		"module": "wasm", "_contract_address": frozen.String(), "epoch_identifier": "week", "epoch_number": "3", "success": "false",
+		"error": "codespace: wasm, code: 31",
+	}, gotResults[frozen.String()])
+
+	// and before epoch start is a no-op
+	gotMsgs = nil
+	require.NoError(t, NewEpochHooks(*k).BeforeEpochStart(ctx, "week", 4))
+	assert.Empty(t, gotMsgs)
+}
+
+func TestEpochHooksGasBudget(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var calls int
+	mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		calls++
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 1, nil
+	}
+	for range 3 {
+		contract := SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+		require.NoError(t, k.importEpochHookSubscription(ctx, types.EpochHookSubscription{Contract: contract.String(), EpochIdentifier: "week", GasLimit: 100_000}))
+	}
+	// the budget was lowered after the contracts subscribed
+	params := types.DefaultParams()
+	params.EpochHookGasBudget = 200_000
+	require.NoError(t, k.SetParams(ctx, params))
+	em := sdk.NewEventManager()
+
+	// when
+	require.NoError(t, NewEpochHooks(*k).AfterEpochEnd(ctx.WithEventManager(em), "week", 1))
+
+	// then
+	assert.Equal(t, 2, calls)
+	var skipped int
+	for _, e := range em.Events() {
+		if e.Type != "epoch_hook_execution" {
+			continue
+		}
+		for _, a := range e.Attributes {
+			if a.Key == "success" && a.Value == "false" {
+				skipped++
+			}
+		}
+	}
+	assert.Equal(t, 1, skipped)
+}
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// trackTxContract records the contract address in the tx contracts of the context, when set.
+// The addresses are used to distribute the developer fee share after the tx was executed.
+func trackTxContract(ctx sdk.Context, contractAddr sdk.AccAddress) {
+	if txContracts, ok := types.TxContractsFromContext(ctx); ok {
+		txContracts.AddContractAddress(contractAddr)
+	}
+}
+
+// registerFeeShare stores a new or updates an existing fee share registration of the contract
+func (k Keeper) registerFeeShare(ctx context.Context, caller sdk.AccAddress, feeShare types.FeeShare, authZ types.AuthorizationPolicy) error {
+	if err := feeShare.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(feeShare.Contract)
+	if err := k.authorizeFeeShareChange(ctx, contractAddr, caller, authZ); err != nil {
+		return err
+	}
+	if err := k.setFeeShare(ctx, contractAddr, feeShare); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterFeeShare,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, feeShare.Contract),
+		sdk.NewAttribute(types.AttributeKeyWithdrawAddress, feeShare.WithdrawAddress),
+	))
+	return nil
+}
+
+// cancelFeeShare deletes the fee share registration of the contract
+func (k Keeper) cancelFeeShare(ctx context.Context, caller, contractAddr sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	if err := k.authorizeFeeShareChange(ctx, contractAddr, caller, authZ); err != nil {
+		return err
+	}
+	if k.GetFeeShare(ctx, contractAddr) == nil {
+		return errorsmod.Wrap(types.ErrNotFound, "fee share")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetFeeShareKey(contractAddr)); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelFeeShare,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+	))
+	return nil
+}
+
+// authorizeFeeShareChange ensures the contract exists and the caller is allowed to modify it
+func (k Keeper) authorizeFeeShareChange(ctx context.Context, contractAddr, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	return nil
+}
+
+// importFeeShare stores the fee share registration for an existing contract
+func (k Keeper) importFeeShare(ctx context.Context, feeShare types.FeeShare) error {
+	if err := feeShare.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(feeShare.Contract)
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return types.ErrNoSuchContractFn(feeShare.Contract).Wrapf("address %s", feeShare.Contract)
+	}
+	if k.GetFeeShare(ctx, contractAddr) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "fee share %s", feeShare.Contract)
+	}
+	return k.setFeeShare(ctx, contractAddr, feeShare)
+}
+
+func (k Keeper) setFeeShare(ctx context.Context, contractAddr sdk.AccAddress, feeShare types.FeeShare) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetFeeShareKey(contractAddr), k.cdc.MustMarshal(&feeShare))
+}
+
+// GetFeeShare returns the fee share registration of the contract or nil when not found
+func (k Keeper) GetFeeShare(ctx context.Context, contractAddr sdk.AccAddress) *types.FeeShare {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetFeeShareKey(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var feeShare types.FeeShare
+	k.cdc.MustUnmarshal(bz, &feeShare)
+	return &feeShare
+}
+
+// IterateFeeShares iterates over all fee share registrations ordered by contract address.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateFeeShares(ctx context.Context, cb func(types.FeeShare) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.FeeSharePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var feeShare types.FeeShare
+		k.cdc.MustUnmarshal(iter.Value(), &feeShare)
+		if cb(feeShare) {
+			break
+		}
+	}
+}
+
+// distributeFeeShares pays the developer share of the tx fee from the fee collector to the withdraw addresses
+// of the registered contracts that were called in the tx. The share is split equally between the contracts.
+// Failed payouts are skipped so that the tx result is never affected.
+func (k Keeper) distributeFeeShares(ctx sdk.Context, fee sdk.Coins, contracts []sdk.AccAddress) {
+	bps := k.GetParams(ctx).DeveloperFeeShareBps
+	if bps == 0 || fee.IsZero() || len(contracts) == 0 {
+		return
+	}
+	var feeShares []types.FeeShare
+	for _, contractAddr := range contracts {
+		if feeShare := k.GetFeeShare(ctx, contractAddr); feeShare != nil {
+			feeShares = append(feeShares, *feeShare)
+		}
+	}
+	if len(feeShares) == 0 {
+		return
+	}
+	var amount sdk.Coins
+	for _, c := range fee {
+		share := c.Amount.MulRaw(int64(bps)).QuoRaw(int64(types.MaxFeeShareBps)).QuoRaw(int64(len(feeShares)))
+		if share.IsPositive() {
+			amount = amount.Add(sdk.NewCoin(c.Denom, share))
+		}
+	}
+	if amount.IsZero() {
+		return
+	}
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	for _, feeShare := range feeShares {
+		if err := k.payFeeShare(ctx, feeCollector, feeShare, amount); err != nil {
+			k.Logger(ctx).Debug("fee share payout failed", "contract", feeShare.Contract, "error", err)
+		}
+	}
+}
+
+// payFeeShare transfers the amount in a cached context without gas consumption. State changes and events
+// are committed only when the transfer succeeded.
+func (k Keeper) payFeeShare(parentCtx sdk.Context, feeCollector sdk.AccAddress, feeShare types.FeeShare, amount sdk.Coins) error {
+	cacheCtx, commit := parentCtx.CacheContext()
+	ctx := cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	withdrawAddr, err := sdk.AccAddressFromBech32(feeShare.WithdrawAddress)
+	if err != nil {
+		return err
+	}
+	if err := k.bank.TransferCoins(ctx, feeCollector, withdrawAddr, amount); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDistributeFeeShare,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContractAddr, feeShare.Contract),
+		sdk.NewAttribute(types.AttributeKeyWithdrawAddress, feeShare.WithdrawAddress),
+		sdk.NewAttribute(types.AttributeKeyFeeShareAmount, amount.String()),
+	))
+	commit()
+	return nil
+}
@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestRegisterFeeShare(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	withdrawAddr := RandomBech32AccountAddress(t)
+
+	specs := map[string]struct {
+		src    types.FeeShare
+		caller sdk.AccAddress
+		policy types.AuthorizationPolicy
+		expErr *errorsmod.Error
+	}{
+		"admin": {
+			src:    types.FeeShare{Contract: example.Contract.String(), WithdrawAddress: withdrawAddr},
+			caller: example.CreatorAddr,
+			policy: DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			src:    types.FeeShare{Contract: example.Contract.String(), WithdrawAddress: withdrawAddr},
+			caller: RandomAccountAddress(t),
+			policy: GovAuthorizationPolicy{},
+		},
+		"other address": {
+			src:    types.FeeShare{Contract: example.Contract.String(), WithdrawAddress: withdrawAddr},
+			caller: RandomAccountAddress(t),
+			policy: DefaultAuthorizationPolicy{},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			src:    types.FeeShare{Contract: RandomBech32AccountAddress(t), WithdrawAddress: withdrawAddr},
+			caller: example.CreatorAddr,
+			policy: GovAuthorizationPolicy{},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.registerFeeShare(ctx.WithEventManager(em), spec.caller, spec.src, spec.policy)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, &spec.src, k.GetFeeShare(ctx, example.Contract))
+			expEvt := sdk.NewEvent("register_fee_share",
+				sdk.NewAttribute("_contract_address", spec.src.Contract),
+				sdk.NewAttribute("withdraw_address", spec.src.WithdrawAddress))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+
+			// and when registered again then the withdraw address is updated
+			update := spec.src
+			update.WithdrawAddress = RandomBech32AccountAddress(t)
+			require.NoError(t, k.registerFeeShare(ctx, spec.caller, update, spec.policy))
+			assert.Equal(t, &update, k.GetFeeShare(ctx, example.Contract))
+
+			// and when cancelled by other address
+			err := k.cancelFeeShare(ctx, RandomAccountAddress(t), example.Contract, DefaultAuthorizationPolicy{})
+			require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+			// and when cancelled
+			em = sdk.NewEventManager()
+			require.NoError(t, k.cancelFeeShare(ctx.WithEventManager(em), spec.caller, example.Contract, spec.policy))
+			assert.Nil(t, k.GetFeeShare(ctx, example.Contract))
+			expEvt = sdk.NewEvent("cancel_fee_share",
+				sdk.NewAttribute("_contract_address", spec.src.Contract))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+			// and can not be cancelled twice
+			err = k.cancelFeeShare(ctx, spec.caller, example.Contract, spec.policy)
+			require.ErrorIs(t, err, types.ErrNotFound)
+		})
+	}
+}
+
+func TestTrackTxContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	txContracts := types.NewTxContracts()
+	ctx = types.WithTxContracts(ctx, txContracts)
+
+	// when
+	_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []sdk.AccAddress{example.Contract}, txContracts.GetContractAddresses())
+}
+
+func TestTrackTxContractSubmessages(t *testing.T) {
+	specs := map[string]struct {
+		calleeErr string
+		subMsg    wasmvmtypes.SubMsg
+		expCallee bool
+	}{
+		"submessage succeeds": {
+			subMsg:    wasmvmtypes.SubMsg{ID: 1, ReplyOn: wasmvmtypes.ReplyNever},
+			expCallee: true,
+		},
+		"submessage fails with reply on error": {
+			calleeErr: "testing",
+			subMsg:    wasmvmtypes.SubMsg{ID: 1, ReplyOn: wasmvmtypes.ReplyError},
+		},
+		"submessage fails with reply always": {
+			calleeErr: "testing",
+			subMsg:    wasmvmtypes.SubMsg{ID: 1, ReplyOn: wasmvmtypes.ReplyAlways},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var mock wasmtesting.MockWasmEngine
+			wasmtesting.MakeInstantiable(&mock)
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+			caller := SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+			callee := SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+			mock.ExecuteFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+				if env.Contract.Address == callee.String() {
+					if spec.calleeErr != "" {
+						return &wasmvmtypes.ContractResult{Err: spec.calleeErr}, 1, nil
+					}
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 1, nil
+				}
+				subMsg := spec.subMsg
+				subMsg.Msg = wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{ContractAddr: callee.String(), Msg: []byte(`{}`)}}}
+				return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Messages: []wasmvmtypes.SubMsg{subMsg}}}, 1, nil
+			}
+			mock.ReplyFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, reply wasmvmtypes.Reply, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+				return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 1, nil
+			}
+			txContracts := types.NewTxContracts()
+			ctx = types.WithTxContracts(ctx, txContracts)
+
+			// when
+			_, err := keepers.ContractKeeper.Execute(ctx, caller, RandomAccountAddress(t), []byte(`{}`), nil)
+
+			// then
+			require.NoError(t, err)
+			exp := []sdk.AccAddress{caller}
+			if spec.expCallee {
+				exp = append(exp, callee)
+				slices.SortFunc(exp, func(a, b sdk.AccAddress) int { return bytes.Compare(a, b) })
+			}
+			assert.Equal(t, exp, txContracts.GetContractAddresses())
+		})
+	}
+}
+
+func TestFeeShareDecorator(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	registered := SeedNewContractInstance(t, parentCtx, keepers, &mock).Contract
+	otherRegistered := SeedNewContractInstance(t, parentCtx, keepers, &mock).Contract
+	unregistered := SeedNewContractInstance(t, parentCtx, keepers, &mock).Contract
+	withdrawAddr, otherWithdrawAddr := RandomAccountAddress(t), RandomAccountAddress(t)
+	require.NoError(t, k.importFeeShare(parentCtx, types.FeeShare{Contract: registered.String(), WithdrawAddress: withdrawAddr.String()}))
+	require.NoError(t, k.importFeeShare(parentCtx, types.FeeShare{Contract: otherRegistered.String(), WithdrawAddress: otherWithdrawAddr.String()}))
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	keepers.Faucet.Fund(parentCtx, feeCollector, sdk.NewInt64Coin("denom", 1000), sdk.NewInt64Coin("other", 1000))
+
+	specs := map[string]struct {
+		bps          uint32
+		fee          sdk.Coins
+		contracts    []sdk.AccAddress
+		failed       bool
+		expPayout    sdk.Coins
+		expOtherPaid bool
+	}{
+		"single registered contract": {
+			bps:       5000,
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			contracts: []sdk.AccAddress{registered, unregistered},
+			expPayout: sdk.NewCoins(sdk.NewInt64Coin("denom", 50)),
+		},
+		"split between registered contracts": {
+			bps:          5000,
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("denom", 100), sdk.NewInt64Coin("other", 3)),
+			contracts:    []sdk.AccAddress{registered, otherRegistered},
+			expPayout:    sdk.NewCoins(sdk.NewInt64Coin("denom", 25)),
+			expOtherPaid: true,
+		},
+		"full share": {
+			bps:       types.MaxFeeShareBps,
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			contracts: []sdk.AccAddress{registered},
+			expPayout: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+		},
+		"disabled": {
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			contracts: []sdk.AccAddress{registered},
+		},
+		"zero fee": {
+			bps:       5000,
+			contracts: []sdk.AccAddress{registered},
+		},
+		"no registered contract": {
+			bps:       5000,
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			contracts: []sdk.AccAddress{unregistered},
+		},
+		"failed tx": {
+			bps:       5000,
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			contracts: []sdk.AccAddress{registered},
+			failed:    true,
+		},
+		"fee collector balance too low": {
+			bps:       types.MaxFeeShareBps,
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("denom", 2000)),
+			contracts: []sdk.AccAddress{registered},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			params := k.GetParams(ctx)
+			params.DeveloperFeeShareBps = spec.bps
+			require.NoError(t, k.SetParams(ctx, params))
+			txContracts := types.NewTxContracts()
+			for _, c := range spec.contracts {
+				txContracts.AddContractAddress(c)
+			}
+			ctx = types.WithTxContracts(ctx, txContracts)
+			var nextCalled bool
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			}
+
+			// when
+			_, gotErr := NewFeeShareDecorator(k).PostHandle(ctx, feeTxMock{fee: spec.fee}, false, !spec.failed, next)
+
+			// then
+			require.NoError(t, gotErr)
+			assert.True(t, nextCalled)
+			assert.Equal(t, spec.expPayout.String(), keepers.BankKeeper.GetAllBalances(ctx, withdrawAddr).String())
+			if spec.expOtherPaid {
+				assert.Equal(t, spec.expPayout.String(), keepers.BankKeeper.GetAllBalances(ctx, otherWithdrawAddr).String())
+			} else {
+				assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, otherWithdrawAddr).IsZero())
+			}
+		})
+	}
+}
+
+type feeTxMock struct {
+	sdk.FeeTx
+	fee      sdk.Coins
+	feePayer sdk.AccAddress
+	granter  sdk.AccAddress
+	msgs     []sdk.Msg
+}
+
+func (m feeTxMock) GetFee() sdk.Coins {
+	return m.fee
+}
+
+func (m feeTxMock) FeePayer() []byte {
+	return m.feePayer
+}
+
+func (m feeTxMock) FeeGranter() []byte {
+	return m.granter
+}
+
+func (m feeTxMock) GetMsgs() []sdk.Msg {
+	return m.msgs
+}
@@ -0,0 +1,298 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+var _ types.FeegrantKeeper = FeeSponsorGrantKeeper{}
+
+// FeeSponsorGrantKeeper lets contracts with a fee sponsorship pay the fees of txs that only execute them.
+// The contract is set as fee granter of the tx. All other fee grants are passed to the wrapped feegrant keeper.
+type FeeSponsorGrantKeeper struct {
+	keeper *Keeper
+	next   types.FeegrantKeeper
+}
+
+// NewFeeSponsorGrantKeeper constructor. The feegrant keeper is optional and can be nil.
+func NewFeeSponsorGrantKeeper(k *Keeper, next types.FeegrantKeeper) *FeeSponsorGrantKeeper {
+	if k == nil {
+		panic("keeper must not be nil")
+	}
+	return &FeeSponsorGrantKeeper{keeper: k, next: next}
+}
+
+// UseGrantedFees checks the messages and budgets when the granter is a sponsoring contract. The contract itself
+// approves the fee later in the FeeSponsorshipDecorator. Otherwise the call is passed to the wrapped feegrant keeper.
+func (g FeeSponsorGrantKeeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	sponsorship := g.keeper.GetFeeSponsorship(ctx, granter)
+	if sponsorship != nil {
+		return g.keeper.useFeeSponsorship(sdk.UnwrapSDKContext(ctx), *sponsorship, grantee, fee, msgs)
+	}
+	if g.next == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+	}
+	return g.next.UseGrantedFees(ctx, granter, grantee, fee, msgs)
+}
+
+// setFeeSponsorship stores a new or updates an existing fee sponsorship of the contract
+func (k Keeper) setFeeSponsorship(ctx context.Context, caller sdk.AccAddress, sponsorship types.FeeSponsorship, authZ types.AuthorizationPolicy) error {
+	if err := sponsorship.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(sponsorship.Contract)
+	if err := k.authorizeFeeSponsorshipChange(ctx, contractAddr, caller, authZ); err != nil {
+		return err
+	}
+	if err := k.storeFeeSponsorship(ctx, contractAddr, sponsorship); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetFeeSponsorship,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, sponsorship.Contract),
+	))
+	return nil
+}
+
+// removeFeeSponsorship deletes the fee sponsorship of the contract
+func (k Keeper) removeFeeSponsorship(ctx context.Context, caller, contractAddr sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	if err := k.authorizeFeeSponsorshipChange(ctx, contractAddr, caller, authZ); err != nil {
+		return err
+	}
+	if k.GetFeeSponsorship(ctx, contractAddr) == nil {
+		return errorsmod.Wrap(types.ErrNotFound, "fee sponsorship")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetFeeSponsorshipKey(contractAddr)); err != nil {
+		return err
+	}
+	if err := k.deleteFeeSponsorshipUsage(ctx, contractAddr); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveFeeSponsorship,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+	))
+	return nil
+}
+
+// authorizeFeeSponsorshipChange ensures the contract exists and the caller is allowed to modify it
+func (k Keeper) authorizeFeeSponsorshipChange(ctx context.Context, contractAddr, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	return nil
+}
+
+// importFeeSponsorship stores the fee sponsorship for an existing contract
+func (k Keeper) importFeeSponsorship(ctx context.Context, sponsorship types.FeeSponsorship) error {
+	if err := sponsorship.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(sponsorship.Contract)
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return types.ErrNoSuchContractFn(sponsorship.Contract).Wrapf("address %s", sponsorship.Contract)
+	}
+	if k.GetFeeSponsorship(ctx, contractAddr) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "fee sponsorship %s", sponsorship.Contract)
+	}
+	return k.storeFeeSponsorship(ctx, contractAddr, sponsorship)
+}
+
+func (k Keeper) storeFeeSponsorship(ctx context.Context, contractAddr sdk.AccAddress, sponsorship types.FeeSponsorship) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetFeeSponsorshipKey(contractAddr), k.cdc.MustMarshal(&sponsorship))
+}
+
+// GetFeeSponsorship returns the fee sponsorship of the contract or nil when not found
+func (k Keeper) GetFeeSponsorship(ctx context.Context, contractAddr sdk.AccAddress) *types.FeeSponsorship {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetFeeSponsorshipKey(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var sponsorship types.FeeSponsorship
+	k.cdc.MustUnmarshal(bz, &sponsorship)
+	return &sponsorship
+}
+
+// IterateFeeSponsorships iterates over all fee sponsorships ordered by contract address.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateFeeSponsorships(ctx context.Context, cb func(types.FeeSponsorship) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.FeeSponsorshipPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var sponsorship types.FeeSponsorship
+		k.cdc.MustUnmarshal(iter.Value(), &sponsorship)
+		if cb(sponsorship) {
+			break
+		}
+	}
+}
+
+// getFeeSponsorshipUsage returns the fees paid by the contract in the current block
+func (k Keeper) getFeeSponsorshipUsage(ctx sdk.Context, contractAddr sdk.AccAddress) types.FeeSponsorshipUsage {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetFeeSponsorshipUsageKey(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	var usage types.FeeSponsorshipUsage
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &usage)
+	}
+	if usage.Height != ctx.BlockHeight() {
+		// the budgets are reset with every block
+		return types.FeeSponsorshipUsage{Height: ctx.BlockHeight()}
+	}
+	return usage
+}
+
+// getFeeSponsorshipSenderUsage returns the fees paid by the contract for the sender in the current block
+func (k Keeper) getFeeSponsorshipSenderUsage(ctx sdk.Context, contractAddr, sender sdk.AccAddress) sdk.Coins {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetFeeSponsorshipSenderUsageKey(contractAddr, ctx.BlockHeight(), sender))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var usage types.FeeSponsorshipSenderUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage.Spent
+}
+
+// deleteFeeSponsorshipUsage deletes the fees paid by the contract in total and per sender
+func (k Keeper) deleteFeeSponsorshipUsage(ctx context.Context, contractAddr sdk.AccAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetFeeSponsorshipUsageKey(contractAddr)); err != nil {
+		return err
+	}
+	return k.deleteFeeSponsorshipSenderUsages(ctx, types.GetFeeSponsorshipSenderUsagesPrefix(contractAddr))
+}
+
+// pruneFeeSponsorshipSenderUsages deletes the fees paid per sender by all contracts. The per sender budgets are
+// reset with every block so that the entries are not needed anymore at the end of the block.
+func (k Keeper) pruneFeeSponsorshipSenderUsages(ctx context.Context) error {
+	return k.deleteFeeSponsorshipSenderUsages(ctx, types.FeeSponsorshipSenderUsagePrefix)
+}
+
+func (k Keeper) deleteFeeSponsorshipSenderUsages(ctx context.Context, keyPrefix []byte) error {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+	return nil
+}
+
+// useFeeSponsorship ensures that the tx contains only execute messages to the sponsoring contract, that the
+// contract is not frozen and that the fee is within the per block and per sender budgets. The contract approval is done separately in
+// approveFeeSponsorship, after the tx signatures were verified.
+func (k Keeper) useFeeSponsorship(ctx sdk.Context, sponsorship types.FeeSponsorship, feePayer sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	contractAddr, err := sdk.AccAddressFromBech32(sponsorship.Contract)
+	if err != nil {
+		return err
+	}
+	if _, err := sponsoredExecuteMsgs(sponsorship, msgs); err != nil {
+		return err
+	}
+	if err := k.assertContractNotFrozen(ctx, contractAddr); err != nil {
+		return err
+	}
+
+	usage := k.getFeeSponsorshipUsage(ctx, contractAddr)
+	usage.Spent = usage.Spent.Add(fee...)
+	if !usage.Spent.IsAllLTE(sponsorship.PerBlockLimit) {
+		return errorsmod.Wrap(types.ErrLimit, "fee sponsorship per block limit exceeded")
+	}
+	senderUsage := types.FeeSponsorshipSenderUsage{
+		Sender: feePayer.String(),
+		Spent:  k.getFeeSponsorshipSenderUsage(ctx, contractAddr, feePayer).Add(fee...),
+	}
+	if !senderUsage.Spent.IsAllLTE(sponsorship.PerSenderLimit) {
+		return errorsmod.Wrap(types.ErrLimit, "fee sponsorship per sender limit exceeded")
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetFeeSponsorshipUsageKey(contractAddr), k.cdc.MustMarshal(&usage)); err != nil {
+		return err
+	}
+	return store.Set(types.GetFeeSponsorshipSenderUsageKey(contractAddr, ctx.BlockHeight(), feePayer), k.cdc.MustMarshal(&senderUsage))
+}
+
+// approveFeeSponsorship calls sudo on the contract limited to the approval gas limit so that it can decide whether
+// it pays the fee. The gas consumed is charged to the tx.
+func (k Keeper) approveFeeSponsorship(ctx sdk.Context, sponsorship types.FeeSponsorship, feePayer sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	contractAddr, err := sdk.AccAddressFromBech32(sponsorship.Contract)
+	if err != nil {
+		return err
+	}
+	executeMsgs, err := sponsoredExecuteMsgs(sponsorship, msgs)
+	if err != nil {
+		return err
+	}
+	msg, err := json.Marshal(types.FeeSponsorshipSudoMsg{ApproveFeeSponsorship: &types.ApproveFeeSponsorshipMsg{
+		FeePayer: feePayer.String(),
+		Fee:      types.NewWasmCoins(fee),
+		Msgs:     executeMsgs,
+	}})
+	if err != nil {
+		return errorsmod.Wrap(err, "sudo msg")
+	}
+	gasUsed, err := k.sudoWithGasLimit(ctx, contractAddr, msg, sponsorship.ApprovalGasLimit)
+	ctx.GasMeter().ConsumeGas(gasUsed, "fee sponsorship approval")
+	if err != nil {
+		return errorsmod.Wrap(err, "fee sponsorship not approved")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSponsorFee,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, sponsorship.Contract),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, feePayer.String()),
+		sdk.NewAttribute(types.AttributeKeySponsoredFee, fee.String()),
+	))
+	return nil
+}
+
+// sponsoredExecuteMsgs converts the tx messages for the approval call. Only execute messages to the sponsoring
+// contract are accepted.
+func sponsoredExecuteMsgs(sponsorship types.FeeSponsorship, msgs []sdk.Msg) ([]types.SponsoredExecuteMsg, error) {
+	executeMsgs := make([]types.SponsoredExecuteMsg, len(msgs))
+	for i, msg := range msgs {
+		m, ok := msg.(*types.MsgExecuteContract)
+		if !ok || m.Contract != sponsorship.Contract {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only execute messages to the sponsoring contract can be sponsored")
+		}
+		executeMsgs[i] = types.SponsoredExecuteMsg{Sender: m.Sender, Msg: m.Msg, Funds: types.NewWasmCoins(m.Funds)}
+	}
+	return executeMsgs, nil
+}
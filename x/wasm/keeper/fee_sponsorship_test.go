@@ -0,0 +1,349 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSetFeeSponsorship(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	limit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+
+	specs := map[string]struct {
+		src    types.FeeSponsorship
+		caller sdk.AccAddress
+		policy types.AuthorizationPolicy
+		expErr *errorsmod.Error
+	}{
+		"admin": {
+			src:    types.FeeSponsorship{Contract: example.Contract.String(), PerBlockLimit: limit, PerSenderLimit: limit, ApprovalGasLimit: 100_000},
+			caller: example.CreatorAddr,
+			policy: DefaultAuthorizationPolicy{},
+		},
+		"gov": {
+			src:    types.FeeSponsorship{Contract: example.Contract.String(), PerBlockLimit: limit, PerSenderLimit: limit, ApprovalGasLimit: 100_000},
+			caller: RandomAccountAddress(t),
+			policy: GovAuthorizationPolicy{},
+		},
+		"other address": {
+			src:    types.FeeSponsorship{Contract: example.Contract.String(), PerBlockLimit: limit, PerSenderLimit: limit, ApprovalGasLimit: 100_000},
+			caller: RandomAccountAddress(t),
+			policy: DefaultAuthorizationPolicy{},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			src:    types.FeeSponsorship{Contract: RandomBech32AccountAddress(t), PerBlockLimit: limit, PerSenderLimit: limit, ApprovalGasLimit: 100_000},
+			caller: example.CreatorAddr,
+			policy: GovAuthorizationPolicy{},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		"approval gas limit exceeds max": {
+			src:    types.FeeSponsorship{Contract: example.Contract.String(), PerBlockLimit: limit, PerSenderLimit: limit, ApprovalGasLimit: types.MaxFeeSponsorshipApprovalGasLimit + 1},
+			caller: example.CreatorAddr,
+			policy: GovAuthorizationPolicy{},
+			expErr: types.ErrLimit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.setFeeSponsorship(ctx.WithEventManager(em), spec.caller, spec.src, spec.policy)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, &spec.src, k.GetFeeSponsorship(ctx, example.Contract))
+			expEvt := sdk.NewEvent("set_fee_sponsorship",
+				sdk.NewAttribute("_contract_address", spec.src.Contract))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+
+			// and when set again then the limits are updated
+			update := spec.src
+			update.ApprovalGasLimit++
+			require.NoError(t, k.setFeeSponsorship(ctx, spec.caller, update, spec.policy))
+			assert.Equal(t, &update, k.GetFeeSponsorship(ctx, example.Contract))
+
+			// and when removed by other address
+			err := k.removeFeeSponsorship(ctx, RandomAccountAddress(t), example.Contract, DefaultAuthorizationPolicy{})
+			require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+			// and when removed
+			em = sdk.NewEventManager()
+			require.NoError(t, k.removeFeeSponsorship(ctx.WithEventManager(em), spec.caller, example.Contract, spec.policy))
+			assert.Nil(t, k.GetFeeSponsorship(ctx, example.Contract))
+			expEvt = sdk.NewEvent("remove_fee_sponsorship",
+				sdk.NewAttribute("_contract_address", spec.src.Contract))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+			// and can not be removed twice
+			err = k.removeFeeSponsorship(ctx, spec.caller, example.Contract, spec.policy)
+			require.ErrorIs(t, err, types.ErrNotFound)
+		})
+	}
+}
+
+func TestFeeSponsorGrantKeeper(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	sponsor := SeedNewContractInstance(t, parentCtx, keepers, &mock).Contract
+	other := SeedNewContractInstance(t, parentCtx, keepers, &mock).Contract
+	feePayer := RandomAccountAddress(t)
+	require.NoError(t, k.importFeeSponsorship(parentCtx, types.FeeSponsorship{
+		Contract:         sponsor.String(),
+		PerBlockLimit:    sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+		PerSenderLimit:   sdk.NewCoins(sdk.NewInt64Coin("denom", 60)),
+		ApprovalGasLimit: 100_000,
+	}))
+	parentCtx = parentCtx.WithBlockHeight(10)
+
+	executeMsg := func(contract sdk.AccAddress, msg string) *types.MsgExecuteContract {
+		return &types.MsgExecuteContract{Sender: feePayer.String(), Contract: contract.String(), Msg: []byte(msg)}
+	}
+	fee := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("denom", amount))
+	}
+
+	t.Run("within budgets", func(t *testing.T) {
+		ctx, _ := parentCtx.CacheContext()
+		msgs := []sdk.Msg{executeMsg(sponsor, `{"foo":{}}`), executeMsg(sponsor, `{"bar":{}}`)}
+
+		// when
+		gotErr := NewFeeSponsorGrantKeeper(k, nil).UseGrantedFees(ctx, sponsor, feePayer, fee(50), msgs)
+
+		// then
+		require.NoError(t, gotErr)
+		// and the per sender limit applies within the block
+		gotErr = NewFeeSponsorGrantKeeper(k, nil).UseGrantedFees(ctx, sponsor, feePayer, fee(11), msgs)
+		require.ErrorIs(t, gotErr, types.ErrLimit)
+		// and the per block limit applies to all senders
+		otherMsgs := []sdk.Msg{&types.MsgExecuteContract{Sender: RandomBech32AccountAddress(t), Contract: sponsor.String(), Msg: []byte(`{}`)}}
+		require.NoError(t, NewFeeSponsorGrantKeeper(k, nil).UseGrantedFees(ctx, sponsor, RandomAccountAddress(t), fee(50), otherMsgs))
+		gotErr = NewFeeSponsorGrantKeeper(k, nil).UseGrantedFees(ctx, sponsor, RandomAccountAddress(t), fee(1), otherMsgs)
+		require.ErrorIs(t, gotErr, types.ErrLimit)
+		// and the budgets are reset in the next block
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		require.NoError(t, NewFeeSponsorGrantKeeper(k, nil).UseGrantedFees(ctx, sponsor, feePayer, fee(60), msgs))
+		assert.Equal(t, fee(60), k.getFeeSponsorshipSenderUsage(ctx, sponsor, feePayer))
+		// and the per sender usage is pruned at the end of the block
+		require.NoError(t, k.EndBlocker(ctx))
+		assert.Empty(t, k.getFeeSponsorshipSenderUsage(ctx, sponsor, feePayer))
+	})
+
+	specs := map[string]struct {
+		fee    sdk.Coins
+		msgs   []sdk.Msg
+		expErr *errorsmod.Error
+	}{
+		"execute other contract": {
+			fee:    fee(1),
+			msgs:   []sdk.Msg{executeMsg(sponsor, `{"foo":{}}`), executeMsg(other, `{"foo":{}}`)},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"non execute msg": {
+			fee:    fee(1),
+			msgs:   []sdk.Msg{&types.MsgClearAdmin{Sender: feePayer.String(), Contract: sponsor.String()}},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"per sender limit exceeded": {
+			fee:    fee(61),
+			msgs:   []sdk.Msg{executeMsg(sponsor, `{"foo":{}}`)},
+			expErr: types.ErrLimit,
+		},
+		"other denom": {
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("other", 1)),
+			msgs:   []sdk.Msg{executeMsg(sponsor, `{"foo":{}}`)},
+			expErr: types.ErrLimit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+
+			// when
+			gotErr := NewFeeSponsorGrantKeeper(k, nil).UseGrantedFees(ctx, sponsor, feePayer, spec.fee, spec.msgs)
+
+			// then
+			require.ErrorIs(t, gotErr, spec.expErr)
+			assert.Equal(t, types.FeeSponsorshipUsage{Height: ctx.BlockHeight()}, k.getFeeSponsorshipUsage(ctx, sponsor))
+		})
+	}
+
+	t.Run("frozen contract", func(t *testing.T) {
+		ctx, _ := parentCtx.CacheContext()
+		require.NoError(t, k.freezeContract(ctx, sponsor))
+
+		// when
+		gotErr := NewFeeSponsorGrantKeeper(k, nil).UseGrantedFees(ctx, sponsor, feePayer, fee(1), []sdk.Msg{executeMsg(sponsor, `{"foo":{}}`)})
+
+		// then
+		require.ErrorIs(t, gotErr, types.ErrContractFrozen)
+		assert.Equal(t, types.FeeSponsorshipUsage{Height: ctx.BlockHeight()}, k.getFeeSponsorshipUsage(ctx, sponsor))
+	})
+
+	t.Run("other granter", func(t *testing.T) {
+		ctx, _ := parentCtx.CacheContext()
+		var gotGranter sdk.AccAddress
+		next := feegrantKeeperMock{UseGrantedFeesFn: func(_ context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+			gotGranter = granter
+			return nil
+		}}
+		granter := RandomAccountAddress(t)
+		require.NoError(t, NewFeeSponsorGrantKeeper(k, next).UseGrantedFees(ctx, granter, feePayer, fee(1), nil))
+		assert.Equal(t, granter, gotGranter)
+		// and without feegrant keeper
+		gotErr := NewFeeSponsorGrantKeeper(k, nil).UseGrantedFees(ctx, granter, feePayer, fee(1), nil)
+		require.ErrorIs(t, gotErr, sdkerrors.ErrInvalidRequest)
+	})
+}
+
+func TestFeeSponsorshipDecorator(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	sponsor := SeedNewContractInstance(t, parentCtx, keepers, &mock).Contract
+	notSponsoring := SeedNewContractInstance(t, parentCtx, keepers, &mock).Contract
+	feePayer, otherFeePayer := RandomAccountAddress(t), RandomAccountAddress(t)
+	require.NoError(t, k.importFeeSponsorship(parentCtx, types.FeeSponsorship{
+		Contract:         sponsor.String(),
+		PerBlockLimit:    sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+		PerSenderLimit:   sdk.NewCoins(sdk.NewInt64Coin("denom", 60)),
+		ApprovalGasLimit: 100_000,
+	}))
+
+	var gotSudoMsgs []string
+	mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		gotSudoMsgs = append(gotSudoMsgs, string(sudoMsg))
+		var msg types.FeeSponsorshipSudoMsg
+		require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+		store.Set([]byte("approved"), []byte(msg.ApproveFeeSponsorship.FeePayer))
+		switch {
+		case msg.ApproveFeeSponsorship.FeePayer == otherFeePayer.String():
+			return &wasmvmtypes.ContractResult{Err: "not eligible"}, 1, nil
+		case string(msg.ApproveFeeSponsorship.Msgs[0].Msg) == `{"expensive":{}}`:
+			return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, gasLimit + 1, nil
+		}
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 1, nil
+	}
+	executeMsg := func(msg string) *types.MsgExecuteContract {
+		return &types.MsgExecuteContract{Sender: feePayer.String(), Contract: sponsor.String(), Msg: []byte(msg)}
+	}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("denom", 50))
+
+	t.Run("approved", func(t *testing.T) {
+		ctx, _ := parentCtx.CacheContext()
+		em := sdk.NewEventManager()
+		ctx = ctx.WithEventManager(em).WithGasMeter(storetypes.NewInfiniteGasMeter())
+		gotSudoMsgs = nil
+		tx := feeTxMock{fee: fee, feePayer: feePayer, granter: sponsor, msgs: []sdk.Msg{executeMsg(`{"foo":{}}`), executeMsg(`{"bar":{}}`)}}
+		var nextCalled bool
+		next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			nextCalled = true
+			return ctx, nil
+		}
+
+		// when
+		_, gotErr := NewFeeSponsorshipDecorator(k).AnteHandle(ctx, tx, false, next)
+
+		// then
+		require.NoError(t, gotErr)
+		assert.True(t, nextCalled)
+		exp := `{"approve_fee_sponsorship":{"fee_payer":"` + feePayer.String() + `","fee":[{"denom":"denom","amount":"50"}],"msgs":[` +
+			`{"sender":"` + feePayer.String() + `","msg":{"foo":{}},"funds":[]},` +
+			`{"sender":"` + feePayer.String() + `","msg":{"bar":{}},"funds":[]}]}}`
+		assert.Equal(t, []string{exp}, gotSudoMsgs)
+		assert.Equal(t, []byte(feePayer.String()), k.QueryRaw(ctx, sponsor, []byte("approved")))
+		assert.NotZero(t, ctx.GasMeter().GasConsumed())
+		expEvt := sdk.NewEvent("sponsor_fee",
+			sdk.NewAttribute("_contract_address", sponsor.String()),
+			sdk.NewAttribute("fee_payer", feePayer.String()),
+			sdk.NewAttribute("fee", "50denom"))
+		assert.Contains(t, em.Events(), expEvt)
+	})
+
+	specs := map[string]struct {
+		tx        feeTxMock
+		expCalled bool
+		expErr    *errorsmod.Error
+	}{
+		"not approved by contract": {
+			tx:        feeTxMock{fee: fee, feePayer: otherFeePayer, granter: sponsor, msgs: []sdk.Msg{executeMsg(`{"foo":{}}`)}},
+			expCalled: true,
+			expErr:    types.ErrExecuteFailed,
+		},
+		"approval out of gas": {
+			tx:        feeTxMock{fee: fee, feePayer: feePayer, granter: sponsor, msgs: []sdk.Msg{executeMsg(`{"expensive":{}}`)}},
+			expCalled: true,
+			expErr:    sdkerrors.ErrOutOfGas,
+		},
+		"non execute msg": {
+			tx:     feeTxMock{fee: fee, feePayer: feePayer, granter: sponsor, msgs: []sdk.Msg{&types.MsgClearAdmin{Sender: feePayer.String(), Contract: sponsor.String()}}},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"no fee granter": {
+			tx: feeTxMock{fee: fee, feePayer: feePayer, msgs: []sdk.Msg{executeMsg(`{"foo":{}}`)}},
+		},
+		"granter without sponsorship": {
+			tx: feeTxMock{fee: fee, feePayer: feePayer, granter: notSponsoring, msgs: []sdk.Msg{executeMsg(`{"foo":{}}`)}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			gotSudoMsgs = nil
+
+			// when
+			_, gotErr := NewFeeSponsorshipDecorator(k).AnteHandle(ctx, spec.tx, false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+
+			// then
+			assert.Equal(t, spec.expCalled, len(gotSudoMsgs) != 0)
+			assert.Nil(t, k.QueryRaw(ctx, sponsor, []byte("approved")))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+type feegrantKeeperMock struct {
+	UseGrantedFeesFn func(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
+func (m feegrantKeeperMock) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	if m.UseGrantedFeesFn == nil {
+		panic("not expected to be called")
+	}
+	return m.UseGrantedFeesFn(ctx, granter, grantee, fee, msgs)
+}
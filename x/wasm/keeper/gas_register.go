@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// GasRegister returns the gas register with the gas costs of the current params.
+// The gas register configured for the keeper is used when the params do not contain gas costs.
+func (k Keeper) GasRegister(ctx context.Context) types.GasRegister {
+	if p := k.gasRegisterParams(ctx); p != nil {
+		return types.NewWasmGasRegister(p.Config())
+	}
+	return k.gasRegister
+}
+
+// wasmVMAPI returns the contract API with the address costs of the current params.
+// The costs configured with `WithAPICosts` are used when the params do not contain gas costs.
+func (k Keeper) wasmVMAPI(ctx context.Context) wasmvm.GoAPI {
+	if p := k.gasRegisterParams(ctx); p != nil {
+		return newCosmwasmAPI(p.HumanizeAddressCost, p.CanonicalizeAddressCost, p.GasMultiplier)
+	}
+	return cosmwasmAPI
+}
+
+// gasRegisterParams returns the on-chain gas costs or nil when not set
+func (k Keeper) gasRegisterParams(ctx context.Context) *types.GasRegisterParams {
+	return k.GetParams(withoutGasCharge(ctx)).GasRegister
+}
+
+// withoutGasCharge returns a context that does not charge gas for store access. It is used for the point
+// lookups of chain settings that are done on every contract call, like frozen and deleted contracts, deprecated
+// codes, code gas multipliers, code schemas, the on-chain gas costs and storage deposits. The lookups are not
+// charged so that the gas consumed by a contract does not depend on which of these settings exist on the chain
+// and stays the same as without them.
+// It must only be used for a constant number of reads by key. Writes and iterations must always be charged.
+func withoutGasCharge(ctx context.Context) sdk.Context {
+	return sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestGasRegisterFromParams(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	querySmart := func() storetypes.Gas {
+		qCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := k.QuerySmart(qCtx, example.Contract, []byte(`{"verifier":{}}`))
+		require.NoError(t, err)
+		return qCtx.GasMeter().GasConsumed()
+	}
+	// keeper defaults without gas costs in params
+	assert.Equal(t, k.gasRegister, k.GasRegister(ctx))
+	_, gotCost, err := k.wasmVMAPI(ctx).CanonicalizeAddress(example.Contract.String())
+	require.NoError(t, err)
+	assert.Equal(t, costCanonical, gotCost)
+	defaultGas := querySmart()
+
+	// when
+	params := k.GetParams(ctx)
+	params.GasRegister = &types.GasRegisterParams{
+		InstanceCost:              types.DefaultInstanceCost + 1000,
+		InstanceCostDiscount:      types.DefaultInstanceCostDiscount,
+		CompileCost:               types.DefaultCompileCost,
+		UncompressCostNumerator:   15,
+		UncompressCostDenominator: 100,
+		GasMultiplier:             types.DefaultGasMultiplier,
+		EventPerAttributeCost:     types.DefaultPerAttributeCost,
+		EventAttributeDataCost:    types.DefaultEventAttributeDataCost,
+		CustomEventCost:           types.DefaultPerCustomEventCost,
+		HumanizeAddressCost:       1,
+		CanonicalizeAddressCost:   2,
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// then
+	assert.Equal(t, types.DefaultInstanceCost+1000, k.GasRegister(ctx).SetupContractCost(false, 0))
+	assert.Equal(t, defaultGas+1000, querySmart())
+	api := k.wasmVMAPI(ctx)
+	_, gotCost, err = api.HumanizeAddress(example.Contract)
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultGasMultiplier, gotCost)
+	_, gotCost, err = api.CanonicalizeAddress(example.Contract.String())
+	require.NoError(t, err)
+	assert.Equal(t, 2*types.DefaultGasMultiplier, gotCost)
+	gotCost, err = api.ValidateAddress(example.Contract.String())
+	require.NoError(t, err)
+	assert.Equal(t, 3*types.DefaultGasMultiplier, gotCost)
+
+	// and the ante decorator reads the current values
+	ante := NewGasRegisterSourceDecorator(k)
+	_, err = ante.AnteHandle(ctx, nil, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		gasRegister, ok := types.GasRegisterFromContext(ctx)
+		require.True(t, ok)
+		assert.Equal(t, types.DefaultInstanceCost+1000, gasRegister.SetupContractCost(false, 0))
+		return ctx, nil
+	})
+	require.NoError(t, err)
+}
@@ -41,6 +41,17 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 				return nil, errorsmod.Wrapf(err, "contract number %d", i)
 			}
 		}
+		if code.Deprecated {
+			if err := keeper.deprecateCode(ctx, code.CodeID); err != nil {
+				return nil, errorsmod.Wrapf(err, "code number %d", i)
+			}
+		}
+	}
+
+	for i, checksum := range data.DeprecatedChecksums {
+		if err := keeper.deprecateChecksum(ctx, checksum); err != nil {
+			return nil, errorsmod.Wrapf(err, "deprecated checksum number %d", i)
+		}
 	}
 
 	for i, contract := range data.Contracts {
@@ -52,6 +63,83 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "contract number %d", i)
 		}
+		if contract.Frozen {
+			if err := keeper.freezeContract(ctx, contractAddr); err != nil {
+				return nil, errorsmod.Wrapf(err, "contract number %d", i)
+			}
+		}
+	}
+
+	for i, schedule := range data.CronSchedules {
+		if err := keeper.importCronSchedule(ctx, schedule); err != nil {
+			return nil, errorsmod.Wrapf(err, "cron schedule number %d", i)
+		}
+	}
+
+	for i, subscription := range data.EpochHookSubscriptions {
+		if err := keeper.importEpochHookSubscription(ctx, subscription); err != nil {
+			return nil, errorsmod.Wrapf(err, "epoch hook subscription number %d", i)
+		}
+	}
+
+	for i, feeShare := range data.FeeShares {
+		if err := keeper.importFeeShare(ctx, feeShare); err != nil {
+			return nil, errorsmod.Wrapf(err, "fee share number %d", i)
+		}
+	}
+
+	for i, sponsorship := range data.FeeSponsorships {
+		if err := keeper.importFeeSponsorship(ctx, sponsorship); err != nil {
+			return nil, errorsmod.Wrapf(err, "fee sponsorship number %d", i)
+		}
+	}
+
+	for i, transfer := range data.PendingAdminTransfers {
+		if err := keeper.importPendingAdminTransfer(ctx, transfer); err != nil {
+			return nil, errorsmod.Wrapf(err, "pending admin transfer number %d", i)
+		}
+	}
+
+	for i, contractName := range data.ContractNames {
+		if err := keeper.importContractName(ctx, contractName); err != nil {
+			return nil, errorsmod.Wrapf(err, "contract name number %d", i)
+		}
+	}
+
+	for i, deleted := range data.DeletedContracts {
+		if err := keeper.importDeletedContract(ctx, deleted); err != nil {
+			return nil, errorsmod.Wrapf(err, "deleted contract number %d", i)
+		}
+	}
+
+	for i, deposit := range data.StorageDeposits {
+		if err := keeper.importStorageDeposit(ctx, deposit); err != nil {
+			return nil, errorsmod.Wrapf(err, "storage deposit number %d", i)
+		}
+	}
+
+	for i, count := range data.CodeExecutionCounts {
+		if err := keeper.importCodeExecutionCount(ctx, count); err != nil {
+			return nil, errorsmod.Wrapf(err, "code execution count number %d", i)
+		}
+	}
+
+	for i, code := range data.AutoPinnedCodes {
+		if err := keeper.importAutoPinnedCode(ctx, code); err != nil {
+			return nil, errorsmod.Wrapf(err, "auto pinned code number %d", i)
+		}
+	}
+
+	for i, multiplier := range data.CodeGasMultipliers {
+		if err := keeper.importCodeGasMultiplier(ctx, multiplier); err != nil {
+			return nil, errorsmod.Wrapf(err, "code gas multiplier number %d", i)
+		}
+	}
+
+	for i, schema := range data.CodeSchemas {
+		if err := keeper.importCodeSchema(ctx, schema); err != nil {
+			return nil, errorsmod.Wrapf(err, "code schema number %d", i)
+		}
 	}
 
 	for i, seq := range data.Sequences {
@@ -94,14 +182,20 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 			panic(err)
 		}
 		genState.Codes = append(genState.Codes, types.Code{
-			CodeID:    codeID,
-			CodeInfo:  info,
-			CodeBytes: bytecode,
-			Pinned:    keeper.IsPinnedCode(ctx, codeID),
+			CodeID:     codeID,
+			CodeInfo:   info,
+			CodeBytes:  bytecode,
+			Pinned:     keeper.IsPinnedCode(ctx, codeID),
+			Deprecated: keeper.isCodeIDDeprecated(ctx, codeID),
 		})
 		return false
 	})
 
+	keeper.iterateDeprecatedChecksums(ctx, func(checksum []byte) bool {
+		genState.DeprecatedChecksums = append(genState.DeprecatedChecksums, checksum)
+		return false
+	})
+
 	keeper.IterateContractInfo(ctx, func(addr sdk.AccAddress, contract types.ContractInfo) bool {
 		var state []types.Model
 		keeper.IterateContractState(ctx, addr, func(key, value []byte) bool {
@@ -116,10 +210,74 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 			ContractInfo:        contract,
 			ContractState:       state,
 			ContractCodeHistory: contractCodeHistory,
+			Frozen:              keeper.IsContractFrozen(ctx, addr),
+		})
+		return false
+	})
+
+	keeper.IterateCronSchedules(ctx, func(schedule types.CronSchedule) bool {
+		genState.CronSchedules = append(genState.CronSchedules, schedule)
+		return false
+	})
+
+	keeper.IterateEpochHookSubscriptions(ctx, func(subscription types.EpochHookSubscription) bool {
+		genState.EpochHookSubscriptions = append(genState.EpochHookSubscriptions, subscription)
+		return false
+	})
+
+	keeper.IterateFeeShares(ctx, func(feeShare types.FeeShare) bool {
+		genState.FeeShares = append(genState.FeeShares, feeShare)
+		return false
+	})
+
+	keeper.IterateFeeSponsorships(ctx, func(sponsorship types.FeeSponsorship) bool {
+		genState.FeeSponsorships = append(genState.FeeSponsorships, sponsorship)
+		return false
+	})
+
+	keeper.IteratePendingAdminTransfers(ctx, func(transfer types.PendingAdminTransfer) bool {
+		genState.PendingAdminTransfers = append(genState.PendingAdminTransfers, transfer)
+		return false
+	})
+
+	keeper.IterateContractNames(ctx, func(contractName types.ContractName) bool {
+		genState.ContractNames = append(genState.ContractNames, contractName)
+		return false
+	})
+
+	keeper.IterateDeletedContracts(ctx, func(contractAddr sdk.AccAddress) bool {
+		genState.DeletedContracts = append(genState.DeletedContracts, types.DeletedContract{
+			ContractAddress:     contractAddr.String(),
+			ContractCodeHistory: keeper.GetContractHistory(ctx, contractAddr),
 		})
 		return false
 	})
 
+	keeper.IterateStorageDeposits(ctx, func(deposit types.StorageDeposit) bool {
+		genState.StorageDeposits = append(genState.StorageDeposits, deposit)
+		return false
+	})
+
+	keeper.IterateCodeExecutionCounts(ctx, func(count types.CodeExecutionCount) bool {
+		genState.CodeExecutionCounts = append(genState.CodeExecutionCounts, count)
+		return false
+	})
+
+	keeper.IterateAutoPinnedCodes(ctx, func(code types.AutoPinnedCode) bool {
+		genState.AutoPinnedCodes = append(genState.AutoPinnedCodes, code)
+		return false
+	})
+
+	keeper.IterateCodeGasMultipliers(ctx, func(multiplier types.CodeGasMultiplier) bool {
+		genState.CodeGasMultipliers = append(genState.CodeGasMultipliers, multiplier)
+		return false
+	})
+
+	keeper.IterateCodeSchemas(ctx, func(schema types.CodeSchema) bool {
+		genState.CodeSchemas = append(genState.CodeSchemas, schema)
+		return false
+	})
+
 	for _, k := range [][]byte{types.KeySequenceCodeID, types.KeySequenceInstanceID} {
 		id, err := keeper.PeekAutoIncrementID(ctx, k)
 		if err != nil {
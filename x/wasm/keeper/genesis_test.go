@@ -55,14 +55,42 @@ func TestGenesisExportImport(t *testing.T) {
 			stateModels       []types.Model
 			history           []types.ContractCodeHistoryEntry
 			pinned            bool
+			frozen            bool
+			deprecated        bool
 			contractExtension bool
+			cronSchedule      types.CronSchedule
+			epochHook         bool
+			feeShare          bool
+			feeSponsorship    bool
+			adminTransfer     bool
+			codeMetadata      bool
+			contractName      bool
+			storageDeposit    bool
+			deleted           bool
+			autoPin           bool
+			gasMultiplier     bool
+			codeSchema        bool
 		)
 		f.Fuzz(&codeInfo)
 		f.Fuzz(&contract)
 		f.Fuzz(&stateModels)
 		f.NilChance(0).Fuzz(&history)
 		f.Fuzz(&pinned)
+		f.Fuzz(&frozen)
+		f.Fuzz(&deprecated)
 		f.Fuzz(&contractExtension)
+		f.Fuzz(&cronSchedule)
+		f.Fuzz(&epochHook)
+		f.Fuzz(&feeShare)
+		f.Fuzz(&feeSponsorship)
+		f.Fuzz(&adminTransfer)
+		f.Fuzz(&codeMetadata)
+		f.Fuzz(&contractName)
+		f.Fuzz(&storageDeposit)
+		f.Fuzz(&deleted)
+		f.Fuzz(&autoPin)
+		f.Fuzz(&gasMultiplier)
+		f.Fuzz(&codeSchema)
 
 		creatorAddr, err := sdk.AccAddressFromBech32(codeInfo.Creator)
 		require.NoError(t, err)
@@ -72,6 +100,16 @@ func TestGenesisExportImport(t *testing.T) {
 			err = contractKeeper.PinCode(srcCtx, codeID)
 			require.NoError(t, err)
 		}
+		if deprecated {
+			require.NoError(t, wasmKeeper.deprecateCode(srcCtx, codeID))
+		}
+		if codeMetadata {
+			require.NoError(t, wasmKeeper.setCodeMetadata(srcCtx, codeID, creatorAddr, types.CodeMetadata{
+				SourceRepository: "https://github.com/CosmWasm/cosmwasm",
+				Commit:           "v1.0.0",
+				SchemaHash:       "0123456789abcdef",
+			}))
+		}
 		if contractExtension {
 			anyTime := time.Now().UTC()
 			var nestedType v1beta1.TextProposal
@@ -88,7 +126,87 @@ func TestGenesisExportImport(t *testing.T) {
 		require.NoError(t, wasmKeeper.appendToContractHistory(srcCtx, contractAddr, history...))
 		err = wasmKeeper.importContractState(srcCtx, contractAddr, stateModels)
 		require.NoError(t, err)
+		if frozen {
+			require.NoError(t, wasmKeeper.freezeContract(srcCtx, contractAddr))
+		}
+		if cronSchedule.Interval != 0 && cronSchedule.GasLimit != 0 {
+			cronSchedule.Name = fmt.Sprintf("schedule-%d", i)
+			cronSchedule.Contract = contractAddr.String()
+			cronSchedule.Msg = []byte(`{}`)
+			cronSchedule.GasLimit = cronSchedule.GasLimit%types.MaxCronGasLimit + 1
+			require.NoError(t, wasmKeeper.importCronSchedule(srcCtx, cronSchedule))
+		}
+		if epochHook {
+			require.NoError(t, wasmKeeper.importEpochHookSubscription(srcCtx, types.EpochHookSubscription{
+				Contract:        contractAddr.String(),
+				EpochIdentifier: "week",
+				GasLimit:        1,
+			}))
+		}
+		if feeShare {
+			require.NoError(t, wasmKeeper.importFeeShare(srcCtx, types.FeeShare{
+				Contract:        contractAddr.String(),
+				WithdrawAddress: codeInfo.Creator,
+			}))
+		}
+		if feeSponsorship {
+			require.NoError(t, wasmKeeper.importFeeSponsorship(srcCtx, types.FeeSponsorship{
+				Contract:         contractAddr.String(),
+				PerBlockLimit:    sdk.NewCoins(sdk.NewInt64Coin("denom", 2)),
+				PerSenderLimit:   sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+				ApprovalGasLimit: 1,
+			}))
+		}
+		if adminTransfer {
+			expiresAt := time.Unix(1_700_000_000, 0).UTC()
+			require.NoError(t, wasmKeeper.importPendingAdminTransfer(srcCtx, types.PendingAdminTransfer{
+				Contract:  contractAddr.String(),
+				NewAdmin:  codeInfo.Creator,
+				ExpiresAt: &expiresAt,
+			}))
+		}
+		if contractName {
+			require.NoError(t, wasmKeeper.importContractName(srcCtx, types.ContractName{
+				Name:     fmt.Sprintf("contract-%d", i),
+				Contract: contractAddr.String(),
+			}))
+		}
+		if storageDeposit {
+			require.NoError(t, wasmKeeper.importStorageDeposit(srcCtx, types.StorageDeposit{
+				Contract: contractAddr.String(),
+				Amount:   sdk.NewInt64Coin("denom", int64(i+1)),
+			}))
+		}
+		if autoPin {
+			require.NoError(t, wasmKeeper.importCodeExecutionCount(srcCtx, types.CodeExecutionCount{
+				Height:     int64(i + 1),
+				CodeID:     codeID,
+				Executions: uint64(i + 1),
+			}))
+			if pinned {
+				require.NoError(t, wasmKeeper.importAutoPinnedCode(srcCtx, types.AutoPinnedCode{CodeID: codeID, CodeSize: 1}))
+			}
+		}
+		if gasMultiplier {
+			require.NoError(t, wasmKeeper.importCodeGasMultiplier(srcCtx, types.CodeGasMultiplier{CodeID: codeID, MultiplierBps: 5_000}))
+		}
+		if codeSchema {
+			require.NoError(t, wasmKeeper.importCodeSchema(srcCtx, types.CodeSchema{CodeID: codeID, Schema: compressedHackatomTestSchema(t), ValidateMessages: true}))
+		}
+		if deleted {
+			require.NoError(t, wasmKeeper.importDeletedContract(srcCtx, types.DeletedContract{
+				ContractAddress: BuildContractAddressClassic(codeID, uint64(1_000_000+i)).String(),
+				ContractCodeHistory: []types.ContractCodeHistoryEntry{
+					{Operation: types.ContractCodeHistoryOperationTypeInit, CodeID: codeID, Updated: &types.AbsoluteTxPosition{BlockHeight: 1}, Msg: []byte(`{}`)},
+					{Operation: types.ContractCodeHistoryOperationTypeDelete, CodeID: codeID, Updated: &types.AbsoluteTxPosition{BlockHeight: 2}, Msg: []byte(`{}`)},
+				},
+			}))
+		}
 	}
+	var deprecatedChecksum [32]byte
+	f.Fuzz(&deprecatedChecksum)
+	require.NoError(t, wasmKeeper.deprecateChecksum(srcCtx, deprecatedChecksum[:]))
+
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
 	err = wasmKeeper.SetParams(srcCtx, wasmParams)
@@ -121,6 +239,8 @@ func TestGenesisExportImport(t *testing.T) {
 		require.NoError(t, err)
 		err = wasmKeeper.addToContractCreatorSecondaryIndex(srcCtx, creatorAddress, history[0].Updated, address)
 		require.NoError(t, err)
+		err = wasmKeeper.addToContractAdminSecondaryIndex(srcCtx, info.AdminAddr(), address)
+		require.NoError(t, err)
 		return false
 	})
 
@@ -197,6 +317,23 @@ func TestGenesisInit(t *testing.T) {
 			},
 			expSuccess: true,
 		},
+		"happy path: deprecated code id and checksum": {
+			src: types.GenesisState{
+				Codes: []types.Code{{
+					CodeID:     1,
+					CodeInfo:   myCodeInfo,
+					CodeBytes:  wasmCode,
+					Deprecated: true,
+				}},
+				DeprecatedChecksums: [][]byte{myCodeInfo.CodeHash},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeySequenceCodeID, Value: 2},
+					{IDKey: types.KeySequenceInstanceID, Value: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+			expSuccess: true,
+		},
 		"happy path: code ids can contain gaps": {
 			src: types.GenesisState{
 				Codes: []types.Code{{
@@ -307,6 +444,187 @@ func TestGenesisInit(t *testing.T) {
 			},
 			expSuccess: true,
 		},
+		"happy path: cron schedule, epoch hook, fee share, fee sponsorship, admin transfer and contract name": {
+			src: types.GenesisState{
+				Codes: []types.Code{{
+					CodeID:    1,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Contracts: []types.Contract{
+					{
+						ContractAddress: BuildContractAddressClassic(1, 1).String(),
+						ContractInfo:    types.ContractInfoFixture(func(c *types.ContractInfo) { c.CodeID = 1 }, types.RandCreatedFields),
+						ContractCodeHistory: []types.ContractCodeHistoryEntry{
+							{
+								Operation: types.ContractCodeHistoryOperationTypeMigrate,
+								CodeID:    1,
+								Updated:   &types.AbsoluteTxPosition{BlockHeight: rand.Uint64(), TxIndex: rand.Uint64()},
+								Msg:       []byte(`{}`),
+							},
+						},
+					},
+				},
+				CronSchedules: []types.CronSchedule{{
+					Name:       "my-schedule",
+					Contract:   BuildContractAddressClassic(1, 1).String(),
+					Msg:        []byte(`{}`),
+					Interval:   1,
+					GasLimit:   1,
+					NextHeight: 2,
+					ErrorCount: 3,
+				}},
+				EpochHookSubscriptions: []types.EpochHookSubscription{{
+					Contract:        BuildContractAddressClassic(1, 1).String(),
+					EpochIdentifier: "week",
+					GasLimit:        1,
+				}},
+				FeeShares: []types.FeeShare{{
+					Contract:        BuildContractAddressClassic(1, 1).String(),
+					WithdrawAddress: myCodeInfo.Creator,
+				}},
+				FeeSponsorships: []types.FeeSponsorship{{
+					Contract:         BuildContractAddressClassic(1, 1).String(),
+					PerBlockLimit:    sdk.NewCoins(sdk.NewInt64Coin("denom", 2)),
+					PerSenderLimit:   sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+					ApprovalGasLimit: 1,
+				}},
+				PendingAdminTransfers: []types.PendingAdminTransfer{{
+					Contract: BuildContractAddressClassic(1, 1).String(),
+					NewAdmin: myCodeInfo.Creator,
+				}},
+				ContractNames: []types.ContractName{{
+					Name:     "mantra.dex.pool-factory",
+					Contract: BuildContractAddressClassic(1, 1).String(),
+				}},
+				StorageDeposits: []types.StorageDeposit{{
+					Contract: BuildContractAddressClassic(1, 1).String(),
+					Amount:   sdk.NewInt64Coin("denom", 1),
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeySequenceCodeID, Value: 2},
+					{IDKey: types.KeySequenceInstanceID, Value: 2},
+				},
+				Params: types.DefaultParams(),
+			},
+			expSuccess: true,
+		},
+		"cron schedule for unknown contract": {
+			src: types.GenesisState{
+				CronSchedules: []types.CronSchedule{{
+					Name:       "my-schedule",
+					Contract:   BuildContractAddressClassic(1, 1).String(),
+					Msg:        []byte(`{}`),
+					Interval:   1,
+					GasLimit:   1,
+					NextHeight: 2,
+					ErrorCount: 3,
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeySequenceCodeID, Value: 1},
+					{IDKey: types.KeySequenceInstanceID, Value: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+		},
+		"epoch hook subscription for unknown contract": {
+			src: types.GenesisState{
+				EpochHookSubscriptions: []types.EpochHookSubscription{{
+					Contract:        BuildContractAddressClassic(1, 1).String(),
+					EpochIdentifier: "week",
+					GasLimit:        1,
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeySequenceCodeID, Value: 1},
+					{IDKey: types.KeySequenceInstanceID, Value: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+		},
+		"fee share for unknown contract": {
+			src: types.GenesisState{
+				FeeShares: []types.FeeShare{{
+					Contract:        BuildContractAddressClassic(1, 1).String(),
+					WithdrawAddress: myCodeInfo.Creator,
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeySequenceCodeID, Value: 1},
+					{IDKey: types.KeySequenceInstanceID, Value: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+		},
+		"fee sponsorship for unknown contract": {
+			src: types.GenesisState{
+				FeeSponsorships: []types.FeeSponsorship{{
+					Contract:         BuildContractAddressClassic(1, 1).String(),
+					PerBlockLimit:    sdk.NewCoins(sdk.NewInt64Coin("denom", 2)),
+					PerSenderLimit:   sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+					ApprovalGasLimit: 1,
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeySequenceCodeID, Value: 1},
+					{IDKey: types.KeySequenceInstanceID, Value: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+		},
+		"pending admin transfer for unknown contract": {
+			src: types.GenesisState{
+				PendingAdminTransfers: []types.PendingAdminTransfer{{
+					Contract: BuildContractAddressClassic(1, 1).String(),
+					NewAdmin: myCodeInfo.Creator,
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeySequenceCodeID, Value: 1},
+					{IDKey: types.KeySequenceInstanceID, Value: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+		},
+		"contract name for unknown contract": {
+			src: types.GenesisState{
+				ContractNames: []types.ContractName{{
+					Name:     "mantra.dex.pool-factory",
+					Contract: BuildContractAddressClassic(1, 1).String(),
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeySequenceCodeID, Value: 1},
+					{IDKey: types.KeySequenceInstanceID, Value: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+		},
+		"storage deposit for unknown contract": {
+			src: types.GenesisState{
+				StorageDeposits: []types.StorageDeposit{{
+					Contract: BuildContractAddressClassic(1, 1).String(),
+					Amount:   sdk.NewInt64Coin("denom", 1),
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeySequenceCodeID, Value: 1},
+					{IDKey: types.KeySequenceInstanceID, Value: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+		},
+		"happy path: deleted contract": {
+			src: types.GenesisState{
+				DeletedContracts: []types.DeletedContract{{
+					ContractAddress: BuildContractAddressClassic(1, 1).String(),
+					ContractCodeHistory: []types.ContractCodeHistoryEntry{
+						{Operation: types.ContractCodeHistoryOperationTypeInit, CodeID: 1, Updated: &types.AbsoluteTxPosition{BlockHeight: 1}, Msg: []byte(`{}`)},
+						{Operation: types.ContractCodeHistoryOperationTypeDelete, CodeID: 1, Updated: &types.AbsoluteTxPosition{BlockHeight: 2}, Msg: []byte(`{}`)},
+					},
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeySequenceCodeID, Value: 1},
+					{IDKey: types.KeySequenceInstanceID, Value: 2},
+				},
+				Params: types.DefaultParams(),
+			},
+			expSuccess: true,
+		},
 		"happy path: code info with two contracts": {
 			src: types.GenesisState{
 				Codes: []types.Code{{
@@ -500,6 +818,26 @@ func TestGenesisInit(t *testing.T) {
 
 			for _, c := range spec.src.Codes {
 				assert.Equal(t, c.Pinned, keeper.IsPinnedCode(ctx, c.CodeID))
+				assert.Equal(t, c.Deprecated, keeper.isCodeIDDeprecated(ctx, c.CodeID))
+			}
+			for _, checksum := range spec.src.DeprecatedChecksums {
+				assert.True(t, keeper.isChecksumDeprecated(ctx, checksum))
+			}
+			for _, schedule := range spec.src.CronSchedules {
+				assert.Equal(t, &schedule, keeper.GetCronSchedule(ctx, schedule.Name))
+			}
+			for _, subscription := range spec.src.EpochHookSubscriptions {
+				contractAddr := sdk.MustAccAddressFromBech32(subscription.Contract)
+				assert.Equal(t, &subscription, keeper.GetEpochHookSubscription(ctx, subscription.EpochIdentifier, contractAddr))
+			}
+			for _, feeShare := range spec.src.FeeShares {
+				assert.Equal(t, &feeShare, keeper.GetFeeShare(ctx, sdk.MustAccAddressFromBech32(feeShare.Contract)))
+			}
+			for _, sponsorship := range spec.src.FeeSponsorships {
+				assert.Equal(t, &sponsorship, keeper.GetFeeSponsorship(ctx, sdk.MustAccAddressFromBech32(sponsorship.Contract)))
+			}
+			for _, transfer := range spec.src.PendingAdminTransfers {
+				assert.Equal(t, &transfer, keeper.GetPendingAdminTransfer(ctx, sdk.MustAccAddressFromBech32(transfer.Contract)))
 			}
 		})
 	}
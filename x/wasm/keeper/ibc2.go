@@ -71,11 +71,13 @@ func (module IBC2Handler) OnRecvPacket(
 	em := sdk.NewEventManager()
 	msg := wasmvmtypes.IBC2PacketReceiveMsg{Payload: newIBC2Payload(payload), Relayer: relayer.String(), SourceClient: sourceClient, PacketSequence: sequence}
 
-	ack := module.keeper.OnRecvIBC2Packet(ctx.WithEventManager(em), contractAddr, msg)
+	recvCtx, commitTxContracts := types.WithTxContractsScope(ctx.WithEventManager(em))
+	ack := module.keeper.OnRecvIBC2Packet(recvCtx, contractAddr, msg)
 
 	if ack.Status == channeltypesv2.PacketStatus_Success {
 		// emit all contract and submessage events on success
 		ctx.EventManager().EmitEvents(em.Events())
+		commitTxContracts()
 	}
 	types.EmitAcknowledgementIBC2Event(ctx, contractAddr, ack, err)
 
@@ -146,13 +148,17 @@ func (k Keeper) OnAckIBC2Packet(
 	if err != nil {
 		return err
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddr); err != nil {
+		return err
+	}
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	gasRegister := k.codeGasRegister(ctx, contractInfo.CodeID)
+	querier := k.newQueryHandler(ctx, contractAddr, gasRegister)
 
-	gasLeft := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBC2PacketAck(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
+	gasLeft := k.runtimeGasForContract(ctx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.IBC2PacketAck(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasRegister, gasUsed)
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -183,13 +189,20 @@ func (k Keeper) OnRecvIBC2Packet(
 			Acknowledgement: []byte(err.Error()),
 		}
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddr); err != nil {
+		return channeltypesv2.RecvPacketResult{
+			Status:          channeltypesv2.PacketStatus_Failure,
+			Acknowledgement: []byte(err.Error()),
+		}
+	}
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	gasRegister := k.codeGasRegister(ctx, contractInfo.CodeID)
+	querier := k.newQueryHandler(ctx, contractAddr, gasRegister)
 
-	gasLeft := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBC2PacketReceive(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
+	gasLeft := k.runtimeGasForContract(ctx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.IBC2PacketReceive(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasRegister, gasUsed)
 	if execErr != nil {
 		panic(execErr) // let the contract fully abort an IBC packet receive.
 		// Throwing a panic here instead of an error ack will revert
@@ -248,13 +261,17 @@ func (k Keeper) OnTimeoutIBC2Packet(
 	if err != nil {
 		return err
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddr); err != nil {
+		return err
+	}
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	gasRegister := k.codeGasRegister(ctx, contractInfo.CodeID)
+	querier := k.newQueryHandler(ctx, contractAddr, gasRegister)
 
-	gasLeft := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBC2PacketTimeout(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
+	gasLeft := k.runtimeGasForContract(ctx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.IBC2PacketTimeout(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasRegister, gasUsed)
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -283,13 +300,17 @@ func (k Keeper) OnSendIBC2Packet(
 	if err != nil {
 		return err
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddr); err != nil {
+		return err
+	}
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	gasRegister := k.codeGasRegister(ctx, contractInfo.CodeID)
+	querier := k.newQueryHandler(ctx, contractAddr, gasRegister)
 
-	gasLeft := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBC2PacketSend(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
+	gasLeft := k.runtimeGasForContract(ctx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.IBC2PacketSend(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasRegister, gasUsed)
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -89,6 +89,7 @@ type Keeper struct {
 	cdc                   codec.Codec
 	accountKeeper         types.AccountKeeper
 	bank                  CoinTransferrer
+	bankView              types.BankViewKeeper
 	wasmVM                types.WasmEngine
 	wasmVMQueryHandler    WasmVMQueryHandler
 	wasmVMResponseHandler WasmVMResponseHandler
@@ -116,6 +117,13 @@ type Keeper struct {
 
 	// wasmLimits contains the limits sent to wasmvm on init
 	wasmLimits wasmvmtypes.WasmLimits
+
+	// simulateExecuteDisabled turns off the SimulateExecute query
+	simulateExecuteDisabled bool
+	// simulationMinter credits funds to the sender in a simulated execution. Optional.
+	simulationMinter coinMinter
+	// epochsKeeper verifies the epoch identifiers of new epoch hook subscriptions. Optional.
+	epochsKeeper types.EpochsKeeper
 }
 
 func (k Keeper) getUploadAccessConfig(ctx context.Context) types.AccessConfig {
@@ -173,15 +181,16 @@ func (k Keeper) create(ctx context.Context, creator sdk.AccAddress, wasmCode []b
 		return 0, checksum, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not create code")
 	}
 
+	gasRegister := k.GasRegister(sdkCtx)
 	if ioutils.IsGzip(wasmCode) {
-		sdkCtx.GasMeter().ConsumeGas(k.gasRegister.UncompressCosts(len(wasmCode)), "Uncompress gzip bytecode")
+		sdkCtx.GasMeter().ConsumeGas(gasRegister.UncompressCosts(len(wasmCode)), "Uncompress gzip bytecode")
 		wasmCode, err = ioutils.Uncompress(wasmCode, int64(types.MaxWasmSize))
 		if err != nil {
 			return 0, checksum, types.ErrCreateFailed.Wrap(errorsmod.Wrap(err, "uncompress wasm archive").Error())
 		}
 	}
 
-	gasLeft := k.runtimeGasForContract(sdkCtx)
+	gasLeft := k.runtimeGasForContract(sdkCtx, gasRegister)
 	var gasUsed uint64
 	isSimulation := sdkCtx.ExecMode() == sdk.ExecModeSimulate
 	if isSimulation {
@@ -190,7 +199,7 @@ func (k Keeper) create(ctx context.Context, creator sdk.AccAddress, wasmCode []b
 	} else {
 		checksum, gasUsed, err = k.wasmVM.StoreCode(wasmCode, gasLeft)
 	}
-	k.consumeRuntimeGas(sdkCtx, gasUsed)
+	k.consumeRuntimeGas(sdkCtx, gasRegister, gasUsed)
 	if err != nil {
 		return 0, checksum, errorsmod.Wrap(types.ErrCreateFailed, err.Error())
 	}
@@ -207,6 +216,9 @@ func (k Keeper) create(ctx context.Context, creator sdk.AccAddress, wasmCode []b
 	k.Logger(sdkCtx).Debug("storing new contract", "capabilities", requiredCapabilities, "code_id", codeID)
 	codeInfo := types.NewCodeInfo(checksum, creator, *instantiateAccess)
 	k.mustStoreCodeInfo(sdkCtx, codeID, codeInfo)
+	if err := k.addToCodeChecksumSecondaryIndex(sdkCtx, checksum, codeID); err != nil {
+		return 0, checksum, err
+	}
 
 	evt := sdk.NewEvent(
 		types.EventTypeStoreCode,
@@ -255,10 +267,32 @@ func (k Keeper) importCode(ctx context.Context, codeID uint64, codeInfo types.Co
 	if ok {
 		return errorsmod.Wrapf(types.ErrDuplicate, "duplicate code: %d", codeID)
 	}
+	if err := k.addToCodeChecksumSecondaryIndex(ctx, codeInfo.CodeHash, codeID); err != nil {
+		return err
+	}
 	// 0x01 | codeID (uint64) -> ContractInfo
 	return store.Set(key, k.cdc.MustMarshal(&codeInfo))
 }
 
+// addToCodeChecksumSecondaryIndex adds element to the index for codes-by-checksum queries
+func (k Keeper) addToCodeChecksumSecondaryIndex(ctx context.Context, checksum []byte, codeID uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetCodeByChecksumSecondaryIndexKey(checksum, codeID), []byte{})
+}
+
+// IterateCodesByChecksum iterates over all code ids stored with the given checksum ASC.
+func (k Keeper) IterateCodesByChecksum(ctx context.Context, checksum []byte, cb func(codeID uint64) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetCodesByChecksumPrefix(checksum))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(binary.BigEndian.Uint64(iter.Key())) {
+			return
+		}
+	}
+}
+
 func (k Keeper) instantiate(
 	ctx context.Context,
 	codeID uint64,
@@ -268,8 +302,10 @@ func (k Keeper) instantiate(
 	deposit sdk.Coins,
 	addressGenerator AddressGenerator,
 	authPolicy types.AuthorizationPolicy,
-) (sdk.AccAddress, []byte, error) {
+) (_ sdk.AccAddress, _ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "instantiate")
+	span := startTraceSpan(sdk.UnwrapSDKContext(ctx), traceTypeInstantiate, nil, initMsg)
+	defer func() { span.end(err) }()
 
 	if creator == nil {
 		return nil, nil, types.ErrEmpty.Wrap("creator")
@@ -280,9 +316,14 @@ func (k Keeper) instantiate(
 	if codeInfo == nil {
 		return nil, nil, types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
 	}
+	if err := k.assertCodeNotDeprecated(ctx, codeID, codeInfo.CodeHash); err != nil {
+		return nil, nil, err
+	}
 
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(sdkCtx, codeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, len(initMsg))
+	k.recordCodeExecution(sdkCtx, codeID)
+	gasRegister := k.codeGasRegister(sdkCtx, codeID)
+	setupCost := gasRegister.SetupContractCost(discount, len(initMsg))
 
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: instantiate")
 
@@ -290,12 +331,16 @@ func (k Keeper) instantiate(
 		return nil, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
 	}
 	contractAddress := addressGenerator(ctx, codeID, codeInfo.CodeHash)
+	span.setContract(contractAddress)
 	if k.HasContractInfo(ctx, contractAddress) {
 		// This case must only happen for instantiate2 because instantiate is based on a counter in state.
 		// So we create an instantiate2 specific error message here even though technically this function
 		// is used for both cases.
 		return nil, nil, types.ErrDuplicate.Wrap("contract address already exists, try a different combination of creator, checksum and salt")
 	}
+	if k.IsContractDeleted(withoutGasCharge(ctx), contractAddress) {
+		return nil, nil, types.ErrDuplicate.Wrap("contract address was used by a deleted contract, try a different combination of creator, checksum and salt")
+	}
 
 	// check account
 	// every cosmos module can define custom account types when needed. The cosmos-sdk comes with extension points
@@ -342,16 +387,15 @@ func (k Keeper) instantiate(
 
 	// create prefixed data store
 	// 0x03 | BuildContractAddressClassic (sdk.AccAddress)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	vmStore := types.NewStoreAdapter(prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(sdkCtx)), prefixStoreKey))
+	vmStore := types.NewStoreAdapter(k.contractStore(sdkCtx, contractAddress))
 
 	// prepare querier
-	querier := k.newQueryHandler(sdkCtx, contractAddress)
+	querier := k.newQueryHandler(sdkCtx, contractAddress, gasRegister)
 
 	// instantiate wasm contract
-	gasLeft := k.runtimeGasForContract(sdkCtx)
-	res, gasUsed, err := k.wasmVM.Instantiate(codeInfo.CodeHash, env, info, initMsg, vmStore, cosmwasmAPI, querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(sdkCtx, gasUsed)
+	gasLeft := k.runtimeGasForContract(sdkCtx, gasRegister)
+	res, gasUsed, err := k.wasmVM.Instantiate(codeInfo.CodeHash, env, info, initMsg, vmStore, k.wasmVMAPI(ctx), querier, k.gasMeter(sdkCtx, gasRegister), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(sdkCtx, gasRegister, gasUsed)
 	if err != nil {
 		return nil, nil, errorsmod.Wrap(types.ErrVMError, err.Error())
 	}
@@ -390,6 +434,10 @@ func (k Keeper) instantiate(
 	if err != nil {
 		return nil, nil, err
 	}
+	err = k.addToContractAdminSecondaryIndex(sdkCtx, admin, contractAddress)
+	if err != nil {
+		return nil, nil, err
+	}
 	err = k.appendToContractHistory(sdkCtx, contractAddress, historyEntry)
 	if err != nil {
 		return nil, nil, err
@@ -404,25 +452,37 @@ func (k Keeper) instantiate(
 	))
 
 	sdkCtx = types.WithSubMsgAuthzPolicy(sdkCtx, authPolicy.SubMessageAuthorizationPolicy(types.AuthZActionInstantiate))
+	sdkCtx, payer := storageDepositPayer(sdkCtx, creator)
 	data, err := k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
 	if err != nil {
 		return nil, nil, errorsmod.Wrap(err, "dispatch")
 	}
+	if err := k.settleStorageDeposit(sdkCtx, contractAddress, payer); err != nil {
+		return nil, nil, err
+	}
 
 	return contractAddress, data, nil
 }
 
 // Execute executes the contract instance
-func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (_ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "execute")
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	span := startTraceSpan(sdkCtx, traceTypeExecute, contractAddress, msg)
+	defer func() { span.end(err) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddress); err != nil {
+		return nil, err
+	}
 
+	trackTxContract(sdkCtx, contractAddress)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, len(msg))
+	k.recordCodeExecution(sdkCtx, contractInfo.CodeID)
+	gasRegister := k.codeGasRegister(sdkCtx, contractInfo.CodeID)
+	setupCost := gasRegister.SetupContractCost(discount, len(msg))
 
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: execute")
 
@@ -437,10 +497,10 @@ func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddr
 	info := types.NewInfo(caller, coins)
 
 	// prepare querier
-	querier := k.newQueryHandler(sdkCtx, contractAddress)
-	gasLeft := k.runtimeGasForContract(sdkCtx)
-	res, gasUsed, execErr := k.wasmVM.Execute(codeInfo.CodeHash, env, info, msg, prefixStore, cosmwasmAPI, querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(sdkCtx, gasUsed)
+	querier := k.newQueryHandler(sdkCtx, contractAddress, gasRegister)
+	gasLeft := k.runtimeGasForContract(sdkCtx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.Execute(codeInfo.CodeHash, env, info, msg, prefixStore, k.wasmVMAPI(ctx), querier, k.gasMeter(sdkCtx, gasRegister), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(sdkCtx, gasRegister, gasUsed)
 	if execErr != nil {
 		return nil, errorsmod.Wrap(types.ErrVMError, execErr.Error())
 	}
@@ -457,10 +517,14 @@ func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddr
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 	))
 
+	sdkCtx, payer := storageDepositPayer(sdkCtx, caller)
 	data, err := k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.settleStorageDeposit(sdkCtx, contractAddress, payer); err != nil {
+		return nil, err
+	}
 
 	return data, nil
 }
@@ -472,10 +536,12 @@ func (k Keeper) migrate(
 	newCodeID uint64,
 	msg []byte,
 	authZ types.AuthorizationPolicy,
-) ([]byte, error) {
+) (_ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "migrate")
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	span := startTraceSpan(sdkCtx, traceTypeMigrate, contractAddress, msg)
+	defer func() { span.end(err) }()
 
 	contractInfo := k.GetContractInfo(ctx, contractAddress)
 	if contractInfo == nil {
@@ -484,11 +550,17 @@ func (k Keeper) migrate(
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not migrate")
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddress); err != nil {
+		return nil, err
+	}
 
 	newCodeInfo := k.GetCodeInfo(ctx, newCodeID)
 	if newCodeInfo == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown code")
 	}
+	if err := k.assertCodeNotDeprecated(ctx, newCodeID, newCodeInfo.CodeHash); err != nil {
+		return nil, err
+	}
 
 	if !authZ.CanInstantiateContract(newCodeInfo.InstantiateConfig, caller) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "to use new code")
@@ -556,6 +628,7 @@ func (k Keeper) migrate(
 	))
 
 	var data []byte
+	sdkCtx, payer := storageDepositPayer(sdkCtx, caller)
 
 	// if migrate entry point was called
 	if response != nil {
@@ -572,7 +645,9 @@ func (k Keeper) migrate(
 		if err != nil {
 			return nil, errorsmod.Wrap(err, "dispatch")
 		}
-		return data, nil
+	}
+	if err := k.settleStorageDeposit(sdkCtx, contractAddress, payer); err != nil {
+		return nil, err
 	}
 
 	return data, nil
@@ -587,26 +662,28 @@ func (k Keeper) callMigrateEntrypoint(
 	senderAddress sdk.AccAddress,
 	oldMigrateVersion *uint64,
 ) (*wasmvmtypes.Response, error) {
+	trackTxContract(sdkCtx, contractAddress)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, newChecksum, k.IsPinnedCode(sdkCtx, newCodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, len(msg))
+	k.recordCodeExecution(sdkCtx, newCodeID)
+	gasRegister := k.codeGasRegister(sdkCtx, newCodeID)
+	setupCost := gasRegister.SetupContractCost(discount, len(msg))
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: migrate")
 
 	env := types.NewEnv(sdkCtx, k.txHash, contractAddress)
 
 	// prepare querier
-	querier := k.newQueryHandler(sdkCtx, contractAddress)
+	querier := k.newQueryHandler(sdkCtx, contractAddress, gasRegister)
 
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	vmStore := types.NewStoreAdapter(prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(sdkCtx)), prefixStoreKey))
-	gasLeft := k.runtimeGasForContract(sdkCtx)
+	vmStore := types.NewStoreAdapter(k.contractStore(sdkCtx, contractAddress))
+	gasLeft := k.runtimeGasForContract(sdkCtx, gasRegister)
 
 	migrateInfo := wasmvmtypes.MigrateInfo{
 		Sender:            senderAddress.String(),
 		OldMigrateVersion: oldMigrateVersion,
 	}
-	res, gasUsed, err := k.wasmVM.MigrateWithInfo(newChecksum, env, msg, migrateInfo, vmStore, cosmwasmAPI, &querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
+	res, gasUsed, err := k.wasmVM.MigrateWithInfo(newChecksum, env, msg, migrateInfo, vmStore, k.wasmVMAPI(sdkCtx), &querier, k.gasMeter(sdkCtx, gasRegister), gasLeft, costJSONDeserialization)
 
-	k.consumeRuntimeGas(sdkCtx, gasUsed)
+	k.consumeRuntimeGas(sdkCtx, gasRegister, gasUsed)
 	if err != nil {
 		return nil, errorsmod.Wrap(types.ErrVMError, err.Error())
 	}
@@ -628,26 +705,31 @@ func (k Keeper) callMigrateEntrypoint(
 // customized though by passing a new policy with the context. See types.WithSubMsgAuthzPolicy.
 // The policy will be read in msgServer.selectAuthorizationPolicy and used for sub-message executions.
 // This is an extension point for some very advanced scenarios only. Use with care!
-func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) (_ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "sudo")
+	span := startTraceSpan(sdk.UnwrapSDKContext(ctx), traceTypeSudo, contractAddress, msg)
+	defer func() { span.end(err) }()
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	trackTxContract(sdkCtx, contractAddress)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, len(msg))
+	k.recordCodeExecution(sdkCtx, contractInfo.CodeID)
+	gasRegister := k.codeGasRegister(sdkCtx, contractInfo.CodeID)
+	setupCost := gasRegister.SetupContractCost(discount, len(msg))
 
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: sudo")
 
 	env := types.NewEnv(sdkCtx, k.txHash, contractAddress)
 
 	// prepare querier
-	querier := k.newQueryHandler(sdkCtx, contractAddress)
-	gasLeft := k.runtimeGasForContract(sdkCtx)
-	res, gasUsed, execErr := k.wasmVM.Sudo(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(sdkCtx, gasUsed)
+	querier := k.newQueryHandler(sdkCtx, contractAddress, gasRegister)
+	gasLeft := k.runtimeGasForContract(sdkCtx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.Sudo(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, k.gasMeter(sdkCtx, gasRegister), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(sdkCtx, gasRegister, gasUsed)
 	if execErr != nil {
 		return nil, errorsmod.Wrap(types.ErrVMError, execErr.Error())
 	}
@@ -665,32 +747,71 @@ func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []
 	))
 
 	// sudo submessages are executed with the default authorization policy
+	sdkCtx, payer := storageDepositPayer(sdkCtx, nil)
 	data, err := k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "dispatch")
 	}
+	if err := k.settleStorageDeposit(sdkCtx, contractAddress, payer); err != nil {
+		return nil, err
+	}
 
 	return data, nil
 }
 
+// sudoWithGasLimit calls sudo on the contract in a cached context with its own gas meter that is limited to the given
+// gas limit. Out of gas and other panics are returned as errors. State changes and events are committed only when the
+// call succeeded. The gas consumed is returned so that the caller can decide whether it is charged.
+// This is used for calls that the chain makes on behalf of a contract, like cron schedules, epoch hooks or fee
+// sponsorship approvals. They do not count for the developer fee share of a tx. Frozen contracts are not called.
+func (k Keeper) sudoWithGasLimit(parentCtx sdk.Context, contractAddr sdk.AccAddress, msg []byte, gasLimit storetypes.Gas) (gasUsed storetypes.Gas, err error) {
+	if err := k.assertContractNotFrozen(parentCtx, contractAddr); err != nil {
+		return 0, err
+	}
+	cacheCtx, commit := parentCtx.CacheContext()
+	ctx, _ := types.WithTxContractsScope(cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit)))
+	defer func() {
+		if r := recover(); r != nil {
+			if rType, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", rType.Descriptor)
+			} else {
+				err = errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r)
+			}
+		}
+		gasUsed = ctx.GasMeter().GasConsumedToLimit()
+	}()
+	if _, err := k.Sudo(ctx, contractAddr, msg); err != nil {
+		return 0, err
+	}
+	commit()
+	return 0, nil
+}
+
 // reply is only called from keeper internal functions (dispatchSubmessages) after processing the submessage
-func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) (_ []byte, err error) {
+	span := startTraceSpan(ctx, traceTypeReply, contractAddress, reply)
+	span.setID(reply.ID)
+	defer func() { span.end(err) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddress); err != nil {
+		return nil, err
+	}
 
-	replyCosts := k.gasRegister.ReplyCosts(true, reply)
+	gasRegister := k.codeGasRegister(ctx, contractInfo.CodeID)
+	replyCosts := gasRegister.ReplyCosts(true, reply)
 	ctx.GasMeter().ConsumeGas(replyCosts, "Loading CosmWasm module: reply")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddress)
 
 	// prepare querier
-	querier := k.newQueryHandler(ctx, contractAddress)
-	gasLeft := k.runtimeGasForContract(ctx)
+	querier := k.newQueryHandler(ctx, contractAddress, gasRegister)
+	gasLeft := k.runtimeGasForContract(ctx, gasRegister)
 
-	res, gasUsed, execErr := k.wasmVM.Reply(codeInfo.CodeHash, env, reply, prefixStore, cosmwasmAPI, querier, k.gasMeter(ctx), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
+	res, gasUsed, execErr := k.wasmVM.Reply(codeInfo.CodeHash, env, reply, prefixStore, k.wasmVMAPI(ctx), querier, k.gasMeter(ctx, gasRegister), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasRegister, gasUsed)
 	if execErr != nil {
 		return nil, errorsmod.Wrap(types.ErrVMError, execErr.Error())
 	}
@@ -732,6 +853,42 @@ func (k Keeper) addToContractCreatorSecondaryIndex(ctx context.Context, creatorA
 	return store.Set(types.GetContractByCreatorSecondaryIndexKey(creatorAddress, position.Bytes(), contractAddress), []byte{})
 }
 
+// removeFromContractCreatorSecondaryIndex removes element from the index for contracts-by-creator queries
+func (k Keeper) removeFromContractCreatorSecondaryIndex(ctx context.Context, creatorAddress sdk.AccAddress, position *types.AbsoluteTxPosition, contractAddress sdk.AccAddress) error {
+	return k.storeService.OpenKVStore(ctx).Delete(types.GetContractByCreatorSecondaryIndexKey(creatorAddress, position.Bytes(), contractAddress))
+}
+
+// addToContractAdminSecondaryIndex adds element to the index for contracts-by-admin queries. Contracts without
+// admin are not indexed.
+func (k Keeper) addToContractAdminSecondaryIndex(ctx context.Context, adminAddress, contractAddress sdk.AccAddress) error {
+	if len(adminAddress) == 0 {
+		return nil
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetContractByAdminSecondaryIndexKey(adminAddress, contractAddress), []byte{})
+}
+
+// removeFromContractAdminSecondaryIndex removes element from the index for contracts-by-admin queries
+func (k Keeper) removeFromContractAdminSecondaryIndex(ctx context.Context, adminAddress, contractAddress sdk.AccAddress) error {
+	if len(adminAddress) == 0 {
+		return nil
+	}
+	return k.storeService.OpenKVStore(ctx).Delete(types.GetContractByAdminSecondaryIndexKey(adminAddress, contractAddress))
+}
+
+// IterateContractsByAdmin iterates over all contracts with given admin address ordered by contract address.
+func (k Keeper) IterateContractsByAdmin(ctx context.Context, admin sdk.AccAddress, cb func(address sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractsByAdminPrefix(admin))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()) {
+			return
+		}
+	}
+}
+
 // IterateContractsByCreator iterates over all contracts with given creator address in order of creation time asc.
 func (k Keeper) IterateContractsByCreator(ctx context.Context, creator sdk.AccAddress, cb func(address sdk.AccAddress) bool) {
 	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractsByCreatorPrefix(creator))
@@ -766,10 +923,25 @@ func (k Keeper) setContractAdmin(ctx context.Context, contractAddress, caller, n
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
 	}
+	return k.changeContractAdmin(sdkCtx, contractAddress, contractInfo, newAdmin)
+}
+
+// changeContractAdmin stores the new admin without authorization checks. A pending admin transfer is
+// removed as it is superseded by the change.
+func (k Keeper) changeContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, contractInfo *types.ContractInfo, newAdmin sdk.AccAddress) error {
+	if err := k.removeFromContractAdminSecondaryIndex(ctx, contractInfo.AdminAddr(), contractAddress); err != nil {
+		return err
+	}
+	if err := k.addToContractAdminSecondaryIndex(ctx, newAdmin, contractAddress); err != nil {
+		return err
+	}
 	newAdminStr := newAdmin.String()
 	contractInfo.Admin = newAdminStr
-	k.mustStoreContractInfo(sdkCtx, contractAddress, contractInfo)
-	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+	k.mustStoreContractInfo(ctx, contractAddress, contractInfo)
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.GetPendingAdminTransferKey(contractAddress)); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeUpdateContractAdmin,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 		sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdminStr),
@@ -874,15 +1046,16 @@ func (k Keeper) QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req
 	}
 
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, len(req))
+	gasRegister := k.codeGasRegister(sdkCtx, contractInfo.CodeID)
+	setupCost := gasRegister.SetupContractCost(discount, len(req))
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: query")
 
 	// prepare querier
-	querier := k.newQueryHandler(sdkCtx, contractAddr)
+	querier := k.newQueryHandler(sdkCtx, contractAddr, gasRegister)
 
 	env := types.NewEnv(sdkCtx, k.txHash, contractAddr)
-	queryResult, gasUsed, qErr := k.wasmVM.Query(codeInfo.CodeHash, env, req, prefixStore, cosmwasmAPI, querier, k.gasMeter(sdkCtx), k.runtimeGasForContract(sdkCtx), costJSONDeserialization)
-	k.consumeRuntimeGas(sdkCtx, gasUsed)
+	queryResult, gasUsed, qErr := k.wasmVM.Query(codeInfo.CodeHash, env, req, prefixStore, k.wasmVMAPI(ctx), querier, k.gasMeter(sdkCtx, gasRegister), k.runtimeGasForContract(sdkCtx, gasRegister), costJSONDeserialization)
+	k.consumeRuntimeGas(sdkCtx, gasRegister, gasUsed)
 	if qErr != nil {
 		return nil, errorsmod.Wrap(types.ErrVMError, qErr.Error())
 	}
@@ -1004,9 +1177,7 @@ func (k Keeper) contractInstance(ctx context.Context, contractAddress sdk.AccAdd
 	}
 	var codeInfo types.CodeInfo
 	k.cdc.MustUnmarshal(codeInfoBz, &codeInfo)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), prefixStoreKey)
-	return contractInfo, codeInfo, types.NewStoreAdapter(prefixStore), nil
+	return contractInfo, codeInfo, types.NewStoreAdapter(k.contractStore(ctx, contractAddress)), nil
 }
 
 func (k Keeper) LoadAsyncAckPacket(ctx context.Context, portID, channelID string, sequence uint64) (channeltypes.Packet, error) {
@@ -1114,8 +1285,7 @@ func (k Keeper) IterateContractState(ctx context.Context, contractAddress sdk.Ac
 }
 
 func (k Keeper) importContractState(ctx context.Context, contractAddress sdk.AccAddress, models []types.Model) error {
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), prefixStoreKey)
+	prefixStore := k.contractStore(ctx, contractAddress)
 	for _, model := range models {
 		if model.Value == nil {
 			model.Value = []byte{}
@@ -1197,6 +1367,10 @@ func (k Keeper) pinCode(ctx context.Context, codeID uint64) error {
 	if err != nil {
 		return err
 	}
+	// a code pinned by governance is no longer managed by automatic pinning
+	if err := store.Delete(types.GetAutoPinnedCodeKey(codeID)); err != nil {
+		return err
+	}
 
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypePinCode,
@@ -1220,6 +1394,9 @@ func (k Keeper) unpinCode(ctx context.Context, codeID uint64) error {
 	if err != nil {
 		return err
 	}
+	if err := store.Delete(types.GetAutoPinnedCodeKey(codeID)); err != nil {
+		return err
+	}
 
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeUnpinCode,
@@ -1228,6 +1405,182 @@ func (k Keeper) unpinCode(ctx context.Context, codeID uint64) error {
 	return nil
 }
 
+// freezeContract pauses the given contract until it is unfrozen again
+func (k Keeper) freezeContract(ctx context.Context, contractAddress sdk.AccAddress) error {
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return types.ErrNoSuchContractFn(contractAddress.String()).Wrapf("address %s", contractAddress.String())
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	// store 1 byte to not run into `nil` debugging issues
+	if err := store.Set(types.GetFrozenContractKey(contractAddress), []byte{1}); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFreezeContract,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+	))
+	return nil
+}
+
+// unfreezeContract resumes a frozen contract
+func (k Keeper) unfreezeContract(ctx context.Context, contractAddress sdk.AccAddress) error {
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return types.ErrNoSuchContractFn(contractAddress.String()).Wrapf("address %s", contractAddress.String())
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetFrozenContractKey(contractAddress)); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnfreezeContract,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+	))
+	return nil
+}
+
+// IsContractFrozen returns true when the contract was frozen by governance
+func (k Keeper) IsContractFrozen(ctx context.Context, contractAddress sdk.AccAddress) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	ok, err := store.Has(types.GetFrozenContractKey(contractAddress))
+	if err != nil {
+		panic(err)
+	}
+	return ok
+}
+
+// assertContractNotFrozen returns an error when the contract must not be called because it is frozen
+func (k Keeper) assertContractNotFrozen(ctx context.Context, contractAddress sdk.AccAddress) error {
+	if k.IsContractFrozen(withoutGasCharge(ctx), contractAddress) {
+		return errorsmod.Wrapf(types.ErrContractFrozen, "address %s", contractAddress.String())
+	}
+	return nil
+}
+
+// deprecateCode marks the code id as deprecated so that it can not be used for new instances or migrations anymore
+func (k Keeper) deprecateCode(ctx context.Context, codeID uint64) error {
+	if k.GetCodeInfo(ctx, codeID) == nil {
+		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	// store 1 byte to not run into `nil` debugging issues
+	if err := store.Set(types.GetDeprecatedCodeIDKey(codeID), []byte{1}); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeprecateCode,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+	))
+	return nil
+}
+
+// undeprecateCode removes the deprecation flag from the code id
+func (k Keeper) undeprecateCode(ctx context.Context, codeID uint64) error {
+	if k.GetCodeInfo(ctx, codeID) == nil {
+		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetDeprecatedCodeIDKey(codeID)); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUndeprecateCode,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+	))
+	return nil
+}
+
+// deprecateChecksum marks the checksum as deprecated. This covers all code ids with the same checksum, including
+// codes that are not stored yet.
+func (k Keeper) deprecateChecksum(ctx context.Context, checksum []byte) error {
+	if err := types.ValidateChecksum(checksum); err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	// store 1 byte to not run into `nil` debugging issues
+	if err := store.Set(types.GetDeprecatedChecksumKey(checksum), []byte{1}); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeprecateCode,
+		sdk.NewAttribute(types.AttributeKeyChecksum, hex.EncodeToString(checksum)),
+	))
+	return nil
+}
+
+// undeprecateChecksum removes the deprecation flag from the checksum
+func (k Keeper) undeprecateChecksum(ctx context.Context, checksum []byte) error {
+	if err := types.ValidateChecksum(checksum); err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetDeprecatedChecksumKey(checksum)); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUndeprecateCode,
+		sdk.NewAttribute(types.AttributeKeyChecksum, hex.EncodeToString(checksum)),
+	))
+	return nil
+}
+
+// IsCodeDeprecated returns true when the code id or its checksum was deprecated by governance
+func (k Keeper) IsCodeDeprecated(ctx context.Context, codeID uint64) bool {
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return false
+	}
+	return k.isCodeIDDeprecated(ctx, codeID) || k.isChecksumDeprecated(ctx, codeInfo.CodeHash)
+}
+
+// isCodeIDDeprecated returns true when the code id itself was deprecated
+func (k Keeper) isCodeIDDeprecated(ctx context.Context, codeID uint64) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	ok, err := store.Has(types.GetDeprecatedCodeIDKey(codeID))
+	if err != nil {
+		panic(err)
+	}
+	return ok
+}
+
+// isChecksumDeprecated returns true when the checksum was deprecated
+func (k Keeper) isChecksumDeprecated(ctx context.Context, checksum []byte) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	ok, err := store.Has(types.GetDeprecatedChecksumKey(checksum))
+	if err != nil {
+		panic(err)
+	}
+	return ok
+}
+
+// iterateDeprecatedChecksums iterates over all deprecated checksums
+func (k Keeper) iterateDeprecatedChecksums(ctx context.Context, cb func(checksum []byte) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DeprecatedChecksumPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(iter.Key()) {
+			return
+		}
+	}
+}
+
+// assertCodeNotDeprecated returns an error when the code must not be used as instantiate or migration target
+func (k Keeper) assertCodeNotDeprecated(ctx context.Context, codeID uint64, checksum []byte) error {
+	freeCtx := withoutGasCharge(ctx)
+	if k.isCodeIDDeprecated(freeCtx, codeID) || k.isChecksumDeprecated(freeCtx, checksum) {
+		return errorsmod.Wrapf(types.ErrCodeDeprecated, "code id %d", codeID)
+	}
+	return nil
+}
+
 // IsPinnedCode returns true when codeID is pinned in wasmvm cache
 func (k Keeper) IsPinnedCode(ctx context.Context, codeID uint64) bool {
 	store := k.storeService.OpenKVStore(ctx)
@@ -1313,6 +1666,29 @@ func (k Keeper) setAccessConfig(ctx context.Context, codeID uint64, caller sdk.A
 	return nil
 }
 
+// setCodeMetadata replaces the build metadata of a code. Only the code creator is authorized. An empty
+// metadata removes it.
+func (k Keeper) setCodeMetadata(ctx context.Context, codeID uint64, caller sdk.AccAddress, metadata types.CodeMetadata) error {
+	info := k.GetCodeInfo(ctx, codeID)
+	if info == nil {
+		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	if info.Creator != caller.String() {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify code metadata")
+	}
+	if metadata.IsEmpty() {
+		info.Metadata = nil
+	} else {
+		info.Metadata = &metadata
+	}
+	k.mustStoreCodeInfo(ctx, codeID, *info)
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetCodeMetadata,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+	))
+	return nil
+}
+
 // handleContractResponse processes the contract response data by emitting events and sending sub-/messages.
 func (k *Keeper) handleContractResponse(
 	ctx sdk.Context,
@@ -1323,7 +1699,7 @@ func (k *Keeper) handleContractResponse(
 	data []byte,
 	evts wasmvmtypes.Array[wasmvmtypes.Event],
 ) ([]byte, error) {
-	attributeGasCost := k.gasRegister.EventCosts(attrs, evts)
+	attributeGasCost := k.GasRegister(ctx).EventCosts(attrs, evts)
 	ctx.GasMeter().ConsumeGas(attributeGasCost, "Custom contract event attributes")
 	// emit all events from this contract itself
 	if len(attrs) != 0 {
@@ -1343,7 +1719,7 @@ func (k *Keeper) handleContractResponse(
 	return k.wasmVMResponseHandler.Handle(ctx, contractAddr, ibcPort, msgs, data)
 }
 
-func (k Keeper) runtimeGasForContract(ctx sdk.Context) uint64 {
+func (k Keeper) runtimeGasForContract(ctx sdk.Context, gasRegister types.GasRegister) uint64 {
 	meter := ctx.GasMeter()
 	if meter.IsOutOfGas() {
 		return 0
@@ -1351,11 +1727,11 @@ func (k Keeper) runtimeGasForContract(ctx sdk.Context) uint64 {
 	if meter.Limit() == math.MaxUint64 { // infinite gas meter and not out of gas
 		return math.MaxUint64
 	}
-	return k.gasRegister.ToWasmVMGas(meter.Limit() - meter.GasConsumedToLimit())
+	return gasRegister.ToWasmVMGas(meter.Limit() - meter.GasConsumedToLimit())
 }
 
-func (k Keeper) consumeRuntimeGas(ctx sdk.Context, gas uint64) {
-	consumed := k.gasRegister.FromWasmVMGas(gas)
+func (k Keeper) consumeRuntimeGas(ctx sdk.Context, gasRegister types.GasRegister, gas uint64) {
+	consumed := gasRegister.FromWasmVMGas(gas)
 	ctx.GasMeter().ConsumeGas(consumed, "wasm contract")
 	// throw OutOfGas error if we ran out (got exactly to zero due to better limit enforcing)
 	if ctx.GasMeter().IsOutOfGas() {
@@ -1412,7 +1788,7 @@ func (k Keeper) importContract(ctx context.Context, contractAddr sdk.AccAddress,
 	if !k.containsCodeInfo(ctx, c.CodeID) {
 		return types.ErrNoSuchCodeFn(c.CodeID).Wrapf("code id %d", c.CodeID)
 	}
-	if k.HasContractInfo(ctx, contractAddr) {
+	if k.HasContractInfo(ctx, contractAddr) || k.IsContractDeleted(ctx, contractAddr) {
 		return errorsmod.Wrapf(types.ErrDuplicate, "contract: %s", contractAddr)
 	}
 	if len(historyEntries) == 0 {
@@ -1437,11 +1813,15 @@ func (k Keeper) importContract(ctx context.Context, contractAddr sdk.AccAddress,
 	if err != nil {
 		return err
 	}
+	err = k.addToContractAdminSecondaryIndex(ctx, c.AdminAddr(), contractAddr)
+	if err != nil {
+		return err
+	}
 	return k.importContractState(ctx, contractAddr, state)
 }
 
-func (k Keeper) newQueryHandler(ctx sdk.Context, contractAddress sdk.AccAddress) QueryHandler {
-	return NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.gasRegister)
+func (k Keeper) newQueryHandler(ctx sdk.Context, contractAddress sdk.AccAddress, gasRegister types.GasRegister) QueryHandler {
+	return NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, gasRegister)
 }
 
 // MultipliedGasMeter wraps the GasMeter from context and multiplies all reads by out defined multiplier
@@ -1460,8 +1840,8 @@ func (m MultipliedGasMeter) GasConsumed() storetypes.Gas {
 	return m.GasRegister.ToWasmVMGas(m.originalMeter.GasConsumed())
 }
 
-func (k Keeper) gasMeter(ctx sdk.Context) MultipliedGasMeter {
-	return NewMultipliedGasMeter(ctx.GasMeter(), k.gasRegister)
+func (k Keeper) gasMeter(ctx sdk.Context, gasRegister types.GasRegister) MultipliedGasMeter {
+	return NewMultipliedGasMeter(ctx.GasMeter(), gasRegister)
 }
 
 // Logger returns a module-specific logger.
@@ -46,6 +46,7 @@ func NewKeeper(
 		wasmVM:               nil,
 		accountKeeper:        accountKeeper,
 		bank:                 NewBankCoinTransferrer(bankKeeper),
+		bankView:             bankKeeper,
 		accountPruner:        NewVestingCoinBurner(bankKeeper),
 		queryGasLimit:        nodeConfig.SmartQueryGasLimit,
 		gasRegister:          types.NewDefaultWasmGasRegister(),
@@ -59,6 +60,11 @@ func NewKeeper(
 		authority:  authority,
 		txHash:     func(data []byte) []byte { sum := sha256.Sum256(data); return sum[:] },
 		wasmLimits: vmConfig.WasmLimits,
+
+		simulateExecuteDisabled: nodeConfig.SimulateExecuteDisabled,
+	}
+	if m, ok := bankKeeper.(coinMinter); ok {
+		keeper.simulationMinter = m
 	}
 	keeper.messenger = NewDefaultMessageHandler(keeper, router, ics4Wrapper, channelKeeperV2, bankKeeper, cdc, portSource)
 	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distrKeeper, channelKeeper, keeper)
@@ -4,11 +4,13 @@ import (
 	"bytes"
 	_ "embed"
 	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	stdrand "math/rand"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
@@ -426,7 +428,7 @@ func TestInstantiate(t *testing.T) {
 
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x1c479), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x1ea4e), gasAfter-gasBefore)
 	}
 
 	// ensure it is stored properly
@@ -1819,6 +1821,9 @@ func TestUpdateContractAdmin(t *testing.T) {
 			}
 			cInfo := keepers.WasmKeeper.GetContractInfo(ctx, addr)
 			assert.Equal(t, spec.newAdmin.String(), cInfo.Admin)
+			// and admin index updated
+			assert.Equal(t, []sdk.AccAddress{addr}, contractsByAdmin(ctx, keepers.WasmKeeper, spec.newAdmin))
+			assert.Empty(t, contractsByAdmin(ctx, keepers.WasmKeeper, spec.instAdmin))
 		})
 	}
 }
@@ -1884,6 +1889,8 @@ func TestClearContractAdmin(t *testing.T) {
 			}
 			cInfo := keepers.WasmKeeper.GetContractInfo(ctx, addr)
 			assert.Empty(t, cInfo.Admin)
+			// and removed from admin index
+			assert.Empty(t, contractsByAdmin(ctx, keepers.WasmKeeper, spec.instAdmin))
 		})
 	}
 }
@@ -2501,6 +2508,76 @@ func TestSetAccessConfig(t *testing.T) {
 	}
 }
 
+func TestSetCodeMetadata(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creatorAddr := RandomAccountAddress(t)
+	const codeID = 1
+	myMetadata := types.CodeMetadata{
+		SourceRepository: "https://github.com/CosmWasm/cw-plus",
+		Commit:           "a1b2c3d",
+		BuilderImage:     "cosmwasm/optimizer:0.16.0",
+		OptimizerVersion: "0.16.0",
+		SchemaHash:       "0123456789abcdef",
+	}
+	k.mustStoreCodeInfo(parentCtx, codeID, types.NewCodeInfo(nil, creatorAddr, types.AllowNobody))
+
+	specs := map[string]struct {
+		codeID      uint64
+		caller      sdk.AccAddress
+		metadata    types.CodeMetadata
+		expMetadata *types.CodeMetadata
+		expErr      *errorsmod.Error
+	}{
+		"creator": {
+			codeID:      codeID,
+			caller:      creatorAddr,
+			metadata:    myMetadata,
+			expMetadata: &myMetadata,
+		},
+		"creator with empty metadata": {
+			codeID: codeID,
+			caller: creatorAddr,
+		},
+		"other address": {
+			codeID:   codeID,
+			caller:   RandomAccountAddress(t),
+			metadata: myMetadata,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown code": {
+			codeID:   codeID + 1,
+			caller:   creatorAddr,
+			metadata: myMetadata,
+			expErr:   types.ErrNoSuchCodeFn(0).Unwrap().(*errorsmod.Error),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.setCodeMetadata(ctx.WithEventManager(em), spec.codeID, spec.caller, spec.metadata)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMetadata, k.GetCodeInfo(ctx, spec.codeID).Metadata)
+			expEvt := sdk.NewEvent("set_code_metadata", sdk.NewAttribute("code_id", "1"))
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+			// and returned by queries
+			rsp, err := Querier(k).CodeInfo(ctx, &types.QueryCodeInfoRequest{CodeId: spec.codeID})
+			require.NoError(t, err)
+			assert.Equal(t, spec.expMetadata, rsp.Metadata)
+		})
+	}
+}
+
 func TestAppendToContractHistory(t *testing.T) {
 	f := fuzz.New().Funcs(ModelFuzzers...)
 	pCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
@@ -2700,6 +2777,59 @@ func TestIteratorContractByCreator(t *testing.T) {
 	}
 }
 
+func TestIteratorContractByAdmin(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	creator := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 1_000_000))
+	alice, bob, carol := RandomAccountAddress(t), RandomAccountAddress(t), RandomAccountAddress(t)
+
+	codeID, _, err := keepers.ContractKeeper.Create(parentCtx, creator, hackatomWasm, nil)
+	require.NoError(t, err)
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    creator,
+		Beneficiary: creator,
+	}.GetBytes(t)
+
+	gotAddr1, _, err := keepers.ContractKeeper.Instantiate(parentCtx, codeID, creator, alice, initMsgBz, "label", nil)
+	require.NoError(t, err)
+	gotAddr2, _, err := keepers.ContractKeeper.Instantiate(parentCtx, codeID, creator, bob, initMsgBz, "label", nil)
+	require.NoError(t, err)
+	gotAddr3, _, err := keepers.ContractKeeper.Instantiate(parentCtx, codeID, creator, bob, initMsgBz, "label", nil)
+	require.NoError(t, err)
+	_, _, err = keepers.ContractKeeper.Instantiate(parentCtx, codeID, creator, nil, initMsgBz, "label", nil)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		adminAddr     sdk.AccAddress
+		contractsAddr []sdk.AccAddress
+	}{
+		"single contract": {
+			adminAddr:     alice,
+			contractsAddr: []sdk.AccAddress{gotAddr1},
+		},
+		"multiple contracts": {
+			adminAddr:     bob,
+			contractsAddr: []sdk.AccAddress{gotAddr2, gotAddr3},
+		},
+		"no contracts - unknown": {
+			adminAddr: carol,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.ElementsMatch(t, spec.contractsAddr, contractsByAdmin(parentCtx, keepers.WasmKeeper, spec.adminAddr))
+		})
+	}
+}
+
+func contractsByAdmin(ctx sdk.Context, k *Keeper, admin sdk.AccAddress) []sdk.AccAddress {
+	var result []sdk.AccAddress
+	k.IterateContractsByAdmin(ctx, admin, func(addr sdk.AccAddress) bool {
+		result = append(result, addr)
+		return false
+	})
+	return result
+}
+
 func TestSetContractAdmin(t *testing.T) {
 	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	k := keepers.WasmKeeper
@@ -3155,3 +3285,205 @@ func TestQueryRawRange(t *testing.T) {
 		})
 	}
 }
+
+func TestFreezeContract(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+
+	specs := map[string]struct {
+		addr   sdk.AccAddress
+		expErr *errorsmod.Error
+	}{
+		"existing contract": {
+			addr: example.Contract,
+		},
+		"unknown contract": {
+			addr:   RandomAccountAddress(t),
+			expErr: types.ErrNoSuchContractFn("").Unwrap().(*errorsmod.Error),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.freezeContract(ctx.WithEventManager(em), spec.addr)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.False(t, k.IsContractFrozen(ctx, spec.addr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, k.IsContractFrozen(ctx, spec.addr))
+			exp := sdk.Events{sdk.NewEvent("freeze_contract", sdk.NewAttribute("_contract_address", spec.addr.String()))}
+			assert.Equal(t, exp, em.Events())
+
+			// and when unfrozen
+			em = sdk.NewEventManager()
+			require.NoError(t, k.unfreezeContract(ctx.WithEventManager(em), spec.addr))
+			assert.False(t, k.IsContractFrozen(ctx, spec.addr))
+			exp = sdk.Events{sdk.NewEvent("unfreeze_contract", sdk.NewAttribute("_contract_address", spec.addr.String()))}
+			assert.Equal(t, exp, em.Events())
+		})
+	}
+}
+
+func TestFrozenContractRejectsCalls(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	require.NoError(t, k.freezeContract(parentCtx, example.Contract))
+
+	specs := map[string]func(ctx sdk.Context) error{
+		"execute": func(ctx sdk.Context) error {
+			_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+			return err
+		},
+		"migrate": func(ctx sdk.Context) error {
+			_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, example.CodeID, []byte(`{}`))
+			return err
+		},
+		"reply": func(ctx sdk.Context) error {
+			_, err := k.reply(ctx, example.Contract, wasmvmtypes.Reply{ID: 1, Result: wasmvmtypes.SubMsgResult{Err: "testing"}})
+			return err
+		},
+		"ibc packet receive": func(ctx sdk.Context) error {
+			_, err := k.OnRecvPacket(ctx, example.Contract, wasmvmtypes.IBCPacketReceiveMsg{})
+			return err
+		},
+		"ibc packet ack": func(ctx sdk.Context) error {
+			return k.OnAckPacket(ctx, example.Contract, wasmvmtypes.IBCPacketAckMsg{})
+		},
+		"ibc packet timeout": func(ctx sdk.Context) error {
+			return k.OnTimeoutPacket(ctx, example.Contract, wasmvmtypes.IBCPacketTimeoutMsg{})
+		},
+	}
+	for name, call := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			gotErr := call(ctx)
+			require.ErrorIs(t, gotErr, types.ErrContractFrozen)
+		})
+	}
+
+	// queries are still served
+	_, err := k.QuerySmart(parentCtx, example.Contract, []byte(`{"verifier":{}}`))
+	require.NoError(t, err)
+
+	// and calls succeed again when unfrozen
+	require.NoError(t, k.unfreezeContract(parentCtx, example.Contract))
+	_, err = keepers.ContractKeeper.Execute(parentCtx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+}
+
+func TestDeprecateCode(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	checksum := example.Checksum
+
+	specs := map[string]struct {
+		deprecate   func(ctx sdk.Context) error
+		undeprecate func(ctx sdk.Context) error
+		expEvent    sdk.Event
+		expErr      *errorsmod.Error
+	}{
+		"by code id": {
+			deprecate:   func(ctx sdk.Context) error { return k.deprecateCode(ctx, example.CodeID) },
+			undeprecate: func(ctx sdk.Context) error { return k.undeprecateCode(ctx, example.CodeID) },
+			expEvent:    sdk.NewEvent("deprecate_code", sdk.NewAttribute("code_id", strconv.FormatUint(example.CodeID, 10))),
+		},
+		"by checksum": {
+			deprecate:   func(ctx sdk.Context) error { return k.deprecateChecksum(ctx, checksum) },
+			undeprecate: func(ctx sdk.Context) error { return k.undeprecateChecksum(ctx, checksum) },
+			expEvent:    sdk.NewEvent("deprecate_code", sdk.NewAttribute("code_checksum", hex.EncodeToString(checksum))),
+		},
+		"unknown code id": {
+			deprecate: func(ctx sdk.Context) error { return k.deprecateCode(ctx, example.CodeID+1) },
+			expErr:    types.ErrNoSuchCodeFn(0).Unwrap().(*errorsmod.Error),
+		},
+		"invalid checksum": {
+			deprecate: func(ctx sdk.Context) error { return k.deprecateChecksum(ctx, checksum[1:]) },
+			expErr:    types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := spec.deprecate(ctx.WithEventManager(em))
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.False(t, k.IsCodeDeprecated(ctx, example.CodeID))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, k.IsCodeDeprecated(ctx, example.CodeID))
+			assert.Equal(t, sdk.Events{spec.expEvent}, em.Events())
+
+			// and when undeprecated
+			require.NoError(t, spec.undeprecate(ctx))
+			assert.False(t, k.IsCodeDeprecated(ctx, example.CodeID))
+		})
+	}
+}
+
+func TestDeprecatedCodeRejectsInstantiateAndMigrate(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	// same checksum with a different code id
+	other := StoreHackatomExampleContract(t, parentCtx, keepers)
+
+	initMsgBz := HackatomExampleInitMsg{Verifier: example.VerifierAddr, Beneficiary: example.BeneficiaryAddr}.GetBytes(t)
+	migMsgBz, err := json.Marshal(struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}{Verifier: RandomAccountAddress(t)})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		deprecate       func(ctx sdk.Context) error
+		expOtherAllowed bool
+	}{
+		"by code id": {
+			deprecate:       func(ctx sdk.Context) error { return k.deprecateCode(ctx, example.CodeID) },
+			expOtherAllowed: true,
+		},
+		"by checksum": {
+			deprecate: func(ctx sdk.Context) error { return k.deprecateChecksum(ctx, example.Checksum) },
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			require.NoError(t, spec.deprecate(ctx))
+
+			// instantiate is rejected
+			_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsgBz, "deprecated", nil)
+			require.ErrorIs(t, err, types.ErrCodeDeprecated)
+			// migrate is rejected
+			_, err = keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, example.CodeID, migMsgBz)
+			require.ErrorIs(t, err, types.ErrCodeDeprecated)
+
+			// other code id with same checksum
+			_, _, err = keepers.ContractKeeper.Instantiate(ctx, other.CodeID, example.CreatorAddr, nil, initMsgBz, "other", nil)
+			if spec.expOtherAllowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrCodeDeprecated)
+			}
+
+			// existing contract keeps running
+			_, err = keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -105,6 +105,9 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		subCtx, commit := ctx.CacheContext()
 		em := sdk.NewEventManager()
 		subCtx = subCtx.WithEventManager(em)
+		subCtx, commitTxContracts := types.WithTxContractsScope(subCtx)
+		span := startTraceSpan(subCtx, traceTypeSubmessage, contractAddr, msg.Msg)
+		span.setID(msg.ID)
 
 		// check how much gas left locally, optionally wrap the gas meter
 		gasRemaining := ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumed()
@@ -119,11 +122,13 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		} else {
 			events, data, msgResponses, err = d.messenger.DispatchMsg(subCtx, contractAddr, ibcPort, msg.Msg)
 		}
+		span.end(err, events...)
 
 		// if it succeeds, commit state changes from submessage, and pass on events to Event Manager
 		var filteredEvents []sdk.Event
 		if err == nil {
 			commit()
+			commitTxContracts()
 			filteredEvents = filterEvents(append(em.Events(), events...))
 			ctx.EventManager().EmitEvents(filteredEvents)
 			if msg.Msg.Wasm == nil {
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -420,7 +421,7 @@ func TestDispatchSubmessages(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			var mockStore wasmtesting.MockCommitMultiStore
 			em := sdk.NewEventManager()
-			ctx := sdk.Context{}.WithMultiStore(&mockStore).
+			ctx := sdk.Context{}.WithContext(context.Background()).WithMultiStore(&mockStore).
 				WithGasMeter(storetypes.NewGasMeter(100)).
 				WithEventManager(em).WithLogger(log.NewTestLogger(t))
 			d := NewMessageDispatcher(spec.msgHandler, spec.replyer)
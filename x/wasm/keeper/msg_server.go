@@ -39,6 +39,11 @@ func (m msgServer) StoreCode(ctx context.Context, msg *types.MsgStoreCode) (*typ
 	if err != nil {
 		return nil, err
 	}
+	if msg.Metadata != nil && !msg.Metadata.IsEmpty() {
+		if err := m.keeper.setCodeMetadata(ctx, codeID, senderAddr, *msg.Metadata); err != nil {
+			return nil, err
+		}
+	}
 
 	return &types.MsgStoreCodeResponse{
 		CodeID:   codeID,
@@ -63,6 +68,10 @@ func (m msgServer) InstantiateContract(ctx context.Context, msg *types.MsgInstan
 		}
 	}
 
+	if err := m.keeper.validateContractMsg(ctx, msg.CodeID, types.SchemaEntryPointInstantiate, msg.Msg); err != nil {
+		return nil, err
+	}
+
 	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
 
 	contractAddr, data, err := m.keeper.instantiate(ctx, msg.CodeID, senderAddr, adminAddr, msg.Msg, msg.Label, msg.Funds, m.keeper.ClassicAddressGenerator(), policy)
@@ -93,6 +102,10 @@ func (m msgServer) InstantiateContract2(ctx context.Context, msg *types.MsgInsta
 		}
 	}
 
+	if err := m.keeper.validateContractMsg(ctx, msg.CodeID, types.SchemaEntryPointInstantiate, msg.Msg); err != nil {
+		return nil, err
+	}
+
 	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
 
 	addrGenerator := PredictableAddressGenerator(senderAddr, msg.Salt, msg.Msg, msg.FixMsg)
@@ -121,6 +134,9 @@ func (m msgServer) ExecuteContract(ctx context.Context, msg *types.MsgExecuteCon
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "contract")
 	}
+	if err := m.keeper.validateExecuteMsg(ctx, contractAddr, msg.Msg); err != nil {
+		return nil, err
+	}
 
 	data, err := m.keeper.execute(ctx, contractAddr, senderAddr, msg.Msg, msg.Funds)
 	if err != nil {
@@ -477,3 +493,516 @@ func (m msgServer) UpdateContractLabel(ctx context.Context, msg *types.MsgUpdate
 
 	return &types.MsgUpdateContractLabelResponse{}, nil
 }
+
+// FreezeContract pauses a contract so that it can not be executed, migrated or called via IBC.
+func (m msgServer) FreezeContract(ctx context.Context, req *types.MsgFreezeContract) (*types.MsgFreezeContractResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.freezeContract(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeContractResponse{}, nil
+}
+
+// UnfreezeContract resumes a frozen contract.
+func (m msgServer) UnfreezeContract(ctx context.Context, req *types.MsgUnfreezeContract) (*types.MsgUnfreezeContractResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.unfreezeContract(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezeContractResponse{}, nil
+}
+
+// DeprecateCodes deprecates a set of code ids or checksums so that they can not be used for new instances anymore.
+func (m msgServer) DeprecateCodes(ctx context.Context, req *types.MsgDeprecateCodes) (*types.MsgDeprecateCodesResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	for _, codeID := range req.CodeIDs {
+		if err := m.keeper.deprecateCode(ctx, codeID); err != nil {
+			return nil, err
+		}
+	}
+	for _, checksum := range req.Checksums {
+		if err := m.keeper.deprecateChecksum(ctx, checksum); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgDeprecateCodesResponse{}, nil
+}
+
+// UndeprecateCodes removes the deprecation from a set of code ids or checksums.
+func (m msgServer) UndeprecateCodes(ctx context.Context, req *types.MsgUndeprecateCodes) (*types.MsgUndeprecateCodesResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	for _, codeID := range req.CodeIDs {
+		if err := m.keeper.undeprecateCode(ctx, codeID); err != nil {
+			return nil, err
+		}
+	}
+	for _, checksum := range req.Checksums {
+		if err := m.keeper.undeprecateChecksum(ctx, checksum); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUndeprecateCodesResponse{}, nil
+}
+
+// RegisterCronSchedule registers a contract sudo call that is executed periodically at the end of a block.
+func (m msgServer) RegisterCronSchedule(ctx context.Context, req *types.MsgRegisterCronSchedule) (*types.MsgRegisterCronScheduleResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	schedule := types.CronSchedule{
+		Name:      req.Name,
+		Contract:  req.Contract,
+		Msg:       req.Msg,
+		Interval:  req.Interval,
+		GasLimit:  req.GasLimit,
+		MaxErrors: req.MaxErrors,
+	}
+	if err := m.keeper.registerCronSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCronScheduleResponse{}, nil
+}
+
+// RemoveCronSchedule removes a cron schedule.
+func (m msgServer) RemoveCronSchedule(ctx context.Context, req *types.MsgRemoveCronSchedule) (*types.MsgRemoveCronScheduleResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	if err := m.keeper.removeCronSchedule(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveCronScheduleResponse{}, nil
+}
+
+// SubscribeEpochHook subscribes a contract to the end of an x/epochs epoch
+func (m msgServer) SubscribeEpochHook(ctx context.Context, msg *types.MsgSubscribeEpochHook) (*types.MsgSubscribeEpochHookResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	subscription := types.EpochHookSubscription{
+		Contract:        msg.Contract,
+		EpochIdentifier: msg.EpochIdentifier,
+		GasLimit:        msg.GasLimit,
+	}
+	if err := m.keeper.subscribeEpochHook(ctx, senderAddr, subscription, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubscribeEpochHookResponse{}, nil
+}
+
+// UnsubscribeEpochHook removes a contract subscription to an x/epochs epoch
+func (m msgServer) UnsubscribeEpochHook(ctx context.Context, msg *types.MsgUnsubscribeEpochHook) (*types.MsgUnsubscribeEpochHookResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.unsubscribeEpochHook(ctx, senderAddr, contractAddr, msg.EpochIdentifier, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnsubscribeEpochHookResponse{}, nil
+}
+
+// RegisterFeeShare registers the withdraw address that receives a share of the fees of txs calling the contract
+func (m msgServer) RegisterFeeShare(ctx context.Context, msg *types.MsgRegisterFeeShare) (*types.MsgRegisterFeeShareResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	feeShare := types.FeeShare{
+		Contract:        msg.Contract,
+		WithdrawAddress: msg.WithdrawAddress,
+	}
+	if err := m.keeper.registerFeeShare(ctx, senderAddr, feeShare, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterFeeShareResponse{}, nil
+}
+
+// CancelFeeShare removes the fee share registration of a contract
+func (m msgServer) CancelFeeShare(ctx context.Context, msg *types.MsgCancelFeeShare) (*types.MsgCancelFeeShareResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.cancelFeeShare(ctx, senderAddr, contractAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelFeeShareResponse{}, nil
+}
+
+// SetFeeSponsorship opts a contract in to pay the fees of txs that only execute the contract
+func (m msgServer) SetFeeSponsorship(ctx context.Context, msg *types.MsgSetFeeSponsorship) (*types.MsgSetFeeSponsorshipResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	sponsorship := types.FeeSponsorship{
+		Contract:         msg.Contract,
+		PerBlockLimit:    msg.PerBlockLimit,
+		PerSenderLimit:   msg.PerSenderLimit,
+		ApprovalGasLimit: msg.ApprovalGasLimit,
+	}
+	if err := m.keeper.setFeeSponsorship(ctx, senderAddr, sponsorship, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFeeSponsorshipResponse{}, nil
+}
+
+// RemoveFeeSponsorship opts a contract out of paying the fees of txs
+func (m msgServer) RemoveFeeSponsorship(ctx context.Context, msg *types.MsgRemoveFeeSponsorship) (*types.MsgRemoveFeeSponsorshipResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.removeFeeSponsorship(ctx, senderAddr, contractAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFeeSponsorshipResponse{}, nil
+}
+
+// ProposeContractAdmin proposes a new contract admin that takes over when it accepts
+func (m msgServer) ProposeContractAdmin(ctx context.Context, msg *types.MsgProposeContractAdmin) (*types.MsgProposeContractAdminResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+	newAdminAddr, err := sdk.AccAddressFromBech32(msg.NewAdmin)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "new admin")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.proposeContractAdmin(ctx, contractAddr, senderAddr, newAdminAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeContractAdminResponse{}, nil
+}
+
+// AcceptContractAdmin sets the sender as contract admin when it was proposed
+func (m msgServer) AcceptContractAdmin(ctx context.Context, msg *types.MsgAcceptContractAdmin) (*types.MsgAcceptContractAdminResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.acceptContractAdmin(ctx, contractAddr, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptContractAdminResponse{}, nil
+}
+
+// CancelContractAdminTransfer removes the pending admin transfer of a contract
+func (m msgServer) CancelContractAdminTransfer(ctx context.Context, msg *types.MsgCancelContractAdminTransfer) (*types.MsgCancelContractAdminTransferResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.cancelContractAdminTransfer(ctx, contractAddr, senderAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelContractAdminTransferResponse{}, nil
+}
+
+// SetCodeMetadata sets the build metadata of a code
+func (m msgServer) SetCodeMetadata(ctx context.Context, msg *types.MsgSetCodeMetadata) (*types.MsgSetCodeMetadataResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+
+	if err := m.keeper.setCodeMetadata(ctx, msg.CodeID, senderAddr, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetCodeMetadataResponse{}, nil
+}
+
+// RegisterContractName claims a unique name for a contract
+func (m msgServer) RegisterContractName(ctx context.Context, msg *types.MsgRegisterContractName) (*types.MsgRegisterContractNameResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.registerContractName(ctx, contractAddr, senderAddr, msg.Name, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterContractNameResponse{}, nil
+}
+
+// TransferContractName moves a claimed name to another contract
+func (m msgServer) TransferContractName(ctx context.Context, msg *types.MsgTransferContractName) (*types.MsgTransferContractNameResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	newContractAddr, err := sdk.AccAddressFromBech32(msg.NewContract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "new contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.transferContractName(ctx, msg.Name, senderAddr, newContractAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferContractNameResponse{}, nil
+}
+
+// ReleaseContractName removes a claimed contract name
+func (m msgServer) ReleaseContractName(ctx context.Context, msg *types.MsgReleaseContractName) (*types.MsgReleaseContractNameResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.releaseContractName(ctx, msg.Name, senderAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReleaseContractNameResponse{}, nil
+}
+
+// DeleteContract removes a contract with its storage
+func (m msgServer) DeleteContract(ctx context.Context, msg *types.MsgDeleteContract) (*types.MsgDeleteContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+	var sweepRecipient sdk.AccAddress
+	if msg.SweepRecipient != "" {
+		if sweepRecipient, err = sdk.AccAddressFromBech32(msg.SweepRecipient); err != nil {
+			return nil, errorsmod.Wrap(err, "sweep recipient")
+		}
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.deleteContract(ctx, contractAddr, senderAddr, sweepRecipient, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteContractResponse{}, nil
+}
+
+// SetCodeGasMultiplier sets or removes the gas multiplier of a code
+func (m msgServer) SetCodeGasMultiplier(ctx context.Context, req *types.MsgSetCodeGasMultiplier) (*types.MsgSetCodeGasMultiplierResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	if err := m.keeper.setCodeGasMultiplier(ctx, req.CodeID, req.MultiplierBps); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetCodeGasMultiplierResponse{}, nil
+}
+
+// SetCodeSchema sets or removes the JSON schema of the contract messages of a code
+func (m msgServer) SetCodeSchema(ctx context.Context, msg *types.MsgSetCodeSchema) (*types.MsgSetCodeSchemaResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+
+	if err := m.keeper.setCodeSchema(ctx, msg.CodeID, senderAddr, msg.Schema, msg.ValidateMessages); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetCodeSchemaResponse{}, nil
+}
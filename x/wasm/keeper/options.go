@@ -83,6 +83,18 @@ func WithQueryPlugins(x *QueryPlugins) Option {
 	})
 }
 
+// WithContractNameQuerier is an optional constructor parameter that lets contracts resolve claimed contract names
+// with the contract name custom query. It replaces the custom querier of the default query plugins.
+func WithContractNameQuerier() Option {
+	return optsFn(func(k *Keeper) {
+		q, ok := k.wasmVMQueryHandler.(QueryPlugins)
+		if !ok {
+			panic(fmt.Sprintf("Unsupported query handler type: %T", k.wasmVMQueryHandler))
+		}
+		k.wasmVMQueryHandler = q.Merge(&QueryPlugins{Custom: ContractNameQuerier(k)})
+	})
+}
+
 // WithMessageEncoders is an optional constructor parameter to pass custom message encoder to the default wasm message handler.
 // This option expects the `DefaultMessageHandler` set and should not be combined with Option `WithMessageHandler` or `WithMessageHandlerDecorator`.
 func WithMessageEncoders(x *MessageEncoders) Option {
@@ -125,6 +137,17 @@ func WithAccountPruner(x AccountPruner) Option {
 	})
 }
 
+// WithEpochsKeeper is an optional constructor parameter to verify that the epoch identifiers of new
+// epoch hook subscriptions exist in the x/epochs module
+func WithEpochsKeeper(x types.EpochsKeeper) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		k.epochsKeeper = x
+	})
+}
+
 func WithVMCacheMetrics(r prometheus.Registerer) Option {
 	return postOptsFn(func(k *Keeper) {
 		NewWasmVMMetricsCollector(k.wasmVM).Register(r)
@@ -133,7 +156,8 @@ func WithVMCacheMetrics(r prometheus.Registerer) Option {
 
 // WithGasRegister set a new gas register to implement custom gas costs.
 // When the "gas multiplier" for wasmvm gas conversion is modified inside the new register,
-// make sure to also use `WithApiCosts` option for non default values
+// make sure to also use `WithApiCosts` option for non default values.
+// The gas costs in the params take precedence when set on chain.
 func WithGasRegister(x types.GasRegister) Option {
 	if x == nil {
 		panic("must not be nil")
@@ -144,6 +168,7 @@ func WithGasRegister(x types.GasRegister) Option {
 }
 
 // WithAPICosts sets custom api costs. Amounts are in cosmwasm gas Not SDK gas.
+// The gas costs in the params take precedence when set on chain.
 func WithAPICosts(human, canonical uint64) Option {
 	return optsFn(func(_ *Keeper) {
 		costHumanize = human
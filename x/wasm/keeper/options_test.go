@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -70,6 +72,20 @@ func TestConstructorOptions(t *testing.T) {
 				assert.IsType(t, &wasmtesting.MockQueryHandler{}, k.wasmVMQueryHandler)
 			},
 		},
+		"epochs keeper": {
+			srcOpt: WithEpochsKeeper(wasmtesting.MockEpochsKeeper{}),
+			verify: func(t *testing.T, k Keeper) {
+				assert.IsType(t, wasmtesting.MockEpochsKeeper{}, k.epochsKeeper)
+			},
+		},
+		"contract name querier": {
+			srcOpt: WithContractNameQuerier(),
+			verify: func(t *testing.T, k Keeper) {
+				require.IsType(t, QueryPlugins{}, k.wasmVMQueryHandler)
+				_, err := k.wasmVMQueryHandler.(QueryPlugins).Custom(sdk.Context{}, []byte(`{"contract_by_name":{"name":"Invalid Name"}}`))
+				assert.IsType(t, wasmvmtypes.InvalidRequest{}, err)
+			},
+		},
 		"message handler decorator": {
 			srcOpt: WithMessageHandlerDecorator(func(old Messenger) Messenger {
 				require.IsType(t, callDepthMessageHandler{}, old)
@@ -22,6 +22,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
@@ -189,22 +190,95 @@ func (q GrpcQuerier) RawContractState(c context.Context, req *types.QueryRawCont
 	return &types.QueryRawContractStateResponse{Data: rsp}, nil
 }
 
-func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmartContractStateRequest) (rsp *types.QuerySmartContractStateResponse, err error) {
+func (q GrpcQuerier) RawContractStateRange(c context.Context, req *types.QueryRawContractStateRangeRequest) (*types.QueryRawContractStateRangeResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
-	if err := req.QueryData.ValidateBasic(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid query data")
-	}
+	ctx := sdk.UnwrapSDKContext(c)
+
 	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
 		return nil, err
 	}
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
+			Wrapf("address %s", contractAddr.String())
+	}
+	limit := req.Limit
+	if limit == 0 || limit > maxResultEntries {
+		limit = maxResultEntries
+	}
+	entries, nextKey := q.keeper.QueryRawRange(ctx, contractAddr, req.Start, req.End, uint16(limit), req.Reverse)
+	if req.Reverse && nextKey != nil {
+		// the end bound is exclusive, so the next page has to end right after the next key
+		nextKey = append(append(make([]byte, 0, len(nextKey)+1), nextKey...), 0)
+	}
+	models := make([]types.Model, len(entries))
+	for i, e := range entries {
+		models[i] = types.Model{Key: e.Key, Value: e.Value}
+	}
+	return &types.QueryRawContractStateRangeResponse{Models: models, NextKey: nextKey}, nil
+}
 
+func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmartContractStateRequest) (*types.QuerySmartContractStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
 	// limit the gas to the queryGasLimit or the remaining gas, whichever is smaller
 	ctx := sdk.UnwrapSDKContext(c)
 	gasLimit := min(ctx.GasMeter().GasRemaining(), q.queryGasLimit)
 	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	bz, err := q.querySmart(ctx, req.Address, req.QueryData)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySmartContractStateResponse{Data: bz}, nil
+}
+
+// max number of queries in a batch smart query
+const maxBatchSmartQueries = 100
+
+func (q GrpcQuerier) BatchSmartContractState(c context.Context, req *types.QueryBatchSmartContractStateRequest) (*types.QueryBatchSmartContractStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	switch n := len(req.Queries); {
+	case n == 0:
+		return nil, status.Error(codes.InvalidArgument, "empty queries")
+	case n > maxBatchSmartQueries:
+		return nil, status.Errorf(codes.InvalidArgument, "too many queries: max %d", maxBatchSmartQueries)
+	}
+	// all queries share the gas limited to the queryGasLimit or the remaining gas, whichever is smaller
+	ctx := sdk.UnwrapSDKContext(c)
+	gasLimit := min(ctx.GasMeter().GasRemaining(), q.queryGasLimit)
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	results := make([]types.BatchSmartQueryResult, len(req.Queries))
+	for i, item := range req.Queries {
+		bz, err := q.querySmart(ctx, item.Address, item.QueryData)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].Data = bz
+	}
+	return &types.QueryBatchSmartContractStateResponse{
+		Results: results,
+		GasUsed: ctx.GasMeter().GasConsumedToLimit(),
+	}, nil
+}
+
+// querySmart runs the smart query with the gas meter of the given context and recovers from an out-of-gas panic
+func (q GrpcQuerier) querySmart(ctx sdk.Context, address string, queryData types.RawContractMessage) (bz []byte, err error) {
+	if err := queryData.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid query data")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, err
+	}
+
 	// recover from out-of-gas panic
 	defer func() {
 		if r := recover(); r != nil {
@@ -217,16 +291,16 @@ func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 			default:
 				err = sdkerrors.ErrPanic
 			}
-			rsp = nil
+			bz = nil
 			moduleLogger(ctx).
 				Debug("smart query contract",
 					"error", "recovering panic",
-					"contract-address", req.Address,
+					"contract-address", address,
 					"stacktrace", string(debug.Stack()))
 		}
 	}()
 
-	bz, err := q.keeper.QuerySmart(ctx, contractAddr, req.QueryData)
+	bz, err = q.keeper.QuerySmart(ctx, contractAddr, queryData)
 	switch {
 	case err != nil:
 		return nil, err
@@ -234,7 +308,7 @@ func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
 			Wrapf("address %s", contractAddr.String())
 	}
-	return &types.QuerySmartContractStateResponse{Data: bz}, nil
+	return bz, nil
 }
 
 func (q GrpcQuerier) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
@@ -275,11 +349,14 @@ func (q GrpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 			if err := q.cdc.Unmarshal(value, &c); err != nil {
 				return false, err
 			}
+			codeID := binary.BigEndian.Uint64(key)
 			r = append(r, types.CodeInfoResponse{
-				CodeID:                binary.BigEndian.Uint64(key),
+				CodeID:                codeID,
 				Creator:               c.Creator,
 				DataHash:              c.CodeHash,
 				InstantiatePermission: c.InstantiateConfig,
+				Deprecated:            q.keeper.IsCodeDeprecated(ctx, codeID),
+				Metadata:              c.Metadata,
 			})
 		}
 		return true, nil
@@ -306,6 +383,8 @@ func (q GrpcQuerier) CodeInfo(c context.Context, req *types.QueryCodeInfoRequest
 		Creator:               info.Creator,
 		Checksum:              info.DataHash,
 		InstantiatePermission: info.InstantiatePermission,
+		Deprecated:            info.Deprecated,
+		Metadata:              info.Metadata,
 	}, nil
 }
 
@@ -316,8 +395,13 @@ func queryContractInfo(ctx sdk.Context, addr sdk.AccAddress, keeper types.ViewKe
 			Wrapf("address %s", addr.String())
 	}
 	return &types.QueryContractInfoResponse{
-		Address:      addr.String(),
-		ContractInfo: *info,
+		Address:              addr.String(),
+		ContractInfo:         *info,
+		Frozen:               keeper.IsContractFrozen(ctx, addr),
+		PendingAdminTransfer: keeper.GetPendingAdminTransfer(ctx, addr),
+		Name:                 keeper.GetContractName(ctx, addr),
+		StorageUsage:         keeper.GetContractStorageUsage(ctx, addr),
+		StorageDeposit:       keeper.GetStorageDeposit(ctx, addr),
 	}, nil
 }
 
@@ -349,6 +433,8 @@ func queryCodeInfo(ctx sdk.Context, codeID uint64, keeper types.ViewKeeper) *typ
 		Creator:               res.Creator,
 		DataHash:              res.CodeHash,
 		InstantiatePermission: res.InstantiateConfig,
+		Deprecated:            keeper.IsCodeDeprecated(ctx, codeID),
+		Metadata:              res.Metadata,
 	}
 	return &info
 }
@@ -422,6 +508,182 @@ func (q GrpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContr
 	}, nil
 }
 
+func (q GrpcQuerier) ContractsByAdmin(c context.Context, req *types.QueryContractsByAdminRequest) (*types.QueryContractsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]string, 0)
+
+	adminAddress, err := sdk.AccAddressFromBech32(req.AdminAddress)
+	if err != nil {
+		return nil, err
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetContractsByAdminPrefix(adminAddress))
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			contracts = append(contracts, sdk.AccAddress(key).String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByAdminResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) LargestContracts(c context.Context, req *types.QueryLargestContractsRequest) (*types.QueryLargestContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	// the index is sorted by size ascending, largest first is the default
+	pageReq := *paginationParams
+	pageReq.Reverse = !pageReq.Reverse
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]types.ContractStorageUsageEntry, 0)
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.ContractsByStorageSizePrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, &pageReq, func(key, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			contractAddr := sdk.AccAddress(key[8:])
+			contracts = append(contracts, types.ContractStorageUsageEntry{
+				Address:      contractAddr.String(),
+				StorageUsage: q.keeper.GetContractStorageUsage(ctx, contractAddr),
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLargestContractsResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) CodesByChecksum(c context.Context, req *types.QueryCodesByChecksumRequest) (*types.QueryCodesByChecksumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	checksum, err := parseChecksum(req.Checksum)
+	if err != nil {
+		return nil, err
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.CodeInfoResponse, 0)
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetCodesByChecksumPrefix(checksum))
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			info := queryCodeInfo(ctx, binary.BigEndian.Uint64(key), q.keeper)
+			if info == nil {
+				return false, types.ErrNoSuchCodeFn(binary.BigEndian.Uint64(key))
+			}
+			r = append(r, *info)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCodesByChecksumResponse{CodeInfos: r, Pagination: pageRes}, nil
+}
+
+// ContractsByChecksum returns the contracts of all code ids with the given checksum. The pagination key is
+// the code id followed by the key in the contracts by code index. Only key based pagination is supported.
+func (q GrpcQuerier) ContractsByChecksum(c context.Context, req *types.QueryContractsByChecksumRequest) (*types.QueryContractsByChecksumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	checksum, err := parseChecksum(req.Checksum)
+	if err != nil {
+		return nil, err
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	if paginationParams.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "reverse pagination not supported")
+	}
+	var startCodeID uint64
+	var startKey []byte
+	if len(paginationParams.Key) != 0 {
+		if len(paginationParams.Key) < 8 {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		startCodeID, startKey = binary.BigEndian.Uint64(paginationParams.Key[:8]), paginationParams.Key[8:]
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var codeIDs []uint64
+	q.keeper.IterateCodesByChecksum(ctx, checksum, func(codeID uint64) bool {
+		codeIDs = append(codeIDs, codeID)
+		return false
+	})
+
+	r := make([]string, 0)
+	var nextKey []byte
+	for _, codeID := range codeIDs {
+		if codeID < startCodeID {
+			continue
+		}
+		var iterStart []byte
+		if codeID == startCodeID {
+			iterStart = startKey
+		}
+		prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetContractByCodeIDSecondaryIndexPrefix(codeID))
+		iter := prefixStore.Iterator(iterStart, nil)
+		for ; iter.Valid(); iter.Next() {
+			if uint64(len(r)) == paginationParams.Limit {
+				nextKey = append(sdk.Uint64ToBigEndian(codeID), iter.Key()...)
+				break
+			}
+			var contractAddr sdk.AccAddress = iter.Key()[types.AbsoluteTxPositionLen:]
+			r = append(r, contractAddr.String())
+		}
+		iter.Close()
+		if nextKey != nil {
+			break
+		}
+	}
+	return &types.QueryContractsByChecksumResponse{
+		Contracts:  r,
+		Pagination: &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+// parseChecksum decodes and validates a hex encoded wasm code checksum
+func parseChecksum(s string) ([]byte, error) {
+	checksum, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid checksum: %s", err)
+	}
+	if err := types.ValidateChecksum(checksum); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return checksum, nil
+}
+
 // max limit to pagination queries
 const maxResultEntries = 100
 
@@ -494,3 +756,404 @@ func BuildAddressPredictable(req *types.QueryBuildAddressRequest) (*types.QueryB
 		Address: BuildContractAddressPredictable(codeHash, creator, salt, initMsg).String(),
 	}, nil
 }
+
+// CronSchedules returns the contract sudo calls registered by governance
+func (q GrpcQuerier) CronSchedules(c context.Context, req *types.QueryCronSchedulesRequest) (*types.QueryCronSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.CronSchedule, 0)
+
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.CronSchedulePrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(_, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var schedule types.CronSchedule
+			if err := q.cdc.Unmarshal(value, &schedule); err != nil {
+				return false, err
+			}
+			r = append(r, schedule)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCronSchedulesResponse{
+		Schedules:  r,
+		Pagination: pageRes,
+	}, nil
+}
+
+// EpochHookSubscriptions returns the contract subscriptions to x/epochs epoch ends
+func (q GrpcQuerier) EpochHookSubscriptions(c context.Context, req *types.QueryEpochHookSubscriptionsRequest) (*types.QueryEpochHookSubscriptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	keyPrefix := types.EpochHookSubscriptionPrefix
+	if req.EpochIdentifier != "" {
+		if err := types.ValidateEpochIdentifier(req.EpochIdentifier); err != nil {
+			return nil, errorsmod.Wrap(err, "epoch identifier")
+		}
+		keyPrefix = types.GetEpochHookSubscriptionsPrefix(req.EpochIdentifier)
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.EpochHookSubscription, 0)
+
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), keyPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(_, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var subscription types.EpochHookSubscription
+			if err := q.cdc.Unmarshal(value, &subscription); err != nil {
+				return false, err
+			}
+			r = append(r, subscription)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEpochHookSubscriptionsResponse{
+		Subscriptions: r,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// CronSchedule returns a single cron schedule by name
+func (q GrpcQuerier) CronSchedule(c context.Context, req *types.QueryCronScheduleRequest) (*types.QueryCronScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateCronScheduleName(req.Name); err != nil {
+		return nil, errorsmod.Wrap(err, "name")
+	}
+	schedule := q.keeper.GetCronSchedule(sdk.UnwrapSDKContext(c), req.Name)
+	if schedule == nil {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "cron schedule %s", req.Name)
+	}
+	return &types.QueryCronScheduleResponse{Schedule: *schedule}, nil
+}
+
+// FeeShare returns the fee share registration of a contract
+func (q GrpcQuerier) FeeShare(c context.Context, req *types.QueryFeeShareRequest) (*types.QueryFeeShareResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	feeShare := q.keeper.GetFeeShare(sdk.UnwrapSDKContext(c), contractAddr)
+	if feeShare == nil {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "fee share %s", req.Address)
+	}
+	return &types.QueryFeeShareResponse{FeeShare: *feeShare}, nil
+}
+
+// FeeShares returns all contract fee share registrations
+func (q GrpcQuerier) FeeShares(c context.Context, req *types.QueryFeeSharesRequest) (*types.QueryFeeSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.FeeShare, 0)
+
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.FeeSharePrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(_, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var feeShare types.FeeShare
+			if err := q.cdc.Unmarshal(value, &feeShare); err != nil {
+				return false, err
+			}
+			r = append(r, feeShare)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFeeSharesResponse{
+		FeeShares:  r,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FeeSponsorship returns the fee sponsorship of a contract
+func (q GrpcQuerier) FeeSponsorship(c context.Context, req *types.QueryFeeSponsorshipRequest) (*types.QueryFeeSponsorshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	sponsorship := q.keeper.GetFeeSponsorship(sdk.UnwrapSDKContext(c), contractAddr)
+	if sponsorship == nil {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "fee sponsorship %s", req.Address)
+	}
+	return &types.QueryFeeSponsorshipResponse{FeeSponsorship: *sponsorship}, nil
+}
+
+// FeeSponsorships returns all contract fee sponsorships
+func (q GrpcQuerier) FeeSponsorships(c context.Context, req *types.QueryFeeSponsorshipsRequest) (*types.QueryFeeSponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.FeeSponsorship, 0)
+
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.FeeSponsorshipPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(_, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var sponsorship types.FeeSponsorship
+			if err := q.cdc.Unmarshal(value, &sponsorship); err != nil {
+				return false, err
+			}
+			r = append(r, sponsorship)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFeeSponsorshipsResponse{
+		FeeSponsorships: r,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// ContractByName returns the address of the contract that claimed the name
+func (q GrpcQuerier) ContractByName(c context.Context, req *types.QueryContractByNameRequest) (*types.QueryContractByNameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateContractName(req.Name); err != nil {
+		return nil, errorsmod.Wrap(err, "name")
+	}
+	contractAddr := q.keeper.GetContractByName(sdk.UnwrapSDKContext(c), req.Name)
+	if contractAddr == nil {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "contract name %s", req.Name)
+	}
+	return &types.QueryContractByNameResponse{Address: contractAddr.String()}, nil
+}
+
+// ContractNames returns all claimed contract names
+func (q GrpcQuerier) ContractNames(c context.Context, req *types.QueryContractNamesRequest) (*types.QueryContractNamesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.ContractName, 0)
+
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.ContractNamePrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			r = append(r, types.ContractName{
+				Name:     string(key),
+				Contract: sdk.AccAddress(value).String(),
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractNamesResponse{
+		ContractNames: r,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// CodeGasMultiplier returns the gas multiplier of a code
+func (q GrpcQuerier) CodeGasMultiplier(c context.Context, req *types.QueryCodeGasMultiplierRequest) (*types.QueryCodeGasMultiplierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.CodeId == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "code id")
+	}
+	multiplier := q.keeper.GetCodeGasMultiplier(sdk.UnwrapSDKContext(c), req.CodeId)
+	if multiplier == nil {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "code gas multiplier %d", req.CodeId)
+	}
+	return &types.QueryCodeGasMultiplierResponse{CodeGasMultiplier: *multiplier}, nil
+}
+
+// CodeGasMultipliers returns the gas multipliers of all codes
+func (q GrpcQuerier) CodeGasMultipliers(c context.Context, req *types.QueryCodeGasMultipliersRequest) (*types.QueryCodeGasMultipliersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.CodeGasMultiplier, 0)
+
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.CodeGasMultiplierPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(_, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var multiplier types.CodeGasMultiplier
+			if err := q.cdc.Unmarshal(value, &multiplier); err != nil {
+				return false, err
+			}
+			r = append(r, multiplier)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCodeGasMultipliersResponse{
+		CodeGasMultipliers: r,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// CodeSchema returns the uncompressed JSON schema of the contract messages of a code
+func (q GrpcQuerier) CodeSchema(c context.Context, req *types.QueryCodeSchemaRequest) (*types.QueryCodeSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.CodeId == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "code id")
+	}
+	codeSchema := q.keeper.GetCodeSchema(sdk.UnwrapSDKContext(c), req.CodeId)
+	if codeSchema == nil {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "code schema %d", req.CodeId)
+	}
+	schema, err := ioutils.Uncompress(codeSchema.Schema, int64(types.MaxCodeSchemaSize))
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "code schema %d: %s", req.CodeId, err)
+	}
+	return &types.QueryCodeSchemaResponse{Schema: schema, ValidateMessages: codeSchema.ValidateMessages}, nil
+}
+
+// contractTracer is implemented by keepers that can trace a contract execution
+type contractTracer interface {
+	traceExecute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*types.TraceNode, []byte)
+}
+
+func (q GrpcQuerier) TraceExecute(c context.Context, req *types.QueryTraceExecuteRequest) (rsp *types.QueryTraceExecuteResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	msg := types.MsgExecuteContract{Sender: req.Sender, Contract: req.Contract, Msg: req.Msg, Funds: req.Funds}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tracer, ok := q.keeper.(contractTracer)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "trace execute not supported")
+	}
+	senderAddr := sdk.MustAccAddressFromBech32(req.Sender)
+	contractAddr := sdk.MustAccAddressFromBech32(req.Contract)
+
+	// limit the gas to the queryGasLimit or the remaining gas, whichever is smaller
+	ctx := sdk.UnwrapSDKContext(c)
+	gasLimit := min(ctx.GasMeter().GasRemaining(), q.queryGasLimit)
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+	// recover from out-of-gas panic
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case storetypes.ErrorOutOfGas:
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas,
+					"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+					rType.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+				)
+			default:
+				err = sdkerrors.ErrPanic
+			}
+			rsp = nil
+			moduleLogger(ctx).
+				Debug("trace execute contract",
+					"error", "recovering panic",
+					"contract-address", req.Contract,
+					"stacktrace", string(debug.Stack()))
+		}
+	}()
+
+	trace, data := tracer.traceExecute(ctx, contractAddr, senderAddr, req.Msg, req.Funds)
+	return &types.QueryTraceExecuteResponse{
+		Trace:   trace,
+		Data:    data,
+		GasUsed: ctx.GasMeter().GasConsumed(),
+	}, nil
+}
+
+// contractSimulator is implemented by keepers that can simulate a contract execution
+type contractSimulator interface {
+	simulateExecute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*types.QuerySimulateExecuteResponse, error)
+}
+
+func (q GrpcQuerier) SimulateExecute(c context.Context, req *types.QuerySimulateExecuteRequest) (rsp *types.QuerySimulateExecuteResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	msg := types.MsgExecuteContract{Sender: req.Sender, Contract: req.Contract, Msg: req.Msg, Funds: req.Funds}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	simulator, ok := q.keeper.(contractSimulator)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "simulate execute not supported")
+	}
+	senderAddr := sdk.MustAccAddressFromBech32(req.Sender)
+	contractAddr := sdk.MustAccAddressFromBech32(req.Contract)
+
+	// limit the gas to the queryGasLimit or the remaining gas, whichever is smaller
+	ctx := sdk.UnwrapSDKContext(c)
+	gasLimit := min(ctx.GasMeter().GasRemaining(), q.queryGasLimit)
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+	// recover from out-of-gas panic
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case storetypes.ErrorOutOfGas:
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas,
+					"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+					rType.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+				)
+			default:
+				err = sdkerrors.ErrPanic
+			}
+			rsp = nil
+			moduleLogger(ctx).
+				Debug("simulate execute contract",
+					"error", "recovering panic",
+					"contract-address", req.Contract,
+					"stacktrace", string(debug.Stack()))
+		}
+	}()
+
+	return simulator.simulateExecute(ctx, contractAddr, senderAddr, req.Msg, req.Funds)
+}
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"testing"
 	"time"
 
@@ -170,6 +173,87 @@ func TestQuerySmartContractState(t *testing.T) {
 	}
 }
 
+func TestQueryBatchSmartContractState(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract.String()
+	verifierQuery := types.BatchSmartQuery{Address: contractAddr, QueryData: []byte(`{"verifier":{}}`)}
+	expVerifierResp := fmt.Sprintf(`{"verifier":"%s"}`, exampleContract.VerifierAddr.String())
+
+	randomAddr := RandomBech32AccountAddress(t)
+
+	q := Querier(keeper)
+	// gas used by a single query
+	rsp, err := q.BatchSmartContractState(ctx, &types.QueryBatchSmartContractStateRequest{Queries: []types.BatchSmartQuery{verifierQuery}})
+	require.NoError(t, err)
+	singleQueryGas := rsp.GasUsed
+	require.NotZero(t, singleQueryGas)
+
+	specs := map[string]struct {
+		querier    *GrpcQuerier
+		srcQueries []types.BatchSmartQuery
+		expResps   []string
+		expErrs    []string
+		expErr     error
+	}{
+		"all succeed": {
+			querier:    q,
+			srcQueries: []types.BatchSmartQuery{verifierQuery, verifierQuery},
+			expResps:   []string{expVerifierResp, expVerifierResp},
+			expErrs:    []string{"", ""},
+		},
+		"errors per item": {
+			querier: q,
+			srcQueries: []types.BatchSmartQuery{
+				{Address: randomAddr, QueryData: []byte(`{"verifier":{}}`)},
+				verifierQuery,
+				{Address: contractAddr, QueryData: []byte(`not a json string`)},
+				{Address: contractAddr, QueryData: []byte(`{"raw":{"key":"config"}}`)},
+			},
+			expResps: []string{"", expVerifierResp, "", ""},
+			expErrs:  []string{"no such contract", "", "invalid query data", "query wasm contract failed"},
+		},
+		"shared gas budget exceeded": {
+			querier:    NewGrpcQuerier(keeper.cdc, keeper.storeService, keeper, singleQueryGas+singleQueryGas/2),
+			srcQueries: []types.BatchSmartQuery{verifierQuery, verifierQuery, verifierQuery},
+			expResps:   []string{expVerifierResp, "", ""},
+			expErrs:    []string{"", "out of gas", "out of gas"},
+		},
+		"empty queries": {
+			querier: q,
+			expErr:  status.Error(codes.InvalidArgument, "empty queries"),
+		},
+		"too many queries": {
+			querier:    q,
+			srcQueries: make([]types.BatchSmartQuery, maxBatchSmartQueries+1),
+			expErr:     status.Errorf(codes.InvalidArgument, "too many queries: max %d", maxBatchSmartQueries),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := spec.querier.BatchSmartContractState(ctx, &types.QueryBatchSmartContractStateRequest{Queries: spec.srcQueries})
+			if spec.expErr != nil {
+				assert.Equal(t, spec.expErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, got.Results, len(spec.srcQueries))
+			for i, r := range got.Results {
+				if spec.expErrs[i] != "" {
+					assert.Contains(t, r.Error, spec.expErrs[i])
+					assert.Empty(t, r.Data)
+					continue
+				}
+				assert.Empty(t, r.Error)
+				assert.JSONEq(t, spec.expResps[i], string(r.Data))
+			}
+			assert.LessOrEqual(t, got.GasUsed, spec.querier.queryGasLimit)
+		})
+	}
+}
+
 func TestQuerySmartContractPanics(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	contractAddr := BuildContractAddressClassic(1, 1)
@@ -275,6 +359,78 @@ func TestQueryRawContractState(t *testing.T) {
 	}
 }
 
+func TestQueryRawContractStateRange(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract.String()
+	contractModel := []types.Model{
+		{Key: []byte("ma"), Value: []byte(`1`)},
+		{Key: []byte("mb"), Value: []byte(`2`)},
+		{Key: []byte("mc"), Value: []byte(`3`)},
+		{Key: []byte("n"), Value: []byte(`4`)},
+	}
+	require.NoError(t, keeper.importContractState(ctx, exampleContract.Contract, contractModel))
+
+	randomAddr := RandomBech32AccountAddress(t)
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery   *types.QueryRawContractStateRangeRequest
+		expModels  []types.Model
+		expNextKey []byte
+		expErr     error
+	}{
+		"all in range": {
+			srcQuery:  &types.QueryRawContractStateRangeRequest{Address: contractAddr, Start: []byte("m"), End: []byte("n")},
+			expModels: contractModel[0:3],
+		},
+		"with limit": {
+			srcQuery:   &types.QueryRawContractStateRangeRequest{Address: contractAddr, Start: []byte("m"), End: []byte("n"), Limit: 2},
+			expModels:  contractModel[0:2],
+			expNextKey: []byte("mc"),
+		},
+		"open end": {
+			srcQuery:  &types.QueryRawContractStateRangeRequest{Address: contractAddr, Start: []byte("mc")},
+			expModels: contractModel[2:4],
+		},
+		"reverse with limit": {
+			srcQuery:   &types.QueryRawContractStateRangeRequest{Address: contractAddr, Start: []byte("m"), End: []byte("n"), Limit: 2, Reverse: true},
+			expModels:  []types.Model{contractModel[2], contractModel[1]},
+			expNextKey: []byte("ma\x00"),
+		},
+		"reverse with next key as end": {
+			srcQuery:  &types.QueryRawContractStateRangeRequest{Address: contractAddr, Start: []byte("m"), End: []byte("ma\x00"), Reverse: true},
+			expModels: contractModel[0:1],
+		},
+		"empty range": {
+			srcQuery:  &types.QueryRawContractStateRangeRequest{Address: contractAddr, Start: []byte("x")},
+			expModels: []types.Model{},
+		},
+		"unknown address": {
+			srcQuery: &types.QueryRawContractStateRangeRequest{Address: randomAddr},
+			expErr:   types.ErrNoSuchContractFn(randomAddr).Wrapf("address %s", randomAddr),
+		},
+		"nil req": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.RawContractStateRange(ctx, spec.srcQuery)
+			if spec.expErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, spec.expErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expModels, got.Models)
+			assert.Equal(t, spec.expNextKey, got.NextKey)
+		})
+	}
+}
+
 func TestQueryContractsByCode(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -649,6 +805,8 @@ func TestQueryContractInfo(t *testing.T) {
 	specs := map[string]struct {
 		src    *types.QueryContractInfoRequest
 		stored types.ContractInfo
+		frozen bool
+		state  []types.Model
 		expRsp *types.QueryContractInfoResponse
 		expErr bool
 	}{
@@ -668,6 +826,26 @@ func TestQueryContractInfo(t *testing.T) {
 				ContractInfo: types.ContractInfoFixture(myExtension),
 			},
 		},
+		"frozen": {
+			src:    &types.QueryContractInfoRequest{Address: contractAddr.String()},
+			stored: types.ContractInfoFixture(),
+			frozen: true,
+			expRsp: &types.QueryContractInfoResponse{
+				Address:      contractAddr.String(),
+				ContractInfo: types.ContractInfoFixture(),
+				Frozen:       true,
+			},
+		},
+		"with storage usage": {
+			src:    &types.QueryContractInfoRequest{Address: contractAddr.String()},
+			stored: types.ContractInfoFixture(),
+			state:  []types.Model{{Key: []byte("foo"), Value: []byte(`"bar"`)}, {Key: []byte("a"), Value: []byte{}}},
+			expRsp: &types.QueryContractInfoResponse{
+				Address:      contractAddr.String(),
+				ContractInfo: types.ContractInfoFixture(),
+				StorageUsage: types.ContractStorageUsage{Bytes: 9, Keys: 2},
+			},
+		},
 		"not found": {
 			src:    &types.QueryContractInfoRequest{Address: RandomBech32AccountAddress(t)},
 			stored: types.ContractInfoFixture(),
@@ -678,6 +856,10 @@ func TestQueryContractInfo(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			xCtx, _ := ctx.CacheContext()
 			k.mustStoreContractInfo(xCtx, contractAddr, &spec.stored) //nolint:gosec
+			if spec.frozen {
+				require.NoError(t, k.freezeContract(xCtx, contractAddr))
+			}
+			require.NoError(t, k.importContractState(xCtx, contractAddr, spec.state))
 			// when
 			gotRsp, gotErr := querier.ContractInfo(xCtx, spec.src)
 			if spec.expErr {
@@ -836,6 +1018,7 @@ func TestQueryCodeInfo(t *testing.T) {
 	specs := map[string]struct {
 		codeID       uint64
 		accessConfig types.AccessConfig
+		deprecated   bool
 	}{
 		"everybody": {
 			codeID:       1,
@@ -849,6 +1032,11 @@ func TestQueryCodeInfo(t *testing.T) {
 			codeID:       20,
 			accessConfig: types.AccessTypeAnyOfAddresses.With(anyAddress),
 		},
+		"deprecated": {
+			codeID:       30,
+			accessConfig: types.AllowEverybody,
+			deprecated:   true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -858,6 +1046,9 @@ func TestQueryCodeInfo(t *testing.T) {
 				codeInfo,
 				wasmCode),
 			)
+			if spec.deprecated {
+				require.NoError(t, keeper.deprecateCode(ctx, spec.codeID))
+			}
 
 			q := Querier(keeper)
 			got, err := q.CodeInfo(ctx, &types.QueryCodeInfoRequest{
@@ -869,6 +1060,7 @@ func TestQueryCodeInfo(t *testing.T) {
 				Creator:               codeInfo.Creator,
 				Checksum:              codeInfo.CodeHash,
 				InstantiatePermission: spec.accessConfig,
+				Deprecated:            spec.deprecated,
 			}
 			require.NotNil(t, got)
 			require.EqualValues(t, expectedResponse, got)
@@ -1098,6 +1290,331 @@ func TestQueryContractsByCreatorList(t *testing.T) {
 	}
 }
 
+func TestQueryContractsByAdminList(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	admin := RandomAccountAddress(t)
+
+	codeID, _, err := keepers.ContractKeeper.Create(ctx, creator, hackatomWasm, nil)
+	require.NoError(t, err)
+
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    creator,
+		Beneficiary: creator,
+	}.GetBytes(t)
+
+	var allExpectedContracts []string
+	for i := 0; i < 10; i++ {
+		contract, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, admin, initMsgBz, fmt.Sprintf("contract %d", i), nil)
+		require.NoError(t, err)
+		allExpectedContracts = append(allExpectedContracts, contract.String())
+	}
+	// not indexed without admin
+	_, _, err = keepers.ContractKeeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "no admin", nil)
+	require.NoError(t, err)
+	// index is ordered by contract address
+	sort.Slice(allExpectedContracts, func(i, j int) bool {
+		return bytes.Compare(sdk.MustAccAddressFromBech32(allExpectedContracts[i]), sdk.MustAccAddressFromBech32(allExpectedContracts[j])) < 0
+	})
+
+	specs := map[string]struct {
+		srcQuery        *types.QueryContractsByAdminRequest
+		expContractAddr []string
+		expErr          error
+	}{
+		"query all": {
+			srcQuery: &types.QueryContractsByAdminRequest{
+				AdminAddress: admin.String(),
+			},
+			expContractAddr: allExpectedContracts,
+		},
+		"with pagination offset": {
+			srcQuery: &types.QueryContractsByAdminRequest{
+				AdminAddress: admin.String(),
+				Pagination: &query.PageRequest{
+					Offset: 1,
+				},
+			},
+			expErr: errLegacyPaginationUnsupported,
+		},
+		"with pagination limit": {
+			srcQuery: &types.QueryContractsByAdminRequest{
+				AdminAddress: admin.String(),
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			expContractAddr: allExpectedContracts[0:1],
+		},
+		"unknown admin": {
+			srcQuery: &types.QueryContractsByAdminRequest{
+				AdminAddress: RandomBech32AccountAddress(t),
+			},
+			expContractAddr: []string{},
+		},
+		"nil admin": {
+			srcQuery: &types.QueryContractsByAdminRequest{
+				Pagination: &query.PageRequest{},
+			},
+			expErr: errors.New("empty address string is not allowed"),
+		},
+		"nil req": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	q := Querier(keepers.WasmKeeper)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, gotErr := q.ContractsByAdmin(ctx, spec.srcQuery)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.NotNil(t, got)
+			assert.Equal(t, spec.expContractAddr, got.ContractAddresses)
+		})
+	}
+}
+
+func TestQueryLargestContracts(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	small, large, medium := RandomAccountAddress(t), RandomAccountAddress(t), RandomAccountAddress(t)
+	require.NoError(t, k.importContractState(ctx, small, []types.Model{{Key: []byte("a"), Value: []byte("1")}}))
+	require.NoError(t, k.importContractState(ctx, large, []types.Model{{Key: []byte("a"), Value: make([]byte, 100)}, {Key: []byte("b"), Value: []byte("1")}}))
+	require.NoError(t, k.importContractState(ctx, medium, []types.Model{{Key: []byte("a"), Value: make([]byte, 10)}}))
+	// an empty store is not listed
+	require.NoError(t, k.importContractState(ctx, RandomAccountAddress(t), nil))
+
+	expLarge := types.ContractStorageUsageEntry{Address: large.String(), StorageUsage: types.ContractStorageUsage{Bytes: 103, Keys: 2}}
+	expMedium := types.ContractStorageUsageEntry{Address: medium.String(), StorageUsage: types.ContractStorageUsage{Bytes: 11, Keys: 1}}
+	expSmall := types.ContractStorageUsageEntry{Address: small.String(), StorageUsage: types.ContractStorageUsage{Bytes: 2, Keys: 1}}
+
+	specs := map[string]struct {
+		srcQuery     *types.QueryLargestContractsRequest
+		expContracts []types.ContractStorageUsageEntry
+		expErr       error
+	}{
+		"query all": {
+			srcQuery:     &types.QueryLargestContractsRequest{},
+			expContracts: []types.ContractStorageUsageEntry{expLarge, expMedium, expSmall},
+		},
+		"with pagination limit": {
+			srcQuery:     &types.QueryLargestContractsRequest{Pagination: &query.PageRequest{Limit: 2}},
+			expContracts: []types.ContractStorageUsageEntry{expLarge, expMedium},
+		},
+		"reverse": {
+			srcQuery:     &types.QueryLargestContractsRequest{Pagination: &query.PageRequest{Reverse: true}},
+			expContracts: []types.ContractStorageUsageEntry{expSmall, expMedium, expLarge},
+		},
+		"with pagination offset": {
+			srcQuery: &types.QueryLargestContractsRequest{Pagination: &query.PageRequest{Offset: 1}},
+			expErr:   errLegacyPaginationUnsupported,
+		},
+		"nil req": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	q := Querier(k)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, gotErr := q.LargestContracts(ctx, spec.srcQuery)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.NotNil(t, got)
+			assert.Equal(t, spec.expContracts, got.Contracts)
+		})
+	}
+
+	// and paginate with next key
+	got, err := q.LargestContracts(ctx, &types.QueryLargestContractsRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.NotEmpty(t, got.Pagination.NextKey)
+	got, err = q.LargestContracts(ctx, &types.QueryLargestContractsRequest{Pagination: &query.PageRequest{Key: got.Pagination.NextKey}})
+	require.NoError(t, err)
+	assert.Equal(t, []types.ContractStorageUsageEntry{expSmall}, got.Contracts)
+}
+
+func TestQueryCodesByChecksum(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example1 := StoreHackatomExampleContract(t, ctx, keepers)
+	example2 := StoreReflectContract(t, ctx, keepers)
+	example3 := StoreHackatomExampleContract(t, ctx, keepers)
+
+	specs := map[string]struct {
+		srcQuery   *types.QueryCodesByChecksumRequest
+		expCodeIDs []uint64
+		expErr     error
+	}{
+		"query all": {
+			srcQuery:   &types.QueryCodesByChecksumRequest{Checksum: hex.EncodeToString(example1.Checksum)},
+			expCodeIDs: []uint64{example1.CodeID, example3.CodeID},
+		},
+		"single code": {
+			srcQuery:   &types.QueryCodesByChecksumRequest{Checksum: hex.EncodeToString(example2.Checksum)},
+			expCodeIDs: []uint64{example2.CodeID},
+		},
+		"with pagination limit": {
+			srcQuery: &types.QueryCodesByChecksumRequest{
+				Checksum:   hex.EncodeToString(example1.Checksum),
+				Pagination: &query.PageRequest{Limit: 1},
+			},
+			expCodeIDs: []uint64{example1.CodeID},
+		},
+		"unknown checksum": {
+			srcQuery:   &types.QueryCodesByChecksumRequest{Checksum: hex.EncodeToString(bytes.Repeat([]byte{1}, 32))},
+			expCodeIDs: []uint64{},
+		},
+		"invalid checksum length": {
+			srcQuery: &types.QueryCodesByChecksumRequest{Checksum: "0102"},
+			expErr:   status.Error(codes.InvalidArgument, "checksum must be 32 bytes: invalid"),
+		},
+		"invalid hex": {
+			srcQuery: &types.QueryCodesByChecksumRequest{Checksum: "not-hex"},
+			expErr:   errors.New("invalid checksum"),
+		},
+		"nil req": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	q := Querier(keepers.WasmKeeper)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := q.CodesByChecksum(ctx, spec.srcQuery)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			gotCodeIDs := make([]uint64, 0)
+			for _, c := range got.CodeInfos {
+				assert.Equal(t, spec.srcQuery.Checksum, hex.EncodeToString(c.DataHash))
+				gotCodeIDs = append(gotCodeIDs, c.CodeID)
+			}
+			assert.Equal(t, spec.expCodeIDs, gotCodeIDs)
+		})
+	}
+}
+
+func TestQueryContractsByChecksum(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 1_000_000))
+	example1 := StoreHackatomExampleContract(t, ctx, keepers)
+	example2 := StoreReflectContract(t, ctx, keepers)
+	example3 := StoreHackatomExampleContract(t, ctx, keepers)
+
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    creator,
+		Beneficiary: creator,
+	}.GetBytes(t)
+	var expContracts []string
+	for _, codeID := range []uint64{example3.CodeID, example1.CodeID, example3.CodeID} {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "label", nil)
+		require.NoError(t, err)
+		expContracts = append(expContracts, contractAddr.String())
+	}
+	// ordered by code id
+	expContracts = []string{expContracts[1], expContracts[0], expContracts[2]}
+	_, _, err := keepers.ContractKeeper.Instantiate(ctx, example2.CodeID, creator, nil, []byte("{}"), "other", nil)
+	require.NoError(t, err)
+
+	q := Querier(keepers.WasmKeeper)
+	specs := map[string]struct {
+		srcQuery     *types.QueryContractsByChecksumRequest
+		expContracts []string
+		expErr       error
+	}{
+		"query all": {
+			srcQuery:     &types.QueryContractsByChecksumRequest{Checksum: hex.EncodeToString(example1.Checksum)},
+			expContracts: expContracts,
+		},
+		"unknown checksum": {
+			srcQuery:     &types.QueryContractsByChecksumRequest{Checksum: hex.EncodeToString(bytes.Repeat([]byte{1}, 32))},
+			expContracts: []string{},
+		},
+		"with reverse": {
+			srcQuery: &types.QueryContractsByChecksumRequest{
+				Checksum:   hex.EncodeToString(example1.Checksum),
+				Pagination: &query.PageRequest{Reverse: true},
+			},
+			expErr: status.Error(codes.InvalidArgument, "reverse pagination not supported"),
+		},
+		"with offset": {
+			srcQuery: &types.QueryContractsByChecksumRequest{
+				Checksum:   hex.EncodeToString(example1.Checksum),
+				Pagination: &query.PageRequest{Offset: 1},
+			},
+			expErr: status.Error(codes.InvalidArgument, "offset and count queries not supported"),
+		},
+		"with count total": {
+			srcQuery: &types.QueryContractsByChecksumRequest{
+				Checksum:   hex.EncodeToString(example1.Checksum),
+				Pagination: &query.PageRequest{CountTotal: true},
+			},
+			expErr: status.Error(codes.InvalidArgument, "offset and count queries not supported"),
+		},
+		"invalid pagination key": {
+			srcQuery: &types.QueryContractsByChecksumRequest{
+				Checksum:   hex.EncodeToString(example1.Checksum),
+				Pagination: &query.PageRequest{Key: []byte{1}},
+			},
+			expErr: status.Error(codes.InvalidArgument, "invalid pagination key"),
+		},
+		"invalid checksum": {
+			srcQuery: &types.QueryContractsByChecksumRequest{Checksum: "0102"},
+			expErr:   status.Error(codes.InvalidArgument, "checksum must be 32 bytes: invalid"),
+		},
+		"nil req": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := q.ContractsByChecksum(ctx, spec.srcQuery)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expContracts, got.Contracts)
+			assert.Nil(t, got.Pagination.NextKey)
+		})
+	}
+
+	t.Run("paginate across code ids", func(t *testing.T) {
+		var gotContracts []string
+		var nextKey []byte
+		for i := 0; ; i++ {
+			require.Less(t, i, len(expContracts))
+			got, err := q.ContractsByChecksum(ctx, &types.QueryContractsByChecksumRequest{
+				Checksum:   hex.EncodeToString(example1.Checksum),
+				Pagination: &query.PageRequest{Key: nextKey, Limit: 1},
+			})
+			require.NoError(t, err)
+			gotContracts = append(gotContracts, got.Contracts...)
+			if nextKey = got.Pagination.NextKey; nextKey == nil {
+				break
+			}
+		}
+		assert.Equal(t, expContracts, gotContracts)
+	})
+}
+
 func fromBase64(s string) []byte {
 	r, err := base64.StdEncoding.DecodeString(s)
 	if err != nil {
@@ -1249,3 +1766,348 @@ func TestQueryBuildAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryTraceExecute(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := example.Contract.String()
+
+	q := Querier(keepers.WasmKeeper)
+	specs := map[string]struct {
+		src    *types.QueryTraceExecuteRequest
+		expErr bool
+	}{
+		"execute succeeds": {
+			src: &types.QueryTraceExecuteRequest{Sender: example.VerifierAddr.String(), Contract: contractAddr, Msg: []byte(`{"release":{}}`)},
+		},
+		"execute fails": {
+			src: &types.QueryTraceExecuteRequest{Sender: example.CreatorAddr.String(), Contract: contractAddr, Msg: []byte(`{"release":{}}`)},
+		},
+		"invalid msg": {
+			src:    &types.QueryTraceExecuteRequest{Sender: example.VerifierAddr.String(), Contract: contractAddr, Msg: []byte(`not json`)},
+			expErr: true,
+		},
+		"invalid sender": {
+			src:    &types.QueryTraceExecuteRequest{Sender: "invalid", Contract: contractAddr, Msg: []byte(`{"release":{}}`)},
+			expErr: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := q.TraceExecute(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.NotNil(t, got.Trace)
+			assert.Equal(t, contractAddr, got.Trace.Contract)
+			assert.NotZero(t, got.GasUsed)
+			// state was not modified
+			assert.Equal(t, example.Deposit, keepers.BankKeeper.GetAllBalances(ctx, example.Contract))
+		})
+	}
+}
+
+func TestQuerySimulateExecute(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := example.Contract.String()
+	funds := sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+
+	specs := map[string]struct {
+		src           *types.QuerySimulateExecuteRequest
+		queryGasLimit storetypes.Gas
+		expErr        bool
+	}{
+		"execute succeeds": {
+			src: &types.QuerySimulateExecuteRequest{Sender: example.VerifierAddr.String(), Contract: contractAddr, Msg: []byte(`{"release":{}}`), Funds: funds},
+		},
+		"execute fails": {
+			src:    &types.QuerySimulateExecuteRequest{Sender: example.CreatorAddr.String(), Contract: contractAddr, Msg: []byte(`{"release":{}}`)},
+			expErr: true,
+		},
+		"out of gas": {
+			src:           &types.QuerySimulateExecuteRequest{Sender: example.VerifierAddr.String(), Contract: contractAddr, Msg: []byte(`{"release":{}}`)},
+			queryGasLimit: 1,
+			expErr:        true,
+		},
+		"invalid msg": {
+			src:    &types.QuerySimulateExecuteRequest{Sender: example.VerifierAddr.String(), Contract: contractAddr, Msg: []byte(`not json`)},
+			expErr: true,
+		},
+		"invalid sender": {
+			src:    &types.QuerySimulateExecuteRequest{Sender: "invalid", Contract: contractAddr, Msg: []byte(`{"release":{}}`)},
+			expErr: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			queryGasLimit := keepers.WasmKeeper.queryGasLimit
+			if spec.queryGasLimit != 0 {
+				queryGasLimit = spec.queryGasLimit
+			}
+			q := NewGrpcQuerier(keepers.WasmKeeper.cdc, keepers.WasmKeeper.storeService, keepers.WasmKeeper, queryGasLimit)
+			got, gotErr := q.SimulateExecute(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.NotZero(t, got.GasUsed)
+			assert.NotEmpty(t, got.Events)
+			// state was not modified
+			assert.Equal(t, example.Deposit, keepers.BankKeeper.GetAllBalances(ctx, example.Contract))
+		})
+	}
+}
+
+func TestQueryCronSchedules(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	for _, name := range []string{"c", "a", "b"} {
+		s := types.CronSchedule{Name: name, Contract: example.Contract.String(), Msg: []byte(`{}`), Interval: 1, GasLimit: 1}
+		require.NoError(t, k.registerCronSchedule(ctx, s))
+	}
+	// ordered by name
+	all := []types.CronSchedule{*k.GetCronSchedule(ctx, "a"), *k.GetCronSchedule(ctx, "b"), *k.GetCronSchedule(ctx, "c")}
+	q := Querier(k)
+
+	specs := map[string]struct {
+		src     *types.QueryCronSchedulesRequest
+		exp     []types.CronSchedule
+		expNext bool
+	}{
+		"all": {
+			src: &types.QueryCronSchedulesRequest{},
+			exp: all,
+		},
+		"with pagination": {
+			src:     &types.QueryCronSchedulesRequest{Pagination: &query.PageRequest{Limit: 2}},
+			exp:     all[:2],
+			expNext: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := q.CronSchedules(ctx, spec.src)
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got.Schedules)
+			assert.Equal(t, spec.expNext, len(got.Pagination.NextKey) != 0)
+		})
+	}
+
+	// single schedule
+	got, err := q.CronSchedule(ctx, &types.QueryCronScheduleRequest{Name: "b"})
+	require.NoError(t, err)
+	assert.Equal(t, all[1], got.Schedule)
+	_, err = q.CronSchedule(ctx, &types.QueryCronScheduleRequest{Name: "unknown"})
+	require.ErrorIs(t, err, types.ErrNotFound)
+	_, err = q.CronSchedule(ctx, &types.QueryCronScheduleRequest{Name: "invalid name"})
+	require.Error(t, err)
+	_, err = q.CronSchedule(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryEpochHookSubscriptions(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	otherExample := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contracts := []string{example.Contract.String(), otherExample.Contract.String()}
+	if bytes.Compare(example.Contract, otherExample.Contract) > 0 {
+		contracts[0], contracts[1] = contracts[1], contracts[0]
+	}
+	// ordered by length prefixed epoch identifier and contract address
+	all := []types.EpochHookSubscription{
+		{Contract: contracts[0], EpochIdentifier: "day", GasLimit: 1},
+		{Contract: contracts[0], EpochIdentifier: "week", GasLimit: 2},
+		{Contract: contracts[1], EpochIdentifier: "week", GasLimit: 3},
+	}
+	for _, s := range all {
+		require.NoError(t, k.importEpochHookSubscription(ctx, s))
+	}
+	q := Querier(k)
+
+	specs := map[string]struct {
+		src     *types.QueryEpochHookSubscriptionsRequest
+		exp     []types.EpochHookSubscription
+		expNext bool
+		expErr  bool
+	}{
+		"all": {
+			src: &types.QueryEpochHookSubscriptionsRequest{},
+			exp: all,
+		},
+		"with pagination": {
+			src:     &types.QueryEpochHookSubscriptionsRequest{Pagination: &query.PageRequest{Limit: 2}},
+			exp:     all[:2],
+			expNext: true,
+		},
+		"by epoch identifier": {
+			src: &types.QueryEpochHookSubscriptionsRequest{EpochIdentifier: "week"},
+			exp: all[1:],
+		},
+		"unknown epoch identifier": {
+			src: &types.QueryEpochHookSubscriptionsRequest{EpochIdentifier: "month"},
+			exp: []types.EpochHookSubscription{},
+		},
+		"invalid epoch identifier": {
+			src:    &types.QueryEpochHookSubscriptionsRequest{EpochIdentifier: "my week"},
+			expErr: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := q.EpochHookSubscriptions(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got.Subscriptions)
+			assert.Equal(t, spec.expNext, len(got.Pagination.NextKey) != 0)
+		})
+	}
+}
+
+func TestQueryFeeShares(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	otherExample := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contracts := []string{example.Contract.String(), otherExample.Contract.String()}
+	if bytes.Compare(example.Contract, otherExample.Contract) > 0 {
+		contracts[0], contracts[1] = contracts[1], contracts[0]
+	}
+	// ordered by contract address
+	all := []types.FeeShare{
+		{Contract: contracts[0], WithdrawAddress: RandomBech32AccountAddress(t)},
+		{Contract: contracts[1], WithdrawAddress: RandomBech32AccountAddress(t)},
+	}
+	for _, f := range all {
+		require.NoError(t, k.importFeeShare(ctx, f))
+	}
+	q := Querier(k)
+
+	t.Run("single", func(t *testing.T) {
+		got, err := q.FeeShare(ctx, &types.QueryFeeShareRequest{Address: all[1].Contract})
+		require.NoError(t, err)
+		assert.Equal(t, all[1], got.FeeShare)
+
+		_, err = q.FeeShare(ctx, &types.QueryFeeShareRequest{Address: RandomBech32AccountAddress(t)})
+		require.ErrorIs(t, err, types.ErrNotFound)
+		_, err = q.FeeShare(ctx, &types.QueryFeeShareRequest{Address: "invalid"})
+		require.Error(t, err)
+		_, err = q.FeeShare(ctx, nil)
+		require.Error(t, err)
+	})
+
+	specs := map[string]struct {
+		src     *types.QueryFeeSharesRequest
+		exp     []types.FeeShare
+		expNext bool
+		expErr  bool
+	}{
+		"all": {
+			src: &types.QueryFeeSharesRequest{},
+			exp: all,
+		},
+		"with pagination": {
+			src:     &types.QueryFeeSharesRequest{Pagination: &query.PageRequest{Limit: 1}},
+			exp:     all[:1],
+			expNext: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := q.FeeShares(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got.FeeShares)
+			assert.Equal(t, spec.expNext, len(got.Pagination.NextKey) != 0)
+		})
+	}
+}
+
+func TestQueryFeeSponsorships(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	otherExample := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contracts := []string{example.Contract.String(), otherExample.Contract.String()}
+	if bytes.Compare(example.Contract, otherExample.Contract) > 0 {
+		contracts[0], contracts[1] = contracts[1], contracts[0]
+	}
+	limit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	// ordered by contract address
+	all := []types.FeeSponsorship{
+		{Contract: contracts[0], PerBlockLimit: limit, PerSenderLimit: limit, ApprovalGasLimit: 1},
+		{Contract: contracts[1], PerBlockLimit: limit, PerSenderLimit: limit, ApprovalGasLimit: 2},
+	}
+	for _, s := range all {
+		require.NoError(t, k.importFeeSponsorship(ctx, s))
+	}
+	q := Querier(k)
+
+	t.Run("single", func(t *testing.T) {
+		got, err := q.FeeSponsorship(ctx, &types.QueryFeeSponsorshipRequest{Address: all[1].Contract})
+		require.NoError(t, err)
+		assert.Equal(t, all[1], got.FeeSponsorship)
+
+		_, err = q.FeeSponsorship(ctx, &types.QueryFeeSponsorshipRequest{Address: RandomBech32AccountAddress(t)})
+		require.ErrorIs(t, err, types.ErrNotFound)
+		_, err = q.FeeSponsorship(ctx, &types.QueryFeeSponsorshipRequest{Address: "invalid"})
+		require.Error(t, err)
+		_, err = q.FeeSponsorship(ctx, nil)
+		require.Error(t, err)
+	})
+
+	specs := map[string]struct {
+		src     *types.QueryFeeSponsorshipsRequest
+		exp     []types.FeeSponsorship
+		expNext bool
+		expErr  bool
+	}{
+		"all": {
+			src: &types.QueryFeeSponsorshipsRequest{},
+			exp: all,
+		},
+		"with pagination": {
+			src:     &types.QueryFeeSponsorshipsRequest{Pagination: &query.PageRequest{Limit: 1}},
+			exp:     all[:1],
+			expNext: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := q.FeeSponsorships(ctx, spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got.FeeSponsorships)
+			assert.Equal(t, spec.expNext, len(got.Pagination.NextKey) != 0)
+		})
+	}
+}
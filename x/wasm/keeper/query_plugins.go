@@ -61,7 +61,9 @@ func (q QueryHandler) Query(request wasmvmtypes.QueryRequest, gasLimit uint64) (
 		q.Ctx.GasMeter().ConsumeGas(subCtx.GasMeter().GasConsumed(), "contract sub-query")
 	}()
 
+	span := startTraceSpan(subCtx, traceTypeQuery, q.Caller, request)
 	res, err := q.Plugins.HandleQuery(subCtx, q.Caller, request)
+	span.end(err)
 	if err == nil {
 		// short-circuit, the rest is dealing with handling existing errors
 		return res, nil
@@ -104,6 +106,10 @@ type contractMetaDataSource interface {
 	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo
 }
 
+type contractNameSource interface {
+	GetContractByName(ctx context.Context, name string) sdk.AccAddress
+}
+
 type wasmQueryKeeper interface {
 	contractMetaDataSource
 	GetCodeInfo(ctx context.Context, codeID uint64) *types.CodeInfo
@@ -262,6 +268,27 @@ func NoCustomQuerier(sdk.Context, json.RawMessage) ([]byte, error) {
 	return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
 }
 
+// ContractNameQuerier resolves claimed contract names for the contract name custom query. Any other
+// custom query is rejected as unsupported. It is not part of the default query plugins and can be
+// enabled with the WithContractNameQuerier option.
+func ContractNameQuerier(k contractNameSource) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query types.ContractNameCustomQuery
+		if err := json.Unmarshal(request, &query); err != nil || query.ContractByName == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
+		}
+		name := query.ContractByName.Name
+		if err := types.ValidateContractName(name); err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: err.Error(), Request: request}
+		}
+		contractAddr := k.GetContractByName(ctx, name)
+		if contractAddr == nil {
+			return nil, errorsmod.Wrapf(types.ErrNotFound, "contract name %s", name)
+		}
+		return json.Marshal(types.ContractByNameCustomQueryResponse{Address: contractAddr.String()})
+	}
+}
+
 func IBCQuerier(wasm contractMetaDataSource, channelKeeper types.ChannelKeeper) func(ctx sdk.Context, caller sdk.AccAddress, request *wasmvmtypes.IBCQuery) ([]byte, error) {
 	return func(ctx sdk.Context, caller sdk.AccAddress, request *wasmvmtypes.IBCQuery) ([]byte, error) {
 		if request.PortID != nil {
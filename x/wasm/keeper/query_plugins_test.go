@@ -587,6 +587,70 @@ func TestCodeInfoWasmQuerier(t *testing.T) {
 	}
 }
 
+func TestContractNameQuerier(t *testing.T) {
+	myContractAddr := keeper.RandomAccountAddress(t)
+	var ctx sdk.Context
+
+	specs := map[string]struct {
+		req            string
+		mock           mockWasmQueryKeeper
+		expRes         types.ContractByNameCustomQueryResponse
+		expErr         bool
+		expUnsupported bool
+	}{
+		"all good": {
+			req: `{"contract_by_name":{"name":"mantra.dex.pool-factory"}}`,
+			mock: mockWasmQueryKeeper{
+				GetContractByNameFn: func(ctx context.Context, name string) sdk.AccAddress {
+					require.Equal(t, "mantra.dex.pool-factory", name)
+					return myContractAddr
+				},
+			},
+			expRes: types.ContractByNameCustomQueryResponse{Address: myContractAddr.String()},
+		},
+		"unknown name": {
+			req: `{"contract_by_name":{"name":"unknown"}}`,
+			mock: mockWasmQueryKeeper{
+				GetContractByNameFn: func(ctx context.Context, name string) sdk.AccAddress {
+					return nil
+				},
+			},
+			expErr: true,
+		},
+		"invalid name": {
+			req:    `{"contract_by_name":{"name":"Invalid Name"}}`,
+			expErr: true,
+		},
+		"other custom query": {
+			req:            `{"other":{}}`,
+			expErr:         true,
+			expUnsupported: true,
+		},
+		"invalid json": {
+			req:            `not json`,
+			expErr:         true,
+			expUnsupported: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := keeper.ContractNameQuerier(spec.mock)
+			gotBz, gotErr := q(ctx, []byte(spec.req))
+			if spec.expErr {
+				require.Error(t, gotErr)
+				if spec.expUnsupported {
+					assert.Equal(t, wasmvmtypes.UnsupportedRequest{Kind: "custom"}, gotErr)
+				}
+				return
+			}
+			require.NoError(t, gotErr)
+			var gotRes types.ContractByNameCustomQueryResponse
+			require.NoError(t, json.Unmarshal(gotBz, &gotRes), string(gotBz))
+			assert.Equal(t, spec.expRes, gotRes)
+		})
+	}
+}
+
 func TestRawRangeWasmQuerier(t *testing.T) {
 	myValidContractAddr := keeper.RandomBech32AccountAddress(t)
 	validResponse := wasmvmtypes.RawRangeResponse{
@@ -716,12 +780,20 @@ func TestQueryErrors(t *testing.T) {
 }
 
 type mockWasmQueryKeeper struct {
-	GetContractInfoFn func(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo
-	QueryRawFn        func(ctx context.Context, contractAddress sdk.AccAddress, key []byte) []byte
-	QuerySmartFn      func(ctx context.Context, contractAddr sdk.AccAddress, req types.RawContractMessage) ([]byte, error)
-	QueryRawRangeFn   func(ctx context.Context, contractAddress sdk.AccAddress, start, end []byte, limit uint16, reverse bool) (results []wasmvmtypes.RawRangeEntry, nextKey []byte)
-	IsPinnedCodeFn    func(ctx context.Context, codeID uint64) bool
-	GetCodeInfoFn     func(ctx context.Context, codeID uint64) *types.CodeInfo
+	GetContractInfoFn   func(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo
+	QueryRawFn          func(ctx context.Context, contractAddress sdk.AccAddress, key []byte) []byte
+	QuerySmartFn        func(ctx context.Context, contractAddr sdk.AccAddress, req types.RawContractMessage) ([]byte, error)
+	QueryRawRangeFn     func(ctx context.Context, contractAddress sdk.AccAddress, start, end []byte, limit uint16, reverse bool) (results []wasmvmtypes.RawRangeEntry, nextKey []byte)
+	IsPinnedCodeFn      func(ctx context.Context, codeID uint64) bool
+	GetCodeInfoFn       func(ctx context.Context, codeID uint64) *types.CodeInfo
+	GetContractByNameFn func(ctx context.Context, name string) sdk.AccAddress
+}
+
+func (m mockWasmQueryKeeper) GetContractByName(ctx context.Context, name string) sdk.AccAddress {
+	if m.GetContractByNameFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetContractByNameFn(ctx, name)
 }
 
 func (m mockWasmQueryKeeper) GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
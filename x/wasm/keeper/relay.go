@@ -33,18 +33,24 @@ func (k Keeper) OnOpenChannel(
 	if err != nil {
 		return "", err
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddr); err != nil {
+		return "", err
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	trackTxContract(sdkCtx, contractAddr)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	k.recordCodeExecution(sdkCtx, contractInfo.CodeID)
+	gasRegister := k.codeGasRegister(ctx, contractInfo.CodeID)
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-open-channel")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, gasRegister)
 
-	gasLeft := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
+	gasLeft := k.runtimeGasForContract(ctx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasRegister, gasUsed)
 	// check if contract panicked / VM failed
 	if execErr != nil {
 		return "", errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
@@ -82,18 +88,24 @@ func (k Keeper) OnConnectChannel(
 	if err != nil {
 		return err
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddr); err != nil {
+		return err
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	trackTxContract(sdkCtx, contractAddr)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	k.recordCodeExecution(sdkCtx, contractInfo.CodeID)
+	gasRegister := k.codeGasRegister(ctx, contractInfo.CodeID)
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-connect-channel")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, gasRegister)
 
-	gasLeft := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
+	gasLeft := k.runtimeGasForContract(ctx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasRegister, gasUsed)
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -125,18 +137,24 @@ func (k Keeper) OnCloseChannel(
 	if err != nil {
 		return err
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddr); err != nil {
+		return err
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	trackTxContract(sdkCtx, contractAddr)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	k.recordCodeExecution(sdkCtx, contractInfo.CodeID)
+	gasRegister := k.codeGasRegister(ctx, contractInfo.CodeID)
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-close-channel")
 
 	params := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, gasRegister)
 
-	gasLeft := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
+	gasLeft := k.runtimeGasForContract(ctx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasRegister, gasUsed)
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -167,18 +185,24 @@ func (k Keeper) OnRecvPacket(
 	if err != nil {
 		return nil, err
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddr); err != nil {
+		return nil, err
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	trackTxContract(sdkCtx, contractAddr)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	k.recordCodeExecution(sdkCtx, contractInfo.CodeID)
+	gasRegister := k.codeGasRegister(ctx, contractInfo.CodeID)
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-recv-packet")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, gasRegister)
 
-	gasLeft := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
+	gasLeft := k.runtimeGasForContract(ctx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasRegister, gasUsed)
 	if execErr != nil {
 		panic(execErr) // let the contract fully abort an IBC packet receive.
 		// Throwing a panic here instead of an error ack will revert
@@ -196,6 +220,7 @@ func (k Keeper) OnRecvPacket(
 		}, nil
 	}
 	// note submessage reply results can overwrite the `Acknowledgement` data
+	ctx, _ = storageDepositPayer(ctx, nil)
 	data, err := k.handleContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Acknowledgement, res.Ok.Events)
 	if err != nil {
 		// submessage errors result in error ACK with state reverted. Error message is redacted
@@ -245,18 +270,24 @@ func (k Keeper) OnAckPacket(
 	if err != nil {
 		return err
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddr); err != nil {
+		return err
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	trackTxContract(sdkCtx, contractAddr)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	k.recordCodeExecution(sdkCtx, contractInfo.CodeID)
+	gasRegister := k.codeGasRegister(ctx, contractInfo.CodeID)
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-ack-packet")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, gasRegister)
 
-	gasLeft := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
+	gasLeft := k.runtimeGasForContract(ctx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasRegister, gasUsed)
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -285,18 +316,24 @@ func (k Keeper) OnTimeoutPacket(
 	if err != nil {
 		return err
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddr); err != nil {
+		return err
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	trackTxContract(sdkCtx, contractAddr)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	k.recordCodeExecution(sdkCtx, contractInfo.CodeID)
+	gasRegister := k.codeGasRegister(ctx, contractInfo.CodeID)
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-timeout-packet")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, gasRegister)
 
-	gasLeft := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
+	gasLeft := k.runtimeGasForContract(ctx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasRegister, gasUsed)
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -324,18 +361,24 @@ func (k Keeper) IBCSourceCallback(
 	if err != nil {
 		return err
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddr); err != nil {
+		return err
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	trackTxContract(sdkCtx, contractAddr)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	k.recordCodeExecution(sdkCtx, contractInfo.CodeID)
+	gasRegister := k.codeGasRegister(ctx, contractInfo.CodeID)
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-source-chain-callback")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, gasRegister)
 
-	gasLeft := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCSourceCallback(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
+	gasLeft := k.runtimeGasForContract(ctx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.IBCSourceCallback(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasRegister, gasUsed)
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -363,18 +406,24 @@ func (k Keeper) IBCDestinationCallback(
 	if err != nil {
 		return err
 	}
+	if err := k.assertContractNotFrozen(ctx, contractAddr); err != nil {
+		return err
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	trackTxContract(sdkCtx, contractAddr)
 	sdkCtx, discount := k.checkDiscountEligibility(sdkCtx, codeInfo.CodeHash, k.IsPinnedCode(ctx, contractInfo.CodeID))
-	setupCost := k.gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
+	k.recordCodeExecution(sdkCtx, contractInfo.CodeID)
+	gasRegister := k.codeGasRegister(ctx, contractInfo.CodeID)
+	setupCost := gasRegister.SetupContractCost(discount, msg.ExpectedJSONSize())
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: ibc-destination-chain-callback")
 
 	env := types.NewEnv(ctx, k.txHash, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+	querier := k.newQueryHandler(ctx, contractAddr, gasRegister)
 
-	gasLeft := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCDestinationCallback(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
+	gasLeft := k.runtimeGasForContract(ctx, gasRegister)
+	res, gasUsed, execErr := k.wasmVM.IBCDestinationCallback(codeInfo.CodeHash, env, msg, prefixStore, k.wasmVMAPI(ctx), querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasRegister, gasUsed)
 	if execErr != nil {
 		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
@@ -390,6 +439,7 @@ func (k Keeper) IBCDestinationCallback(
 }
 
 func (k Keeper) handleIBCBasicContractResponse(ctx sdk.Context, addr sdk.AccAddress, id string, res *wasmvmtypes.IBCBasicResponse) error {
+	ctx, _ = storageDepositPayer(ctx, nil)
 	_, err := k.handleContractResponse(ctx, addr, id, res.Messages, res.Attributes, nil, res.Events)
 	return err
 }
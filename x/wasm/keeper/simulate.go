@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// coinMinter is implemented by bank keepers that can mint coins. It is used to credit arbitrary funds to the
+// sender of a simulated execution.
+type coinMinter interface {
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// storeKeyRecorder collects the keys written or deleted in the wasm store
+type storeKeyRecorder struct {
+	keys map[string]struct{}
+}
+
+// cacheMultiStore is an alias so that the embedded field name does not collide with the CacheMultiStore method
+type cacheMultiStore = storetypes.CacheMultiStore
+
+// recordingMultiStore wraps a cache multistore to record all keys written to the wasm store,
+// including writes in nested cache stores that may be discarded later
+type recordingMultiStore struct {
+	cacheMultiStore
+	recorder *storeKeyRecorder
+}
+
+func (s recordingMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store := s.cacheMultiStore.GetKVStore(key)
+	if key.Name() != types.StoreKey {
+		return store
+	}
+	return recordingKVStore{KVStore: store, recorder: s.recorder}
+}
+
+func (s recordingMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return recordingMultiStore{cacheMultiStore: s.cacheMultiStore.CacheMultiStore(), recorder: s.recorder}
+}
+
+// recordingKVStore records the keys of all write operations
+type recordingKVStore struct {
+	storetypes.KVStore
+	recorder *storeKeyRecorder
+}
+
+func (s recordingKVStore) Set(key, value []byte) {
+	s.recorder.keys[string(key)] = struct{}{}
+	s.KVStore.Set(key, value)
+}
+
+func (s recordingKVStore) Delete(key []byte) {
+	s.recorder.keys[string(key)] = struct{}{}
+	s.KVStore.Delete(key)
+}
+
+// simulateExecute runs the contract execution in a cached context and returns the result with all contract
+// storage changes. The funds are credited to the sender before the execution. State changes are always discarded.
+func (k Keeper) simulateExecute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*types.QuerySimulateExecuteResponse, error) {
+	if k.simulateExecuteDisabled {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "simulate execute disabled")
+	}
+	recorder := &storeKeyRecorder{keys: make(map[string]struct{})}
+	cacheCtx := ctx.WithMultiStore(recordingMultiStore{
+		cacheMultiStore: ctx.MultiStore().CacheMultiStore(),
+		recorder:        recorder,
+	}).WithEventManager(sdk.NewEventManager())
+
+	if !coins.IsZero() {
+		if err := k.fundSimulationSender(cacheCtx, caller, coins); err != nil {
+			return nil, err
+		}
+	}
+	gasStart := cacheCtx.GasMeter().GasConsumed()
+	data, err := k.execute(cacheCtx, contractAddress, caller, msg, coins)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySimulateExecuteResponse{
+		Data:         data,
+		Events:       sdk.StringifyEvents(cacheCtx.EventManager().ABCIEvents()),
+		GasUsed:      cacheCtx.GasMeter().GasConsumed() - gasStart,
+		StateChanges: k.contractStateChanges(ctx, cacheCtx, recorder),
+	}, nil
+}
+
+// fundSimulationSender mints the coins to the sender account. This is gas free and must only be used with
+// a cached context that is never committed.
+func (k Keeper) fundSimulationSender(ctx sdk.Context, sender sdk.AccAddress, coins sdk.Coins) error {
+	minter := k.simulationMinter
+	if minter == nil {
+		return errorsmod.Wrap(types.ErrInvalid, "funds not supported")
+	}
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	if err := minter.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+		return errorsmod.Wrap(err, "mint funds")
+	}
+	return errorsmod.Wrap(minter.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, coins), "fund sender")
+}
+
+// contractStateChanges compares the recorded contract storage keys between the original and the cached context.
+// Keys that were written and later reverted or that were set to the same value are dropped.
+func (k Keeper) contractStateChanges(origCtx, cacheCtx sdk.Context, recorder *storeKeyRecorder) []types.ContractStateChange {
+	origStore := k.storeService.OpenKVStore(origCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	newStore := k.storeService.OpenKVStore(cacheCtx)
+
+	var result []types.ContractStateChange
+	for rawKey := range recorder.keys {
+		key := []byte(rawKey)
+		if !bytes.HasPrefix(key, types.ContractStorePrefix) {
+			continue
+		}
+		contractAddr, contractKey := k.splitContractStoreKey(cacheCtx, key)
+		if contractAddr == nil {
+			continue
+		}
+		oldValue, err := origStore.Get(key)
+		if err != nil {
+			panic(err)
+		}
+		newValue, err := newStore.Get(key)
+		if err != nil {
+			panic(err)
+		}
+		if bytes.Equal(oldValue, newValue) {
+			continue
+		}
+		result = append(result, types.ContractStateChange{
+			Contract: contractAddr.String(),
+			Key:      contractKey,
+			OldValue: oldValue,
+			NewValue: newValue,
+			Deleted:  newValue == nil,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Contract != result[j].Contract {
+			return result[i].Contract < result[j].Contract
+		}
+		return bytes.Compare(result[i].Key, result[j].Key) < 0
+	})
+	return result
+}
+
+// splitContractStoreKey returns the contract address and the contract storage key for a full store key.
+// Contract addresses are not length prefixed so the supported lengths are tried against the known contracts.
+func (k Keeper) splitContractStoreKey(ctx sdk.Context, key []byte) (sdk.AccAddress, []byte) {
+	key = key[len(types.ContractStorePrefix):]
+	for _, n := range []int{types.ContractAddrLen, types.SDKAddrLen} {
+		if len(key) < n {
+			continue
+		}
+		if addr := sdk.AccAddress(key[:n]); k.HasContractInfo(ctx, addr) {
+			return addr, key[n:]
+		}
+	}
+	return nil, nil
+}
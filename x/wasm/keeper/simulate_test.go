@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSimulateExecute(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectCapabilities)
+	k := keepers.WasmKeeper
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+
+	codeID, _, err := keepers.ContractKeeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, nil, []byte("{}"), "reflect", nil)
+	require.NoError(t, err)
+	// the reflect contract stores its state with a length prefixed key
+	configKey := append([]byte{0, 6}, "config"...)
+	configBefore := k.QueryRaw(ctx, contractAddr, configKey)
+	require.NotEmpty(t, configBefore)
+
+	newOwner := RandomAccountAddress(t)
+	changeOwnerMsg, err := json.Marshal(testdata.ReflectHandleMsg{ChangeOwner: &testdata.OwnerPayload{Owner: newOwner}})
+	require.NoError(t, err)
+	// more than the creator owns
+	funds := sdk.NewCoins(sdk.NewInt64Coin("denom", 200000), sdk.NewInt64Coin("other", 1))
+
+	specs := map[string]struct {
+		sender       sdk.AccAddress
+		funds        sdk.Coins
+		disabled     bool
+		expErr       bool
+		expChangeLen int
+	}{
+		"owner without funds": {
+			sender:       creator,
+			expChangeLen: 1,
+		},
+		"owner with funds": {
+			sender:       creator,
+			funds:        funds,
+			expChangeLen: 1,
+		},
+		"execution fails": {
+			sender: RandomAccountAddress(t),
+			expErr: true,
+		},
+		"disabled": {
+			sender:   creator,
+			disabled: true,
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			em := sdk.NewEventManager()
+			k := k
+			k.simulateExecuteDisabled = spec.disabled
+
+			// when
+			got, gotErr := k.simulateExecute(ctx.WithEventManager(em), contractAddr, spec.sender, changeOwnerMsg, spec.funds)
+
+			// then state changes and events are discarded
+			assert.Empty(t, em.Events())
+			assert.Equal(t, configBefore, k.QueryRaw(ctx, contractAddr, configKey))
+			assert.Equal(t, deposit, keepers.BankKeeper.GetAllBalances(ctx, creator))
+			assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, contractAddr).IsZero())
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.NotZero(t, got.GasUsed)
+			assert.NotEmpty(t, got.Events)
+			require.Len(t, got.StateChanges, spec.expChangeLen)
+			change := got.StateChanges[0]
+			assert.Equal(t, contractAddr.String(), change.Contract)
+			assert.Equal(t, configKey, []byte(change.Key))
+			assert.Equal(t, configBefore, change.OldValue)
+			assert.Contains(t, string(change.NewValue), newOwner.String())
+			assert.False(t, change.Deleted)
+		})
+	}
+}
+
+func TestContractStateChanges(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	otherExample := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	contractStore := func(ctx sdk.Context, addr sdk.AccAddress) prefix.Store {
+		return prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractStorePrefix(addr))
+	}
+	contractStore(ctx, example.Contract).Set([]byte("existing"), []byte("old"))
+	contractStore(ctx, example.Contract).Set([]byte("unchanged"), []byte("same"))
+
+	recorder := &storeKeyRecorder{keys: make(map[string]struct{})}
+	cacheCtx := ctx.WithMultiStore(recordingMultiStore{cacheMultiStore: ctx.MultiStore().CacheMultiStore(), recorder: recorder})
+	contractStore(cacheCtx, example.Contract).Set([]byte("new"), []byte("value"))
+	contractStore(cacheCtx, example.Contract).Delete([]byte("existing"))
+	contractStore(cacheCtx, example.Contract).Set([]byte("unchanged"), []byte("same"))
+	// writes in a nested cache that is discarded are recorded but dropped from the result
+	nestedCtx, _ := cacheCtx.CacheContext()
+	contractStore(nestedCtx, otherExample.Contract).Set([]byte("discarded"), []byte("value"))
+	// writes in a nested cache that is committed are included
+	nestedCtx, commit := cacheCtx.CacheContext()
+	contractStore(nestedCtx, otherExample.Contract).Set([]byte("committed"), []byte("value"))
+	commit()
+	// non contract storage keys are ignored
+	require.NoError(t, k.storeService.OpenKVStore(cacheCtx).Set(types.GetContractAddressKey(RandomAccountAddress(t)), []byte("value")))
+
+	// when
+	got := k.contractStateChanges(ctx, cacheCtx, recorder)
+
+	// then
+	exp := []types.ContractStateChange{
+		{Contract: example.Contract.String(), Key: []byte("existing"), OldValue: []byte("old"), Deleted: true},
+		{Contract: example.Contract.String(), Key: []byte("new"), NewValue: []byte("value")},
+		{Contract: otherExample.Contract.String(), Key: []byte("committed"), NewValue: []byte("value")},
+	}
+	if otherExample.Contract.String() < example.Contract.String() {
+		exp = append(exp[2:], exp[:2]...)
+	}
+	assert.Equal(t, exp, got)
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// storageDepositPayer returns the payer of the storage deposits for a contract call and the context to dispatch its
+// sub-messages with. The payer of the outermost call is kept so that the tx sender pays for all contracts called
+// and not the calling contract. Calls without a tx sender, like sudo or IBC, pass an empty payer.
+func storageDepositPayer(ctx sdk.Context, caller sdk.AccAddress) (sdk.Context, sdk.AccAddress) {
+	if payer, ok := types.StorageDepositPayer(ctx); ok {
+		return ctx, payer
+	}
+	return types.WithStorageDepositPayer(ctx, caller), caller
+}
+
+// settleStorageDeposit adjusts the deposit held in escrow for the contract to the size of its storage.
+// A missing deposit is charged from the payer, or from the contract balance when there is no payer.
+// An excess deposit is refunded to the contract. The payer is optional.
+// It is called at the end of instantiate, execute, migrate and sudo, so that storage written by replies is
+// included. Writes from IBC entry points are settled with the next of these calls.
+func (k Keeper) settleStorageDeposit(ctx sdk.Context, contractAddr, payer sdk.AccAddress) error {
+	freeCtx := withoutGasCharge(ctx)
+	params := k.GetParams(freeCtx)
+	held := k.GetStorageDeposit(freeCtx, contractAddr)
+	if !params.StorageDepositEnabled() && held == nil {
+		return nil
+	}
+
+	required := sdk.Coin{Denom: params.StorageDepositDenom, Amount: sdkmath.ZeroInt()}
+	if params.StorageDepositEnabled() {
+		bytes := k.GetContractStorageUsage(freeCtx, contractAddr).Bytes
+		required.Amount = sdkmath.NewIntFromUint64(bytes).Mul(sdkmath.NewIntFromUint64(params.StorageDepositPerByte))
+	}
+	current := sdk.Coin{Denom: required.Denom, Amount: sdkmath.ZeroInt()}
+	switch {
+	case held == nil:
+	case held.Denom == required.Denom:
+		current = *held
+	default:
+		// the deposit denom was changed by governance
+		if err := k.refundStorageDeposit(ctx, contractAddr, *held); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case required.Amount.GT(current.Amount):
+		if err := k.chargeStorageDeposit(ctx, contractAddr, payer, required.Sub(current)); err != nil {
+			return err
+		}
+	case required.Amount.LT(current.Amount):
+		if err := k.refundStorageDeposit(ctx, contractAddr, current.Sub(required)); err != nil {
+			return err
+		}
+	case held == nil || held.Denom == required.Denom:
+		// nothing changed
+		return nil
+	}
+	return k.setStorageDeposit(ctx, contractAddr, required)
+}
+
+// chargeStorageDeposit moves the amount from the payer, or the contract when there is no payer, into escrow
+func (k Keeper) chargeStorageDeposit(ctx sdk.Context, contractAddr, payer sdk.AccAddress, amount sdk.Coin) error {
+	if len(payer) == 0 || payer.String() == k.authority {
+		// the governance account never pays storage deposits
+		payer = contractAddr
+	}
+	if err := k.bank.TransferCoins(ctx, payer, types.StorageDepositEscrowAddress, sdk.NewCoins(amount)); err != nil {
+		return errorsmod.Wrapf(types.ErrInsufficientStorageDeposit, "%s can not pay %s: %s", payer, amount, err)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeStorageDeposit,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDepositPayer, payer.String()),
+		sdk.NewAttribute(types.AttributeKeyDepositAmount, amount.String()),
+	))
+	return nil
+}
+
+// refundStorageDeposit moves the amount from escrow to the contract
+func (k Keeper) refundStorageDeposit(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.bank.TransferCoins(ctx, types.StorageDepositEscrowAddress, contractAddr, sdk.NewCoins(amount)); err != nil {
+		return errorsmod.Wrap(err, "refund storage deposit")
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeStorageRefund,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDepositAmount, amount.String()),
+	))
+	return nil
+}
+
+// setStorageDeposit stores the deposit held for the contract. A zero amount removes the entry.
+func (k Keeper) setStorageDeposit(ctx context.Context, contractAddr sdk.AccAddress, amount sdk.Coin) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if amount.IsNil() || amount.IsZero() {
+		return store.Delete(types.GetStorageDepositKey(contractAddr))
+	}
+	return store.Set(types.GetStorageDepositKey(contractAddr), k.cdc.MustMarshal(&amount))
+}
+
+// GetStorageDeposit returns the deposit held in escrow for the storage of the contract or nil when none
+func (k Keeper) GetStorageDeposit(ctx context.Context, contractAddr sdk.AccAddress) *sdk.Coin {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetStorageDepositKey(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var amount sdk.Coin
+	k.cdc.MustUnmarshal(bz, &amount)
+	return &amount
+}
+
+// IterateStorageDeposits iterates over all storage deposits ordered by contract address.
+// The callback method can return true to abort early.
+func (k Keeper) IterateStorageDeposits(ctx context.Context, cb func(types.StorageDeposit) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.StorageDepositPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var amount sdk.Coin
+		k.cdc.MustUnmarshal(iter.Value(), &amount)
+		deposit := types.StorageDeposit{
+			Contract: sdk.AccAddress(iter.Key()).String(),
+			Amount:   amount,
+		}
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+func (k Keeper) importStorageDeposit(ctx context.Context, deposit types.StorageDeposit) error {
+	if err := deposit.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(deposit.Contract)
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return types.ErrNoSuchContractFn(deposit.Contract).Wrapf("address %s", deposit.Contract)
+	}
+	return k.setStorageDeposit(ctx, contractAddr, deposit.Amount)
+}
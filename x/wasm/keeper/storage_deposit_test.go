@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v3"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSettleStorageDeposit(t *testing.T) {
+	specs := map[string]struct {
+		perByte         uint64
+		denom           string
+		held            *sdk.Coin
+		contractFunds   sdk.Coins
+		payerFunds      sdk.Coins
+		noPayer         bool
+		govPayer        bool
+		shrink          bool
+		expErr          error
+		expDeposit      *sdk.Coin
+		expContractBal  sdk.Coins
+		expPayerBal     sdk.Coins
+		expEscrowBal    sdk.Coins
+		expNoEventTypes bool
+	}{
+		"disabled": {
+			contractFunds:   sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			expContractBal:  sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			expNoEventTypes: true,
+		},
+		"charged from payer": {
+			perByte:        2,
+			denom:          "denom",
+			contractFunds:  sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			payerFunds:     sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			expDeposit:     coinP(sdk.NewInt64Coin("denom", 20)),
+			expContractBal: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			expPayerBal:    sdk.NewCoins(sdk.NewInt64Coin("denom", 80)),
+			expEscrowBal:   sdk.NewCoins(sdk.NewInt64Coin("denom", 20)),
+		},
+		"charged from contract without payer": {
+			perByte:        2,
+			denom:          "denom",
+			contractFunds:  sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			noPayer:        true,
+			expDeposit:     coinP(sdk.NewInt64Coin("denom", 20)),
+			expContractBal: sdk.NewCoins(sdk.NewInt64Coin("denom", 80)),
+			expEscrowBal:   sdk.NewCoins(sdk.NewInt64Coin("denom", 20)),
+		},
+		"charged from contract for governance": {
+			perByte:        2,
+			denom:          "denom",
+			contractFunds:  sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			govPayer:       true,
+			payerFunds:     sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			expDeposit:     coinP(sdk.NewInt64Coin("denom", 20)),
+			expContractBal: sdk.NewCoins(sdk.NewInt64Coin("denom", 80)),
+			expEscrowBal:   sdk.NewCoins(sdk.NewInt64Coin("denom", 20)),
+		},
+		"only the missing amount is charged": {
+			perByte:        2,
+			denom:          "denom",
+			held:           coinP(sdk.NewInt64Coin("denom", 15)),
+			contractFunds:  sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			noPayer:        true,
+			expDeposit:     coinP(sdk.NewInt64Coin("denom", 20)),
+			expContractBal: sdk.NewCoins(sdk.NewInt64Coin("denom", 95)),
+			expEscrowBal:   sdk.NewCoins(sdk.NewInt64Coin("denom", 20)),
+		},
+		"unchanged": {
+			perByte:         2,
+			denom:           "denom",
+			held:            coinP(sdk.NewInt64Coin("denom", 20)),
+			expDeposit:      coinP(sdk.NewInt64Coin("denom", 20)),
+			expEscrowBal:    sdk.NewCoins(sdk.NewInt64Coin("denom", 20)),
+			expNoEventTypes: true,
+		},
+		"refunded on shrink": {
+			perByte:        2,
+			denom:          "denom",
+			held:           coinP(sdk.NewInt64Coin("denom", 20)),
+			shrink:         true,
+			expDeposit:     coinP(sdk.NewInt64Coin("denom", 8)),
+			expContractBal: sdk.NewCoins(sdk.NewInt64Coin("denom", 12)),
+			expEscrowBal:   sdk.NewCoins(sdk.NewInt64Coin("denom", 8)),
+		},
+		"refunded when disabled": {
+			held:           coinP(sdk.NewInt64Coin("denom", 20)),
+			expContractBal: sdk.NewCoins(sdk.NewInt64Coin("denom", 20)),
+		},
+		"refunded and charged on denom change": {
+			perByte:        1,
+			denom:          "other",
+			held:           coinP(sdk.NewInt64Coin("denom", 20)),
+			contractFunds:  sdk.NewCoins(sdk.NewInt64Coin("other", 100)),
+			noPayer:        true,
+			expDeposit:     coinP(sdk.NewInt64Coin("other", 10)),
+			expContractBal: sdk.NewCoins(sdk.NewInt64Coin("denom", 20), sdk.NewInt64Coin("other", 90)),
+			expEscrowBal:   sdk.NewCoins(sdk.NewInt64Coin("other", 10)),
+		},
+		"payer can not pay": {
+			perByte:       2,
+			denom:         "denom",
+			contractFunds: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+			payerFunds:    sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			expErr:        types.ErrInsufficientStorageDeposit,
+		},
+		"contract can not pay without payer": {
+			perByte: 2,
+			denom:   "denom",
+			noPayer: true,
+			expErr:  types.ErrInsufficientStorageDeposit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			ctx := parentCtx.WithEventManager(sdk.NewEventManager())
+			k := keepers.WasmKeeper
+			bank := keepers.BankKeeper
+			contractAddr, payer := RandomAccountAddress(t), RandomAccountAddress(t)
+			if spec.govPayer {
+				payer = sdk.MustAccAddressFromBech32(k.GetAuthority())
+			}
+			if spec.noPayer {
+				payer = nil
+			}
+
+			params := types.DefaultParams()
+			params.StorageDepositDenom = spec.denom
+			params.StorageDepositPerByte = spec.perByte
+			require.NoError(t, k.SetParams(ctx, params))
+
+			// 10 bytes stored
+			store := k.contractStore(ctx, contractAddr)
+			store.Set([]byte("foo"), []byte("bar"))
+			store.Set([]byte("a"), []byte("bcd"))
+			if spec.shrink {
+				store.Delete([]byte("foo"))
+			}
+			if spec.held != nil {
+				keepers.Faucet.Fund(ctx, types.StorageDepositEscrowAddress, *spec.held)
+				require.NoError(t, k.setStorageDeposit(ctx, contractAddr, *spec.held))
+			}
+			if !spec.contractFunds.IsZero() {
+				keepers.Faucet.Fund(ctx, contractAddr, spec.contractFunds...)
+			}
+			if !spec.payerFunds.IsZero() {
+				keepers.Faucet.Fund(ctx, payer, spec.payerFunds...)
+			}
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := k.settleStorageDeposit(ctx.WithEventManager(em), contractAddr, payer)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expDeposit, k.GetStorageDeposit(ctx, contractAddr))
+			assert.Equal(t, spec.expContractBal.String(), bank.GetAllBalances(ctx, contractAddr).String())
+			if len(payer) != 0 && !spec.govPayer {
+				assert.Equal(t, spec.expPayerBal.String(), bank.GetAllBalances(ctx, payer).String())
+			}
+			assert.Equal(t, spec.expEscrowBal.String(), bank.GetAllBalances(ctx, types.StorageDepositEscrowAddress).String())
+			assert.Equal(t, spec.expNoEventTypes, len(em.Events()) == 0)
+		})
+	}
+}
+
+func TestStorageDepositOnInstantiateAndExecute(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := k.GetParams(ctx)
+	params.StorageDepositDenom = "denom"
+	params.StorageDepositPerByte = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	usage := k.GetContractStorageUsage(ctx, example.Contract)
+	require.NotZero(t, usage.Bytes)
+	exp := sdk.NewInt64Coin("denom", int64(usage.Bytes))
+	assert.Equal(t, &exp, k.GetStorageDeposit(ctx, example.Contract))
+	assert.Equal(t, exp, keepers.BankKeeper.GetBalance(ctx, types.StorageDepositEscrowAddress, "denom"))
+
+	// when storage is unchanged by execute
+	_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+	// then
+	assert.Equal(t, &exp, k.GetStorageDeposit(ctx, example.Contract))
+}
+
+func TestStorageDepositOfSubMessagePaidByTxSender(t *testing.T) {
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+	k := keepers.WasmKeeper
+	caller := SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	callee := SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	mock.ExecuteFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		if env.Contract.Address == callee.String() {
+			store.Set([]byte("foo"), []byte("bar"))
+			return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 1, nil
+		}
+		subMsg := wasmvmtypes.SubMsg{
+			ReplyOn: wasmvmtypes.ReplyNever,
+			Msg:     wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{ContractAddr: callee.String(), Msg: []byte(`{}`)}}},
+		}
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Messages: []wasmvmtypes.SubMsg{subMsg}}}, 1, nil
+	}
+	params := k.GetParams(ctx)
+	params.StorageDepositDenom = "denom"
+	params.StorageDepositPerByte = 1
+	require.NoError(t, k.SetParams(ctx, params))
+	sender := RandomAccountAddress(t)
+	keepers.Faucet.Fund(ctx, sender, sdk.NewInt64Coin("denom", 100))
+	keepers.Faucet.Fund(ctx, caller, sdk.NewInt64Coin("denom", 100))
+
+	// when
+	_, err := keepers.ContractKeeper.Execute(ctx, caller, sender, []byte(`{}`), nil)
+
+	// then
+	require.NoError(t, err)
+	exp := sdk.NewInt64Coin("denom", 6)
+	assert.Equal(t, &exp, k.GetStorageDeposit(ctx, callee))
+	assert.Equal(t, sdk.NewInt64Coin("denom", 94), keepers.BankKeeper.GetBalance(ctx, sender, "denom"))
+	assert.Equal(t, sdk.NewInt64Coin("denom", 100), keepers.BankKeeper.GetBalance(ctx, caller, "denom"))
+}
+
+func coinP(c sdk.Coin) *sdk.Coin {
+	return &c
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// storageUsageStore is the prefixed store of a contract that keeps the storage usage counters of the contract
+// in sync on every write and delete. The bookkeeping is charged to the caller like the write itself.
+type storageUsageStore struct {
+	storetypes.KVStore
+	k            Keeper
+	ctx          sdk.Context
+	contractAddr sdk.AccAddress
+}
+
+// contractStore returns the prefixed store of the contract. All writes through this store are accounted in the
+// contract storage usage.
+func (k Keeper) contractStore(ctx context.Context, contractAddr sdk.AccAddress) storetypes.KVStore {
+	prefixStoreKey := types.GetContractStorePrefix(contractAddr)
+	return storageUsageStore{
+		KVStore:      prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), prefixStoreKey),
+		k:            k,
+		ctx:          sdk.UnwrapSDKContext(ctx),
+		contractAddr: contractAddr,
+	}
+}
+
+func (s storageUsageStore) Set(key, value []byte) {
+	bytesDelta, keysDelta := int64(len(key)+len(value)), int64(1)
+	if old := s.KVStore.Get(key); old != nil {
+		bytesDelta -= int64(len(key) + len(old))
+		keysDelta = 0
+	}
+	s.KVStore.Set(key, value)
+	s.k.addContractStorageUsage(s.ctx, s.contractAddr, bytesDelta, keysDelta)
+}
+
+func (s storageUsageStore) Delete(key []byte) {
+	old := s.KVStore.Get(key)
+	s.KVStore.Delete(key)
+	if old != nil {
+		s.k.addContractStorageUsage(s.ctx, s.contractAddr, -int64(len(key)+len(old)), -1)
+	}
+}
+
+// addContractStorageUsage applies the deltas to the storage usage counters of the contract
+func (k Keeper) addContractStorageUsage(ctx context.Context, contractAddr sdk.AccAddress, bytesDelta, keysDelta int64) {
+	if bytesDelta == 0 && keysDelta == 0 {
+		return
+	}
+	usage := k.GetContractStorageUsage(ctx, contractAddr)
+	usage.Bytes = uint64(int64(usage.Bytes) + bytesDelta)
+	usage.Keys = uint64(int64(usage.Keys) + keysDelta)
+	if err := k.setContractStorageUsage(ctx, contractAddr, usage); err != nil {
+		panic(err)
+	}
+}
+
+// setContractStorageUsage stores the storage usage counters of the contract and updates the size index.
+// Empty counters are removed from the store.
+func (k Keeper) setContractStorageUsage(ctx context.Context, contractAddr sdk.AccAddress, usage types.ContractStorageUsage) error {
+	store := k.storeService.OpenKVStore(ctx)
+	old := k.GetContractStorageUsage(ctx, contractAddr)
+	if old.Keys != 0 || old.Bytes != 0 {
+		if err := store.Delete(types.GetContractByStorageSizeSecondaryIndexKey(old.Bytes, contractAddr)); err != nil {
+			return err
+		}
+	}
+	if usage.Keys == 0 && usage.Bytes == 0 {
+		return store.Delete(types.GetContractStorageUsageKey(contractAddr))
+	}
+	if err := store.Set(types.GetContractStorageUsageKey(contractAddr), k.cdc.MustMarshal(&usage)); err != nil {
+		return err
+	}
+	return store.Set(types.GetContractByStorageSizeSecondaryIndexKey(usage.Bytes, contractAddr), []byte{})
+}
+
+// GetContractStorageUsage returns the total bytes and number of keys stored by the contract
+func (k Keeper) GetContractStorageUsage(ctx context.Context, contractAddr sdk.AccAddress) types.ContractStorageUsage {
+	var usage types.ContractStorageUsage
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetContractStorageUsageKey(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &usage)
+	}
+	return usage
+}
+
+// IterateContractsByStorageSize iterates over all contracts with stored data ordered by storage bytes, largest first.
+// The callback method can return true to abort early.
+func (k Keeper) IterateContractsByStorageSize(ctx context.Context, cb func(address sdk.AccAddress, usage types.ContractStorageUsage) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ContractsByStorageSizePrefix)
+	iter := prefixStore.ReverseIterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		contractAddr := sdk.AccAddress(iter.Key()[8:])
+		if cb(contractAddr, k.GetContractStorageUsage(ctx, contractAddr)) {
+			return
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestContractStorageUsage(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	contractAddr := RandomAccountAddress(t)
+	store := k.contractStore(ctx, contractAddr)
+
+	indexed := func() map[string]uint64 {
+		r := make(map[string]uint64)
+		k.IterateContractsByStorageSize(ctx, func(addr sdk.AccAddress, usage types.ContractStorageUsage) bool {
+			r[addr.String()] = usage.Bytes
+			return false
+		})
+		return r
+	}
+
+	// when new keys are set
+	store.Set([]byte("foo"), []byte("bar"))
+	store.Set([]byte("a"), []byte{})
+	// then
+	assert.Equal(t, types.ContractStorageUsage{Bytes: 7, Keys: 2}, k.GetContractStorageUsage(ctx, contractAddr))
+	assert.Equal(t, map[string]uint64{contractAddr.String(): 7}, indexed())
+
+	// when an existing value is replaced
+	store.Set([]byte("foo"), []byte("b"))
+	// then
+	assert.Equal(t, types.ContractStorageUsage{Bytes: 5, Keys: 2}, k.GetContractStorageUsage(ctx, contractAddr))
+	assert.Equal(t, map[string]uint64{contractAddr.String(): 5}, indexed())
+
+	// when an unknown key is deleted
+	store.Delete([]byte("unknown"))
+	// then
+	assert.Equal(t, types.ContractStorageUsage{Bytes: 5, Keys: 2}, k.GetContractStorageUsage(ctx, contractAddr))
+
+	// when all keys are deleted
+	store.Delete([]byte("foo"))
+	store.Delete([]byte("a"))
+	// then
+	assert.Equal(t, types.ContractStorageUsage{}, k.GetContractStorageUsage(ctx, contractAddr))
+	assert.Empty(t, indexed())
+}
+
+func TestContractStorageUsageCharged(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	measure := func(f func()) uint64 {
+		gasBefore := ctx.GasMeter().GasConsumed()
+		f()
+		return ctx.GasMeter().GasConsumed() - gasBefore
+	}
+	// costs of the single operations on fresh contracts
+	prefixStore := k.contractStore(ctx, RandomAccountAddress(t)).(storageUsageStore).KVStore
+	readGas := measure(func() { prefixStore.Get([]byte("foo")) })
+	writeGas := measure(func() { prefixStore.Set([]byte("foo"), []byte("bar")) })
+	bookkeepingGas := measure(func() { k.addContractStorageUsage(ctx, RandomAccountAddress(t), 6, 1) })
+	require.NotZero(t, bookkeepingGas)
+
+	// when
+	gotGas := measure(func() { k.contractStore(ctx, RandomAccountAddress(t)).Set([]byte("foo"), []byte("bar")) })
+
+	// then the old value lookup and the bookkeeping are charged with the write
+	assert.Equal(t, readGas+writeGas+bookkeepingGas, gotGas)
+}
+
+func TestContractStorageUsageOnInstantiate(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	var exp types.ContractStorageUsage
+	keepers.WasmKeeper.IterateContractState(ctx, example.Contract, func(key, value []byte) bool {
+		exp.Bytes += uint64(len(key) + len(value))
+		exp.Keys++
+		return false
+	})
+	require.NotZero(t, exp.Keys)
+	assert.Equal(t, exp, keepers.WasmKeeper.GetContractStorageUsage(ctx, example.Contract))
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// call types recorded in a trace
+const (
+	traceTypeExecute     = "execute"
+	traceTypeInstantiate = "instantiate"
+	traceTypeMigrate     = "migrate"
+	traceTypeSudo        = "sudo"
+	traceTypeReply       = "reply"
+	traceTypeSubmessage  = "submessage"
+	traceTypeQuery       = "query"
+)
+
+type callTracerContextKey struct{}
+
+// callTracer records the tree of contract calls made during an execution.
+// It is not safe for concurrent use.
+type callTracer struct {
+	root  *types.TraceNode
+	stack []*types.TraceNode
+}
+
+// withCallTracer returns a new context that records all contract calls into the given tracer
+func withCallTracer(ctx sdk.Context, t *callTracer) sdk.Context {
+	return ctx.WithValue(callTracerContextKey{}, t)
+}
+
+// traceSpan is a single open node in the call tree. A nil span is a no-op so that
+// callers do not need to check if tracing is enabled.
+type traceSpan struct {
+	tracer      *callTracer
+	node        *types.TraceNode
+	depth       int
+	gasMeter    storetypes.GasMeter
+	gasStart    storetypes.Gas
+	em          sdk.EventManagerI
+	eventsStart int
+}
+
+// startTraceSpan opens a new node as child of the current node when a tracer is set in the context.
+// Returns nil otherwise. The msg is stored as is when it is a byte slice or json encoded otherwise.
+func startTraceSpan(ctx sdk.Context, nodeType string, contractAddr sdk.AccAddress, msg any) *traceSpan {
+	if ctx.Context() == nil {
+		return nil
+	}
+	t, ok := ctx.Value(callTracerContextKey{}).(*callTracer)
+	if !ok || t == nil {
+		return nil
+	}
+	node := &types.TraceNode{Type: nodeType}
+	switch m := msg.(type) {
+	case []byte:
+		node.Msg = m
+	default:
+		// best effort, the message is informational only
+		node.Msg, _ = json.Marshal(m)
+	}
+	if contractAddr != nil {
+		node.Contract = contractAddr.String()
+	}
+	if n := len(t.stack); n != 0 {
+		parent := t.stack[n-1]
+		parent.Children = append(parent.Children, node)
+	} else if t.root == nil {
+		t.root = node
+	}
+	t.stack = append(t.stack, node)
+	return &traceSpan{
+		tracer:      t,
+		node:        node,
+		depth:       len(t.stack) - 1,
+		gasMeter:    ctx.GasMeter(),
+		gasStart:    ctx.GasMeter().GasConsumed(),
+		em:          ctx.EventManager(),
+		eventsStart: len(ctx.EventManager().Events()),
+	}
+}
+
+// setContract sets the contract address when it is not known on span start
+func (s *traceSpan) setContract(contractAddr sdk.AccAddress) {
+	if s == nil {
+		return
+	}
+	s.node.Contract = contractAddr.String()
+}
+
+// setID sets the submessage id
+func (s *traceSpan) setID(id uint64) {
+	if s == nil {
+		return
+	}
+	s.node.ID = id
+}
+
+// end closes the node and all nested nodes that were not closed due to an abort.
+// Gas and events are recorded from the context that the span was started with.
+func (s *traceSpan) end(err error, extraEvents ...sdk.Event) {
+	if s == nil {
+		return
+	}
+	s.node.GasUsed = s.gasMeter.GasConsumed() - s.gasStart
+	var events sdk.Events
+	if all := s.em.Events(); len(all) > s.eventsStart {
+		events = append(events, all[s.eventsStart:]...)
+	}
+	events = append(events, extraEvents...)
+	s.node.Events = sdk.StringifyEvents(events.ToABCIEvents())
+	if err != nil {
+		s.node.Error = err.Error()
+	}
+	if len(s.tracer.stack) > s.depth {
+		s.tracer.stack = s.tracer.stack[:s.depth]
+	}
+}
+
+// traceExecute runs the contract execution in a cached context and returns the call tree. State changes are
+// always discarded. A failed execution is reported in the trace nodes and not returned as error.
+func (k Keeper) traceExecute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*types.TraceNode, []byte) {
+	tracer := &callTracer{}
+	cacheCtx, _ := ctx.CacheContext()
+	data, _ := k.execute(withCallTracer(cacheCtx, tracer), contractAddress, caller, msg, coins)
+	return tracer.root, data
+}
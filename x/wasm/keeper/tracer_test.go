@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestTraceExecute(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectCapabilities)
+	k := keepers.WasmKeeper
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+
+	reflectID, _, err := keepers.ContractKeeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	reflectAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, reflectID, creator, nil, []byte("{}"), "reflect", nil)
+	require.NoError(t, err)
+
+	hackatomID, _, err := keepers.ContractKeeper.Create(ctx, creator, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	// reflect contract is the verifier and can release funds
+	initMsgBz := HackatomExampleInitMsg{Verifier: reflectAddr, Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+	hackatomAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, hackatomID, creator, nil, initMsgBz, "hackatom", deposit)
+	require.NoError(t, err)
+	// other hackatom contract that rejects the release
+	initMsgBz = HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+	otherHackatomAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, hackatomID, creator, nil, initMsgBz, "other hackatom", nil)
+	require.NoError(t, err)
+
+	reflectExecMsg := func(target sdk.AccAddress) []byte {
+		msg := testdata.ReflectHandleMsg{
+			ReflectSubMsg: &testdata.ReflectSubPayload{
+				Msgs: []wasmvmtypes.SubMsg{{
+					ID: 7,
+					Msg: wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{
+						Execute: &wasmvmtypes.ExecuteMsg{ContractAddr: target.String(), Msg: []byte(`{"release":{}}`), Funds: []wasmvmtypes.Coin{}},
+					}},
+					ReplyOn: wasmvmtypes.ReplyAlways,
+				}},
+			},
+		}
+		bz, err := json.Marshal(msg)
+		require.NoError(t, err)
+		return bz
+	}
+
+	specs := map[string]struct {
+		msg            []byte
+		expSubMsgError bool
+	}{
+		"submessage succeeds": {
+			msg: reflectExecMsg(hackatomAddr),
+		},
+		"submessage fails": {
+			msg:            reflectExecMsg(otherHackatomAddr),
+			expSubMsgError: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			em := sdk.NewEventManager()
+			gasBefore := ctx.GasMeter().GasConsumed()
+
+			// when
+			root, _ := k.traceExecute(ctx.WithEventManager(em), reflectAddr, creator, spec.msg, nil)
+
+			// then state changes and events are discarded
+			assert.Empty(t, em.Events())
+			assert.Equal(t, deposit, keepers.BankKeeper.GetAllBalances(ctx, hackatomAddr))
+			assert.Greater(t, ctx.GasMeter().GasConsumed(), gasBefore)
+
+			// and the call tree is recorded
+			require.NotNil(t, root)
+			assert.Equal(t, "execute", root.Type)
+			assert.Equal(t, reflectAddr.String(), root.Contract)
+			assert.Equal(t, types.RawContractMessage(spec.msg), root.Msg)
+			assert.Empty(t, root.Error)
+			assert.NotZero(t, root.GasUsed)
+			assert.NotEmpty(t, root.Events)
+
+			require.Len(t, root.Children, 2)
+			subMsg, reply := root.Children[0], root.Children[1]
+			assert.Equal(t, "submessage", subMsg.Type)
+			assert.Equal(t, uint64(7), subMsg.ID)
+			assert.Equal(t, reflectAddr.String(), subMsg.Contract)
+			assert.NotZero(t, subMsg.GasUsed)
+			assert.Less(t, subMsg.GasUsed, root.GasUsed)
+
+			require.Len(t, subMsg.Children, 1)
+			nested := subMsg.Children[0]
+			assert.Equal(t, "execute", nested.Type)
+			assert.Equal(t, `{"release":{}}`, string(nested.Msg))
+			if spec.expSubMsgError {
+				assert.Equal(t, otherHackatomAddr.String(), nested.Contract)
+				assert.NotEmpty(t, subMsg.Error)
+				assert.NotEmpty(t, nested.Error)
+				assert.Empty(t, nested.Children)
+			} else {
+				assert.Equal(t, hackatomAddr.String(), nested.Contract)
+				assert.Empty(t, subMsg.Error)
+				assert.Empty(t, nested.Error)
+				assert.NotEmpty(t, nested.Events)
+				// hackatom queries its balance and sends it to the beneficiary
+				require.Len(t, nested.Children, 2)
+				balanceQuery, bankSend := nested.Children[0], nested.Children[1]
+				assert.Equal(t, "query", balanceQuery.Type)
+				assert.Equal(t, hackatomAddr.String(), balanceQuery.Contract)
+				assert.NotZero(t, balanceQuery.GasUsed)
+				assert.Equal(t, "submessage", bankSend.Type)
+				assert.Equal(t, hackatomAddr.String(), bankSend.Contract)
+				assert.Contains(t, string(bankSend.Msg), `"send"`)
+				assert.NotEmpty(t, bankSend.Events)
+			}
+
+			assert.Equal(t, "reply", reply.Type)
+			assert.Equal(t, uint64(7), reply.ID)
+			assert.Equal(t, reflectAddr.String(), reply.Contract)
+			assert.Empty(t, reply.Error)
+		})
+	}
+}
+
+func TestTraceExecuteFailure(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	// when not called by the verifier
+	root, data := keepers.WasmKeeper.traceExecute(ctx, example.Contract, example.CreatorAddr, []byte(`{"release":{}}`), nil)
+
+	// then
+	assert.Nil(t, data)
+	require.NotNil(t, root)
+	assert.Equal(t, "execute", root.Type)
+	assert.Contains(t, root.Error, "Unauthorized")
+	assert.Empty(t, root.Children)
+}
+
+func TestTraceQuery(t *testing.T) {
+	ctx, _ := CreateTestInput(t, false, AvailableCapabilities)
+	caller := RandomAccountAddress(t)
+	myErr := errors.New("testing")
+	tracer := &callTracer{}
+	ctx = withCallTracer(ctx, tracer)
+	span := startTraceSpan(ctx, traceTypeExecute, caller, []byte(`{}`))
+
+	mock := WasmVMQueryHandlerFn(func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+		ctx.GasMeter().ConsumeGas(100, "testing")
+		return nil, myErr
+	})
+	q := NewQueryHandler(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), mock, caller, types.NewDefaultWasmGasRegister())
+	request := wasmvmtypes.QueryRequest{Bank: &wasmvmtypes.BankQuery{AllBalances: &wasmvmtypes.AllBalancesQuery{Address: caller.String()}}}
+
+	// when
+	_, err := q.Query(request, types.DefaultGasMultiplier*1_000)
+	require.Error(t, err)
+	span.end(nil)
+
+	// then
+	require.NotNil(t, tracer.root)
+	require.Len(t, tracer.root.Children, 1)
+	got := tracer.root.Children[0]
+	expMsg, err := json.Marshal(request)
+	require.NoError(t, err)
+	assert.Equal(t, "query", got.Type)
+	assert.Equal(t, caller.String(), got.Contract)
+	assert.Equal(t, types.RawContractMessage(expMsg), got.Msg)
+	assert.Equal(t, uint64(100), got.GasUsed)
+	assert.Equal(t, "testing", got.Error)
+	assert.Empty(t, tracer.stack)
+}
+
+func TestTraceSpanWithoutTracer(t *testing.T) {
+	ctx, _ := CreateTestInput(t, false, AvailableCapabilities)
+	span := startTraceSpan(ctx, traceTypeExecute, RandomAccountAddress(t), []byte(`{}`))
+	assert.Nil(t, span)
+	// nil spans are no-ops
+	span.setID(1)
+	span.setContract(RandomAccountAddress(t))
+	span.end(errors.New("testing"))
+}
@@ -11,6 +11,7 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -156,3 +157,14 @@ func (m *IBCContractKeeperMock) DeleteAsyncAckPacket(ctx context.Context, portID
 	key := portID + fmt.Sprint(len(channelID)) + channelID
 	delete(m.packets, key)
 }
+
+type MockEpochsKeeper struct {
+	GetEpochInfoFn func(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, error)
+}
+
+func (m MockEpochsKeeper) GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, error) {
+	if m.GetEpochInfoFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetEpochInfoFn(ctx, identifier)
+}
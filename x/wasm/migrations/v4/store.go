@@ -0,0 +1,39 @@
+package v4
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// AddToAdminIndexFn creates a secondary index entry for the admin of the contract
+type AddToAdminIndexFn func(ctx context.Context, adminAddress, contractAddress sdk.AccAddress) error
+
+// wasmKeeper abstract keeper
+type wasmKeeper interface {
+	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, types.ContractInfo) bool)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper            wasmKeeper
+	addToAdminIndexFn AddToAdminIndexFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper, fn AddToAdminIndexFn) Migrator {
+	return Migrator{keeper: k, addToAdminIndexFn: fn}
+}
+
+// Migrate4to5 migrates from version 4 to 5. It backfills the contracts by admin
+// secondary index for all existing contracts with an admin.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	var err error
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, contractInfo types.ContractInfo) bool {
+		err = m.addToAdminIndexFn(ctx, contractInfo.AdminAddr(), contractAddr)
+		return err != nil
+	})
+	return err
+}
@@ -0,0 +1,53 @@
+package v4_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate4To5(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, []string{"iterator", "staking", "stargate", "cosmwasm_1_1"})
+	wasmKeeper := keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	admin := keeper.RandomAccountAddress(t)
+	example := keeper.StoreHackatomExampleContract(t, ctx, keepers)
+
+	initMsgBz, err := json.Marshal(keeper.HackatomExampleInitMsg{
+		Verifier:    keeper.RandomAccountAddress(t),
+		Beneficiary: keeper.RandomAccountAddress(t),
+	})
+	require.NoError(t, err)
+
+	contractAddr1, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, admin, initMsgBz, "demo contract 1", nil)
+	require.NoError(t, err)
+	contractAddr2, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, admin, initMsgBz, "demo contract 2", nil)
+	require.NoError(t, err)
+	_, _, err = keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, nil, initMsgBz, "demo contract 3", nil)
+	require.NoError(t, err)
+
+	// remove keys
+	ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetContractByAdminSecondaryIndexKey(admin, contractAddr1))
+	ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetContractByAdminSecondaryIndexKey(admin, contractAddr2))
+
+	// migrator
+	err = keeper.NewMigrator(*wasmKeeper, nil).Migrate4to5(ctx)
+	require.NoError(t, err)
+
+	// check new store
+	var allContracts []sdk.AccAddress
+	wasmKeeper.IterateContractsByAdmin(ctx, admin, func(addr sdk.AccAddress) bool {
+		allContracts = append(allContracts, addr)
+		return false
+	})
+	assert.ElementsMatch(t, []sdk.AccAddress{contractAddr1, contractAddr2}, allContracts)
+}
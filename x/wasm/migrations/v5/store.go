@@ -0,0 +1,39 @@
+package v5
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// AddToChecksumIndexFn creates a secondary index entry for the checksum of the code
+type AddToChecksumIndexFn func(ctx context.Context, checksum []byte, codeID uint64) error
+
+// wasmKeeper abstract keeper
+type wasmKeeper interface {
+	IterateCodeInfos(ctx context.Context, cb func(uint64, types.CodeInfo) bool)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper               wasmKeeper
+	addToChecksumIndexFn AddToChecksumIndexFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper, fn AddToChecksumIndexFn) Migrator {
+	return Migrator{keeper: k, addToChecksumIndexFn: fn}
+}
+
+// Migrate5to6 migrates from version 5 to 6. It backfills the codes by checksum
+// secondary index for all existing codes.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	var err error
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, codeInfo types.CodeInfo) bool {
+		err = m.addToChecksumIndexFn(ctx, codeInfo.CodeHash, codeID)
+		return err != nil
+	})
+	return err
+}
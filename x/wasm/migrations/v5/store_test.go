@@ -0,0 +1,44 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate5To6(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, []string{"iterator", "staking", "stargate", "cosmwasm_1_1", "cosmwasm_1_2", "cosmwasm_1_3", "cosmwasm_1_4", "cosmwasm_2_0"})
+	wasmKeeper := keepers.WasmKeeper
+
+	example1 := keeper.StoreHackatomExampleContract(t, ctx, keepers)
+	example2 := keeper.StoreHackatomExampleContract(t, ctx, keepers)
+	example3 := keeper.StoreReflectContract(t, ctx, keepers)
+
+	// remove keys
+	for _, e := range []keeper.ExampleContract{example1, example2, example3} {
+		ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetCodeByChecksumSecondaryIndexKey(e.Checksum, e.CodeID))
+	}
+
+	// migrator
+	err := keeper.NewMigrator(*wasmKeeper, nil).Migrate5to6(ctx)
+	require.NoError(t, err)
+
+	// check new store
+	var codeIDs []uint64
+	wasmKeeper.IterateCodesByChecksum(ctx, example1.Checksum, func(codeID uint64) bool {
+		codeIDs = append(codeIDs, codeID)
+		return false
+	})
+	assert.Equal(t, []uint64{example1.CodeID, example2.CodeID}, codeIDs)
+
+	codeIDs = nil
+	wasmKeeper.IterateCodesByChecksum(ctx, example3.Checksum, func(codeID uint64) bool {
+		codeIDs = append(codeIDs, codeID)
+		return false
+	})
+	assert.Equal(t, []uint64{example3.CodeID}, codeIDs)
+}
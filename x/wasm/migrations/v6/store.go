@@ -0,0 +1,46 @@
+package v6
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// SetStorageUsageFn stores the storage usage counters of a contract
+type SetStorageUsageFn func(ctx context.Context, contractAddr sdk.AccAddress, usage types.ContractStorageUsage) error
+
+// wasmKeeper abstract keeper
+type wasmKeeper interface {
+	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, types.ContractInfo) bool)
+	IterateContractState(ctx context.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper            wasmKeeper
+	setStorageUsageFn SetStorageUsageFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper, fn SetStorageUsageFn) Migrator {
+	return Migrator{keeper: k, setStorageUsageFn: fn}
+}
+
+// Migrate6to7 migrates from version 6 to 7. It backfills the storage usage
+// counters and the contracts by storage size index for all existing contracts.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	var err error
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, _ types.ContractInfo) bool {
+		var usage types.ContractStorageUsage
+		m.keeper.IterateContractState(ctx, contractAddr, func(key, value []byte) bool {
+			usage.Bytes += uint64(len(key) + len(value))
+			usage.Keys++
+			return false
+		})
+		err = m.setStorageUsageFn(ctx, contractAddr, usage)
+		return err != nil
+	})
+	return err
+}
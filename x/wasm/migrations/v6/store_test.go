@@ -0,0 +1,54 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate6To7(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, []string{"iterator", "staking", "stargate", "cosmwasm_1_1"})
+	wasmKeeper := keepers.WasmKeeper
+
+	contract1 := keeper.InstantiateHackatomExampleContract(t, ctx, keepers)
+	contract2 := keeper.InstantiateHackatomExampleContract(t, ctx, keepers)
+	contracts := []sdk.AccAddress{contract1.Contract, contract2.Contract}
+
+	expUsages := make([]types.ContractStorageUsage, len(contracts))
+	for i, addr := range contracts {
+		expUsages[i] = wasmKeeper.GetContractStorageUsage(ctx, addr)
+		require.NotZero(t, expUsages[i].Keys)
+		// remove keys
+		ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetContractStorageUsageKey(addr))
+		ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetContractByStorageSizeSecondaryIndexKey(expUsages[i].Bytes, addr))
+		require.Equal(t, types.ContractStorageUsage{}, wasmKeeper.GetContractStorageUsage(ctx, addr))
+	}
+
+	// migrator
+	err := keeper.NewMigrator(*wasmKeeper, nil).Migrate6to7(ctx)
+	require.NoError(t, err)
+
+	// check new store
+	for i, addr := range contracts {
+		var exp types.ContractStorageUsage
+		wasmKeeper.IterateContractState(ctx, addr, func(key, value []byte) bool {
+			exp.Bytes += uint64(len(key) + len(value))
+			exp.Keys++
+			return false
+		})
+		assert.Equal(t, exp, expUsages[i])
+		assert.Equal(t, exp, wasmKeeper.GetContractStorageUsage(ctx, addr))
+	}
+	var indexed []string
+	wasmKeeper.IterateContractsByStorageSize(ctx, func(addr sdk.AccAddress, _ types.ContractStorageUsage) bool {
+		indexed = append(indexed, addr.String())
+		return false
+	})
+	assert.ElementsMatch(t, []string{contract1.Contract.String(), contract2.Contract.String()}, indexed)
+}
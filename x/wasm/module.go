@@ -40,10 +40,11 @@ var (
 
 // Module init related flags
 const (
-	flagWasmMemoryCacheSize        = "wasm.memory_cache_size"
-	flagWasmQueryGasLimit          = "wasm.query_gas_limit"
-	flagWasmSimulationGasLimit     = "wasm.simulation_gas_limit"
-	flagWasmSkipWasmVMVersionCheck = "wasm.skip_wasmvm_version_check"
+	flagWasmMemoryCacheSize         = "wasm.memory_cache_size"
+	flagWasmQueryGasLimit           = "wasm.query_gas_limit"
+	flagWasmSimulationGasLimit      = "wasm.simulation_gas_limit"
+	flagWasmSkipWasmVMVersionCheck  = "wasm.skip_wasmvm_version_check"
+	flagWasmSimulateExecuteDisabled = "wasm.simulate_execute_disabled"
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -99,7 +100,10 @@ func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry)
 }
 
 // ____________________________________________________________________________
-var _ appmodule.AppModule = AppModule{}
+var (
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
+)
 
 // AppModule implements an application module for the wasm module.
 type AppModule struct {
@@ -148,7 +152,7 @@ func (am AppModule) IsAppModule() { // marker
 // module. It should be incremented on each consensus-breaking change
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 4 }
+func (AppModule) ConsensusVersion() uint64 { return 7 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
@@ -167,6 +171,23 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+	err = cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// EndBlock executes the wasm module logic at the end of every block.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(ctx)
 }
 
 // RegisterInvariants registers the wasm module invariants.
@@ -227,6 +248,7 @@ func AddModuleInitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Uint32(flagWasmMemoryCacheSize, defaults.MemoryCacheSize, "Sets the size in MiB (NOT bytes) of an in-memory cache for Wasm modules. Set to 0 to disable.")
 	startCmd.Flags().Uint64(flagWasmQueryGasLimit, defaults.SmartQueryGasLimit, "Set the max gas that can be spent on executing a query with a Wasm contract")
 	startCmd.Flags().String(flagWasmSimulationGasLimit, "", "Set the max gas that can be spent when executing a simulation TX")
+	startCmd.Flags().Bool(flagWasmSimulateExecuteDisabled, defaults.SimulateExecuteDisabled, "Disable the SimulateExecute query that runs contract executions from arbitrary senders")
 	startCmd.Flags().Bool(flagWasmSkipWasmVMVersionCheck, false, "Skip check that ensures that libwasmvm version (the Rust project) and wasmvm version (the Go project) match")
 
 	preCheck := func(cmd *cobra.Command, _ []string) error {
@@ -266,6 +288,11 @@ func ReadNodeConfig(opts servertypes.AppOptions) (types.NodeConfig, error) {
 			cfg.SimulationGasLimit = &limit
 		}
 	}
+	if v := opts.Get(flagWasmSimulateExecuteDisabled); v != nil {
+		if cfg.SimulateExecuteDisabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
 	// attach contract debugging to global "trace" flag
 	if v := opts.Get(server.FlagTrace); v != nil {
 		if cfg.ContractDebugMode, err = cast.ToBoolE(v); err != nil {
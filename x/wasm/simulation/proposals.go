@@ -23,6 +23,7 @@ const (
 	WeightUnpinCodesProposal                  = "weight_unpin_codes_proposal"
 	WeightUpdateInstantiateConfigProposal     = "weight_update_instantiate_config_proposal"
 	WeightStoreAndInstantiateContractProposal = "weight_store_and_instantiate_contract_proposal"
+	WeightSetCodeGasMultiplierProposal        = "weight_set_code_gas_multiplier_proposal"
 
 	DefaultWeightStoreCodeProposal                   int = 5
 	DefaultWeightInstantiateContractProposal         int = 5
@@ -35,6 +36,7 @@ const (
 	DefaultWeightUnpinCodesProposal                  int = 5
 	DefaultWeightUpdateInstantiateConfigProposal     int = 5
 	DefaultWeightStoreAndInstantiateContractProposal int = 5
+	DefaultWeightSetCodeGasMultiplierProposal        int = 5
 )
 
 func ProposalMsgs(bk BankKeeper, wasmKeeper WasmKeeper) []simtypes.WeightedProposalMsg {
@@ -108,6 +110,14 @@ func ProposalMsgs(bk BankKeeper, wasmKeeper WasmKeeper) []simtypes.WeightedPropo
 				DefaultSimulationCodeIDSelector,
 			),
 		),
+		simulation.NewWeightedProposalMsg(
+			WeightSetCodeGasMultiplierProposal,
+			DefaultWeightSetCodeGasMultiplierProposal,
+			SimulateSetCodeGasMultiplierProposal(
+				wasmKeeper,
+				DefaultSimulationCodeIDSelector,
+			),
+		),
 	}
 }
 
@@ -356,6 +366,25 @@ func SimulateUpdateInstantiateConfigProposal(wasmKeeper WasmKeeper, codeSelector
 	}
 }
 
+// Simulate set code gas multiplier proposal
+func SimulateSetCodeGasMultiplierProposal(wasmKeeper WasmKeeper, codeSelector CodeIDSelector) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+		authority := wasmKeeper.GetAuthority()
+
+		codeID := codeSelector(ctx, wasmKeeper)
+		if codeID == 0 {
+			return nil
+		}
+
+		multiplierBps := types.MinCodeGasMultiplierBps + uint32(r.Int63n(int64(types.MaxCodeGasMultiplierBps-types.MinCodeGasMultiplierBps)+1))
+		return &types.MsgSetCodeGasMultiplier{
+			Authority:     authority,
+			CodeID:        codeID,
+			MultiplierBps: multiplierBps,
+		}
+	}
+}
+
 func SimulateStoreAndInstantiateContractProposal(wasmKeeper WasmKeeper) simtypes.MsgSimulatorFn {
 	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
 		authority := wasmKeeper.GetAuthority()
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"context"
+	"slices"
 	"strings"
 
 	wasmvm "github.com/CosmWasm/wasmvm/v3"
@@ -492,6 +493,170 @@ func (f AcceptedMessageKeysFilter) ValidateBasic() error {
 	return nil
 }
 
+// Operators of a MessageFieldConstraint
+const (
+	ConstraintOperatorEqual              = "=="
+	ConstraintOperatorNotEqual           = "!="
+	ConstraintOperatorLessThan           = "<"
+	ConstraintOperatorLessThanOrEqual    = "<="
+	ConstraintOperatorGreaterThan        = ">"
+	ConstraintOperatorGreaterThanOrEqual = ">="
+	ConstraintOperatorIn                 = "in"
+	ConstraintOperatorNotIn              = "not_in"
+)
+
+// NewAcceptedMessageConstraintsFilter constructor
+func NewAcceptedMessageConstraintsFilter(constraints ...MessageFieldConstraint) *AcceptedMessageConstraintsFilter {
+	return &AcceptedMessageConstraintsFilter{Constraints: constraints}
+}
+
+// Accept only payload messages with a json object where the fields satisfy all the constraints.
+func (f *AcceptedMessageConstraintsFilter) Accept(ctx sdk.Context, msg RawContractMessage) (bool, error) {
+	gasForDeserialization := gasDeserializationCostPerByte * uint64(len(msg))
+	ctx.GasMeter().ConsumeGas(gasForDeserialization, "contract authorization")
+
+	if err := msg.ValidateBasic(); err != nil {
+		return false, sdkerrors.ErrUnauthorized.Wrapf("not an allowed msg: %s", err.Error())
+	}
+	document, err := decodeStrictJSON(msg)
+	if err != nil {
+		return false, sdkerrors.ErrUnauthorized.Wrapf("not an allowed msg: %s", err.Error())
+	}
+	if _, ok := document.(map[string]any); !ok {
+		return false, nil // unsupported type
+	}
+	for _, c := range f.Constraints {
+		ok, err := c.Accept(ctx, document)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// ValidateBasic validates the filter
+func (f AcceptedMessageConstraintsFilter) ValidateBasic() error {
+	if len(f.Constraints) == 0 {
+		return ErrEmpty.Wrap("constraints")
+	}
+	idx := make(map[string]struct{}, len(f.Constraints))
+	for _, c := range f.Constraints {
+		if err := c.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "constraint %q", c.Path)
+		}
+		key := c.Path + " " + c.Operator
+		if _, exists := idx[key]; exists {
+			return ErrDuplicate.Wrapf("constraint %q", key)
+		}
+		idx[key] = struct{}{}
+	}
+	return nil
+}
+
+// Accept returns true when the field in the decoded json document satisfies the constraint.
+// A missing field never satisfies the constraint, for any operator.
+func (c MessageFieldConstraint) Accept(ctx sdk.Context, document any) (bool, error) {
+	var valuesSize int
+	for _, v := range c.Values {
+		valuesSize += len(v)
+	}
+	ctx.GasMeter().ConsumeGas(gasDeserializationCostPerByte*uint64(valuesSize), "contract authorization")
+
+	field, ok := jsonPathValue(document, c.Path)
+	if !ok {
+		return false, nil
+	}
+	values := make([]any, len(c.Values))
+	for i, v := range c.Values {
+		var err error
+		if values[i], err = decodeStrictJSON(v); err != nil {
+			return false, ErrInvalid.Wrapf("constraint value: %s", err)
+		}
+	}
+	matchesAny := func() bool {
+		return slices.ContainsFunc(values, func(v any) bool { return jsonEqual(field, v) })
+	}
+	switch c.Operator {
+	case ConstraintOperatorEqual, ConstraintOperatorIn:
+		return matchesAny(), nil
+	case ConstraintOperatorNotEqual, ConstraintOperatorNotIn:
+		return !matchesAny(), nil
+	}
+
+	fieldValue, ok := jsonNumericValue(field)
+	if !ok {
+		return false, nil
+	}
+	limit, ok := jsonNumericValue(values[0])
+	if !ok {
+		return false, ErrInvalid.Wrap("constraint value: not a number")
+	}
+	cmp := fieldValue.Cmp(limit)
+	switch c.Operator {
+	case ConstraintOperatorLessThan:
+		return cmp < 0, nil
+	case ConstraintOperatorLessThanOrEqual:
+		return cmp <= 0, nil
+	case ConstraintOperatorGreaterThan:
+		return cmp > 0, nil
+	case ConstraintOperatorGreaterThanOrEqual:
+		return cmp >= 0, nil
+	default:
+		return false, ErrInvalid.Wrapf("operator %q", c.Operator)
+	}
+}
+
+// ValidateBasic validates the constraint
+func (c MessageFieldConstraint) ValidateBasic() error {
+	if c.Path == "" {
+		return ErrEmpty.Wrap("path")
+	}
+	for _, segment := range strings.Split(c.Path, ".") {
+		if segment == "" {
+			return ErrInvalid.Wrap("path contains an empty segment")
+		}
+		if segment != strings.TrimSpace(segment) {
+			return ErrInvalid.Wrap("path contains whitespaces")
+		}
+	}
+	var numeric bool
+	switch c.Operator {
+	case ConstraintOperatorIn, ConstraintOperatorNotIn:
+		if len(c.Values) == 0 {
+			return ErrEmpty.Wrap("values")
+		}
+	case ConstraintOperatorEqual, ConstraintOperatorNotEqual:
+		if len(c.Values) != 1 {
+			return ErrInvalid.Wrapf("operator %q requires exactly one value", c.Operator)
+		}
+	case ConstraintOperatorLessThan, ConstraintOperatorLessThanOrEqual, ConstraintOperatorGreaterThan, ConstraintOperatorGreaterThanOrEqual:
+		if len(c.Values) != 1 {
+			return ErrInvalid.Wrapf("operator %q requires exactly one value", c.Operator)
+		}
+		numeric = true
+	default:
+		return ErrInvalid.Wrapf("operator %q", c.Operator)
+	}
+	values := make([]any, 0, len(c.Values))
+	for _, m := range c.Values {
+		if err := m.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "value")
+		}
+		v, err := decodeStrictJSON(m)
+		if err != nil {
+			return ErrInvalid.Wrapf("value: %s", err)
+		}
+		if _, ok := jsonNumericValue(v); numeric && !ok {
+			return ErrInvalid.Wrapf("operator %q requires a number value", c.Operator)
+		}
+		if slices.ContainsFunc(values, func(o any) bool { return jsonEqual(v, o) }) {
+			return ErrDuplicate.Wrap("value")
+		}
+		values = append(values, v)
+	}
+	return nil
+}
+
 // NewAcceptedMessagesFilter constructor
 func NewAcceptedMessagesFilter(msgs ...RawContractMessage) *AcceptedMessagesFilter {
 	return &AcceptedMessagesFilter{Messages: msgs}
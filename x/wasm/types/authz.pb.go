@@ -494,6 +494,101 @@ func (m *AcceptedMessageKeysFilter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AcceptedMessageKeysFilter proto.InternalMessageInfo
 
+// AcceptedMessageConstraintsFilter accept only contract messages with fields
+// that satisfy all the constraints.
+type AcceptedMessageConstraintsFilter struct {
+	// Constraints is the list of field constraints that must all be satisfied
+	Constraints []MessageFieldConstraint `protobuf:"bytes,1,rep,name=constraints,proto3" json:"constraints"`
+}
+
+func (m *AcceptedMessageConstraintsFilter) Reset()         { *m = AcceptedMessageConstraintsFilter{} }
+func (m *AcceptedMessageConstraintsFilter) String() string { return proto.CompactTextString(m) }
+func (*AcceptedMessageConstraintsFilter) ProtoMessage()    {}
+func (*AcceptedMessageConstraintsFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{10}
+}
+
+func (m *AcceptedMessageConstraintsFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *AcceptedMessageConstraintsFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptedMessageConstraintsFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *AcceptedMessageConstraintsFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptedMessageConstraintsFilter.Merge(m, src)
+}
+
+func (m *AcceptedMessageConstraintsFilter) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *AcceptedMessageConstraintsFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptedMessageConstraintsFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptedMessageConstraintsFilter proto.InternalMessageInfo
+
+// MessageFieldConstraint is a condition on a field of a JSON contract message
+type MessageFieldConstraint struct {
+	// Path is the dot separated path to the field in the JSON object, e.g.
+	// "transfer.amount". Array elements are selected by their index.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Operator is one of "==", "!=", "<", "<=", ">", ">=", "in" or "not_in"
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// Values are the JSON values to compare the field with. The "in" and
+	// "not_in" operators take one or more values, all others exactly one.
+	Values []RawContractMessage `protobuf:"bytes,3,rep,name=values,proto3,casttype=RawContractMessage" json:"values,omitempty"`
+}
+
+func (m *MessageFieldConstraint) Reset()         { *m = MessageFieldConstraint{} }
+func (m *MessageFieldConstraint) String() string { return proto.CompactTextString(m) }
+func (*MessageFieldConstraint) ProtoMessage()    {}
+func (*MessageFieldConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{11}
+}
+
+func (m *MessageFieldConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MessageFieldConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageFieldConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MessageFieldConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageFieldConstraint.Merge(m, src)
+}
+
+func (m *MessageFieldConstraint) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MessageFieldConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageFieldConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageFieldConstraint proto.InternalMessageInfo
+
 // AcceptedMessagesFilter accept only the specific raw contract messages to be
 // executed.
 // Since: wasmd 0.30
@@ -506,7 +601,7 @@ func (m *AcceptedMessagesFilter) Reset()         { *m = AcceptedMessagesFilter{}
 func (m *AcceptedMessagesFilter) String() string { return proto.CompactTextString(m) }
 func (*AcceptedMessagesFilter) ProtoMessage()    {}
 func (*AcceptedMessagesFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36ff3a20cf32b258, []int{10}
+	return fileDescriptor_36ff3a20cf32b258, []int{12}
 }
 
 func (m *AcceptedMessagesFilter) XXX_Unmarshal(b []byte) error {
@@ -551,65 +646,73 @@ func init() {
 	proto.RegisterType((*CombinedLimit)(nil), "cosmwasm.wasm.v1.CombinedLimit")
 	proto.RegisterType((*AllowAllMessagesFilter)(nil), "cosmwasm.wasm.v1.AllowAllMessagesFilter")
 	proto.RegisterType((*AcceptedMessageKeysFilter)(nil), "cosmwasm.wasm.v1.AcceptedMessageKeysFilter")
+	proto.RegisterType((*AcceptedMessageConstraintsFilter)(nil), "cosmwasm.wasm.v1.AcceptedMessageConstraintsFilter")
+	proto.RegisterType((*MessageFieldConstraint)(nil), "cosmwasm.wasm.v1.MessageFieldConstraint")
 	proto.RegisterType((*AcceptedMessagesFilter)(nil), "cosmwasm.wasm.v1.AcceptedMessagesFilter")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/authz.proto", fileDescriptor_36ff3a20cf32b258) }
 
 var fileDescriptor_36ff3a20cf32b258 = []byte{
-	// 817 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x4f, 0x33, 0x45,
-	0x18, 0xee, 0x7e, 0xdf, 0x27, 0xd2, 0x81, 0xcf, 0x1f, 0x1b, 0x6c, 0x5a, 0x20, 0x5b, 0xb2, 0x2a,
-	0x56, 0x92, 0xee, 0xa6, 0xe8, 0xa9, 0x07, 0x4d, 0xb7, 0x5a, 0x35, 0x82, 0x31, 0x8b, 0x06, 0xe2,
-	0xa5, 0x99, 0xee, 0x0e, 0xdb, 0x91, 0xdd, 0x99, 0x66, 0x67, 0x0a, 0x14, 0x63, 0xbc, 0x7b, 0xf2,
-	0xec, 0xc9, 0x9b, 0xc6, 0x13, 0x87, 0xfe, 0x11, 0x84, 0xc4, 0x84, 0x78, 0xf2, 0x84, 0x0a, 0x07,
-	0xfe, 0x01, 0xe3, 0xc1, 0x93, 0x99, 0x1f, 0x6d, 0x69, 0x29, 0x04, 0x39, 0xf9, 0x5d, 0xa6, 0x9d,
-	0xf7, 0x9d, 0xf7, 0x7d, 0x9f, 0xe7, 0x9d, 0x67, 0xde, 0x05, 0xcb, 0x01, 0x65, 0xc9, 0x01, 0x64,
-	0x89, 0x2b, 0x97, 0xfd, 0x8a, 0x0b, 0xbb, 0xbc, 0x7d, 0xe4, 0x74, 0x52, 0xca, 0xa9, 0xf9, 0xd2,
-	0xc0, 0xeb, 0xc8, 0x65, 0xbf, 0xb2, 0xb8, 0x10, 0xd1, 0x88, 0x4a, 0xa7, 0x2b, 0xfe, 0xa9, 0x73,
-	0x8b, 0x05, 0x71, 0x8e, 0xb2, 0xa6, 0x72, 0xa8, 0x8d, 0x76, 0x59, 0x6a, 0xe7, 0xb6, 0x20, 0x43,
-	0xee, 0x7e, 0xa5, 0x85, 0x38, 0xac, 0xb8, 0x01, 0xc5, 0x44, 0xfb, 0x6f, 0x02, 0xe0, 0xbd, 0x0e,
-	0x1a, 0x44, 0x17, 0x22, 0x4a, 0xa3, 0x18, 0xb9, 0x72, 0xd7, 0xea, 0xee, 0xba, 0x90, 0xf4, 0xb4,
-	0xeb, 0x65, 0x98, 0x60, 0x42, 0x5d, 0xb9, 0x2a, 0x93, 0xfd, 0x83, 0x01, 0x72, 0x5b, 0x9c, 0xa6,
-	0xa8, 0x4e, 0x43, 0x54, 0xeb, 0xf2, 0x36, 0x4d, 0xf1, 0x11, 0xe4, 0x98, 0x12, 0xf3, 0x1d, 0x30,
-	0x13, 0xa5, 0x90, 0x70, 0x96, 0x37, 0x56, 0x1e, 0x97, 0xe6, 0xd6, 0x97, 0x9c, 0x49, 0x6a, 0x8e,
-	0x08, 0xfa, 0x40, 0x9c, 0xf1, 0xb2, 0x27, 0xe7, 0xc5, 0xcc, 0x4f, 0x57, 0xc7, 0x6b, 0x86, 0xaf,
-	0xa3, 0xaa, 0x8d, 0xd3, 0x7e, 0xd9, 0xd6, 0xc4, 0x54, 0x87, 0x34, 0x17, 0x67, 0xac, 0xce, 0xb7,
-	0x57, 0xc7, 0x6b, 0x4b, 0x92, 0xc8, 0x74, 0x1c, 0x76, 0xdf, 0x00, 0x56, 0x9d, 0x12, 0x9e, 0xc2,
-	0x80, 0xbf, 0x7f, 0x88, 0x82, 0xae, 0xb0, 0x8e, 0x43, 0xf5, 0x26, 0xa0, 0x16, 0xa7, 0x41, 0x55,
-	0x19, 0x6e, 0x85, 0xfb, 0xc9, 0xfd, 0xe1, 0xbe, 0x2a, 0xe1, 0xde, 0x8d, 0x69, 0x0c, 0xf6, 0x26,
-	0x8e, 0x52, 0xf8, 0x3f, 0x83, 0x3d, 0x1d, 0x93, 0xfd, 0x0d, 0xc8, 0x0e, 0x6f, 0xd5, 0x5c, 0x02,
-	0xd9, 0x80, 0x86, 0xa8, 0xd9, 0x86, 0xac, 0x9d, 0x37, 0x56, 0x8c, 0xd2, 0xbc, 0x3f, 0x2b, 0x0c,
-	0x1f, 0x42, 0xd6, 0x36, 0x3f, 0x07, 0x39, 0x4c, 0x18, 0x87, 0x84, 0x63, 0xc8, 0x51, 0xb3, 0x83,
-	0xd2, 0x04, 0x33, 0x86, 0x29, 0xc9, 0x3f, 0x5a, 0x31, 0x4a, 0x73, 0xeb, 0xd6, 0x4d, 0x36, 0xb5,
-	0x20, 0x40, 0x8c, 0xd5, 0x29, 0xd9, 0xc5, 0x91, 0xff, 0xca, 0xb5, 0xe8, 0x4f, 0x87, 0xc1, 0xf6,
-	0x5f, 0x06, 0x78, 0x3a, 0xc6, 0xda, 0x7c, 0x1b, 0xcc, 0x06, 0xda, 0x20, 0x41, 0x64, 0xbd, 0xfc,
-	0xaf, 0xfd, 0xf2, 0x82, 0x26, 0x5d, 0x0b, 0xc3, 0x14, 0x31, 0xb6, 0xc5, 0x53, 0x4c, 0x22, 0x7f,
-	0x78, 0xd2, 0xfc, 0x0c, 0x3c, 0x17, 0xe3, 0x04, 0x73, 0x8d, 0x66, 0xc1, 0x51, 0xef, 0xc2, 0x19,
-	0xbc, 0x0b, 0xa7, 0x46, 0x7a, 0x5e, 0xe9, 0xb4, 0x5f, 0x7e, 0xed, 0xd6, 0xa6, 0x8b, 0xce, 0x1c,
-	0x6d, 0x88, 0x24, 0x3b, 0xbe, 0x4a, 0x66, 0x6e, 0x83, 0x99, 0x5d, 0x1c, 0x73, 0x94, 0xe6, 0x1f,
-	0xdf, 0x91, 0xf6, 0xcd, 0xd3, 0x7e, 0xf9, 0xf5, 0xbb, 0xd3, 0x36, 0x64, 0x96, 0x1d, 0x5f, 0xa7,
-	0xb3, 0x09, 0x78, 0xba, 0x09, 0x0f, 0xeb, 0x30, 0x8e, 0x99, 0xac, 0x68, 0x2e, 0x83, 0x6c, 0x8a,
-	0x12, 0x88, 0x09, 0x26, 0x91, 0xa4, 0xfd, 0xc4, 0x1f, 0x19, 0xaa, 0xef, 0xde, 0x17, 0xb8, 0xb8,
-	0x78, 0x53, 0x5e, 0xfc, 0x58, 0x7a, 0xfb, 0x17, 0x43, 0x16, 0x6c, 0x74, 0x49, 0xa8, 0x0b, 0x7e,
-	0x05, 0x9e, 0x87, 0x09, 0xed, 0x8e, 0xe4, 0x58, 0x70, 0x74, 0x8b, 0xc5, 0x20, 0x1a, 0xca, 0xaa,
-	0x4e, 0x31, 0xf1, 0x1a, 0x42, 0x88, 0x3f, 0xff, 0x5e, 0x2c, 0x45, 0x98, 0xb7, 0xbb, 0x2d, 0x27,
-	0xa0, 0x89, 0x9e, 0x61, 0xfa, 0xa7, 0xcc, 0xc2, 0x3d, 0x3d, 0x96, 0x44, 0x00, 0xfb, 0xfe, 0xea,
-	0x78, 0x6d, 0x3e, 0x46, 0x11, 0x0c, 0x7a, 0x4d, 0x31, 0xca, 0x98, 0x52, 0xf1, 0xa0, 0xe2, 0x03,
-	0xf9, 0x8c, 0xd0, 0xdb, 0x7f, 0x4b, 0xd9, 0x24, 0x2d, 0x4c, 0x50, 0xa8, 0xf8, 0xbc, 0x01, 0x5e,
-	0x0c, 0x04, 0xdf, 0xe6, 0x64, 0x1b, 0x5f, 0x90, 0x66, 0x7f, 0x60, 0xbd, 0x4e, 0xfc, 0xd1, 0xb3,
-	0x40, 0x7c, 0x8c, 0xa6, 0x1d, 0x80, 0x5c, 0x2d, 0x8e, 0xe9, 0x41, 0x2d, 0x8e, 0x37, 0x11, 0x63,
-	0x30, 0x42, 0x4c, 0x69, 0xab, 0xfa, 0xd1, 0xbd, 0x55, 0x38, 0x9a, 0xc1, 0xd3, 0x53, 0xd9, 0x5f,
-	0x83, 0x82, 0x78, 0xbb, 0x1d, 0x8e, 0x42, 0xed, 0xf9, 0x18, 0xf5, 0xb4, 0xd3, 0x34, 0xc1, 0x93,
-	0x3d, 0xd4, 0x53, 0xaa, 0xc9, 0xfa, 0xf2, 0x7f, 0x75, 0xe3, 0x3f, 0xd5, 0xb6, 0x54, 0xed, 0xdb,
-	0x2a, 0xd8, 0x3f, 0x1a, 0x20, 0x37, 0xe1, 0x1d, 0x14, 0xf7, 0xc0, 0x6c, 0xa2, 0x2d, 0x12, 0xc0,
-	0xbc, 0xb7, 0xfa, 0xcf, 0x79, 0xd1, 0xf4, 0xe1, 0xc1, 0x70, 0xd0, 0x29, 0xb7, 0xb8, 0x88, 0x39,
-	0x4c, 0x62, 0x4c, 0x50, 0xf3, 0x4b, 0x46, 0x89, 0x3f, 0x8c, 0x7b, 0x58, 0xa3, 0xa6, 0xc2, 0xf1,
-	0xde, 0x3b, 0xf9, 0xd3, 0xca, 0x9c, 0x5c, 0x58, 0xc6, 0xd9, 0x85, 0x65, 0xfc, 0x71, 0x61, 0x19,
-	0xdf, 0x5d, 0x5a, 0x99, 0xb3, 0x4b, 0x2b, 0xf3, 0xdb, 0xa5, 0x95, 0xf9, 0x62, 0xf5, 0x9a, 0x6a,
-	0xea, 0x94, 0x25, 0xdb, 0x83, 0x8f, 0x78, 0xe8, 0x1e, 0xaa, 0x8f, 0xb9, 0x54, 0x4e, 0x6b, 0x46,
-	0x4e, 0x93, 0xb7, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xf0, 0x2f, 0x8c, 0x6b, 0x08, 0x00,
-	0x00,
+	// 916 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xb7, 0x4b, 0x69, 0xa6, 0x5d, 0x3e, 0xac, 0x12, 0xa5, 0xed, 0xca, 0x8d, 0x0c, 0xbb,
+	0x84, 0x4a, 0xb1, 0xd5, 0x85, 0x53, 0x0e, 0xa0, 0x38, 0x10, 0x40, 0x6c, 0x11, 0x72, 0x59, 0xed,
+	0x8a, 0x4b, 0x34, 0xb1, 0xa7, 0xce, 0xb0, 0xf6, 0x4c, 0xe4, 0x19, 0xb7, 0x4d, 0x11, 0xe2, 0x8a,
+	0x38, 0x71, 0xe6, 0xc4, 0x0d, 0xc4, 0xa9, 0x87, 0xfc, 0x11, 0x55, 0x25, 0xd0, 0x8a, 0x13, 0xa7,
+	0x05, 0xda, 0x43, 0xff, 0x01, 0xc4, 0x81, 0x13, 0x9a, 0x0f, 0xe7, 0xab, 0x69, 0xd5, 0xed, 0x09,
+	0x2e, 0x8e, 0xe7, 0xbd, 0x79, 0xbf, 0xf7, 0xfb, 0xbd, 0x79, 0xf3, 0x62, 0x70, 0x3b, 0xa0, 0x2c,
+	0xd9, 0x83, 0x2c, 0x71, 0xe5, 0x63, 0x77, 0xd3, 0x85, 0x19, 0xef, 0x1e, 0x38, 0xbd, 0x94, 0x72,
+	0x6a, 0xbe, 0x94, 0x7b, 0x1d, 0xf9, 0xd8, 0xdd, 0x5c, 0x5d, 0x8e, 0x68, 0x44, 0xa5, 0xd3, 0x15,
+	0x6f, 0x6a, 0xdf, 0xea, 0x8a, 0xd8, 0x47, 0x59, 0x5b, 0x39, 0xd4, 0x42, 0xbb, 0x2c, 0xb5, 0x72,
+	0x3b, 0x90, 0x21, 0x77, 0x77, 0xb3, 0x83, 0x38, 0xdc, 0x74, 0x03, 0x8a, 0x89, 0xf6, 0x9f, 0x27,
+	0xc0, 0xfb, 0x3d, 0x94, 0x47, 0xaf, 0x44, 0x94, 0x46, 0x31, 0x72, 0xe5, 0xaa, 0x93, 0xed, 0xb8,
+	0x90, 0xf4, 0xb5, 0xeb, 0x65, 0x98, 0x60, 0x42, 0x5d, 0xf9, 0x54, 0x26, 0xfb, 0x7b, 0x03, 0x94,
+	0xb6, 0x39, 0x4d, 0x51, 0x93, 0x86, 0xa8, 0x91, 0xf1, 0x2e, 0x4d, 0xf1, 0x01, 0xe4, 0x98, 0x12,
+	0xf3, 0x6d, 0x30, 0x1f, 0xa5, 0x90, 0x70, 0x56, 0x36, 0x2a, 0x73, 0xd5, 0xc5, 0x7b, 0x6b, 0xce,
+	0xb4, 0x34, 0x47, 0x04, 0xbd, 0x2f, 0xf6, 0x78, 0xc5, 0xa3, 0xa7, 0xeb, 0x85, 0x1f, 0xcf, 0x0e,
+	0x37, 0x0c, 0x5f, 0x47, 0xd5, 0x5b, 0xc7, 0x83, 0x9a, 0xad, 0x85, 0xa9, 0x0a, 0x69, 0x2d, 0xce,
+	0x44, 0x9e, 0x6f, 0xce, 0x0e, 0x37, 0xd6, 0xa4, 0x90, 0xd9, 0x3c, 0xec, 0x81, 0x01, 0xac, 0x26,
+	0x25, 0x3c, 0x85, 0x01, 0x7f, 0x6f, 0x1f, 0x05, 0x99, 0xb0, 0x4e, 0x52, 0xf5, 0xa6, 0xa8, 0xae,
+	0xcf, 0xa2, 0xaa, 0x10, 0x2e, 0xa4, 0xfb, 0xf1, 0xd5, 0xe9, 0xbe, 0x2a, 0xe9, 0x5e, 0xce, 0x69,
+	0x82, 0xf6, 0x16, 0x8e, 0x52, 0xf8, 0x1f, 0xa3, 0x3d, 0x9b, 0x93, 0xfd, 0x15, 0x28, 0x0e, 0x4f,
+	0xd5, 0x5c, 0x03, 0xc5, 0x80, 0x86, 0xa8, 0xdd, 0x85, 0xac, 0x5b, 0x36, 0x2a, 0x46, 0x75, 0xc9,
+	0x5f, 0x10, 0x86, 0x0f, 0x20, 0xeb, 0x9a, 0x0f, 0x40, 0x09, 0x13, 0xc6, 0x21, 0xe1, 0x18, 0x72,
+	0xd4, 0xee, 0xa1, 0x34, 0xc1, 0x8c, 0x61, 0x4a, 0xca, 0x37, 0x2a, 0x46, 0x75, 0xf1, 0x9e, 0x75,
+	0x5e, 0x4d, 0x23, 0x08, 0x10, 0x63, 0x4d, 0x4a, 0x76, 0x70, 0xe4, 0xbf, 0x32, 0x16, 0xfd, 0xc9,
+	0x30, 0xd8, 0xfe, 0xcb, 0x00, 0xb7, 0x26, 0x54, 0x9b, 0x6f, 0x81, 0x85, 0x40, 0x1b, 0x24, 0x89,
+	0xa2, 0x57, 0xfe, 0x75, 0x50, 0x5b, 0xd6, 0xa2, 0x1b, 0x61, 0x98, 0x22, 0xc6, 0xb6, 0x79, 0x8a,
+	0x49, 0xe4, 0x0f, 0x77, 0x9a, 0x9f, 0x82, 0xe7, 0x62, 0x9c, 0x60, 0xae, 0xd9, 0x2c, 0x3b, 0xea,
+	0x5e, 0x38, 0xf9, 0xbd, 0x70, 0x1a, 0xa4, 0xef, 0x55, 0x8f, 0x07, 0xb5, 0xd7, 0x2e, 0x2c, 0xba,
+	0xa8, 0xcc, 0xc1, 0x7d, 0x01, 0xf2, 0xc8, 0x57, 0x60, 0xe6, 0x43, 0x30, 0xbf, 0x83, 0x63, 0x8e,
+	0xd2, 0xf2, 0xdc, 0x25, 0xb0, 0x6f, 0x1c, 0x0f, 0x6a, 0x77, 0x2e, 0x87, 0x6d, 0x49, 0x94, 0x47,
+	0xbe, 0x86, 0xb3, 0x09, 0xb8, 0xb5, 0x05, 0xf7, 0x9b, 0x30, 0x8e, 0x99, 0xcc, 0x68, 0xde, 0x06,
+	0xc5, 0x14, 0x25, 0x10, 0x13, 0x4c, 0x22, 0x29, 0xfb, 0xa6, 0x3f, 0x32, 0xd4, 0xdf, 0xb9, 0x2a,
+	0x71, 0x71, 0xf0, 0xa6, 0x3c, 0xf8, 0x09, 0x78, 0xfb, 0x67, 0x43, 0x26, 0x6c, 0x65, 0x24, 0xd4,
+	0x09, 0xbf, 0x00, 0xcf, 0xc3, 0x84, 0x66, 0xa3, 0x76, 0x5c, 0x71, 0x74, 0x89, 0xc5, 0x20, 0x1a,
+	0xb6, 0x55, 0x93, 0x62, 0xe2, 0xb5, 0x44, 0x23, 0xfe, 0xf4, 0xfb, 0x7a, 0x35, 0xc2, 0xbc, 0x9b,
+	0x75, 0x9c, 0x80, 0x26, 0x7a, 0x86, 0xe9, 0x9f, 0x1a, 0x0b, 0x1f, 0xeb, 0xb1, 0x24, 0x02, 0xd8,
+	0x77, 0x67, 0x87, 0x1b, 0x4b, 0x31, 0x8a, 0x60, 0xd0, 0x6f, 0x8b, 0x51, 0xc6, 0x54, 0x17, 0xe7,
+	0x19, 0xaf, 0xa9, 0x67, 0xc4, 0xde, 0xfe, 0x5b, 0xb6, 0x4d, 0xd2, 0xc1, 0x04, 0x85, 0x4a, 0xcf,
+	0xeb, 0xe0, 0xc5, 0x40, 0xe8, 0x6d, 0x4f, 0x97, 0xf1, 0x05, 0x69, 0xf6, 0x73, 0xeb, 0xb8, 0xf0,
+	0x1b, 0xff, 0x07, 0xe1, 0x13, 0x32, 0xed, 0x00, 0x94, 0x1a, 0x71, 0x4c, 0xf7, 0x1a, 0x71, 0xbc,
+	0x85, 0x18, 0x83, 0x11, 0x62, 0xaa, 0xb7, 0xea, 0x1f, 0x5e, 0xb9, 0x0b, 0x47, 0x33, 0x78, 0x36,
+	0x94, 0xfd, 0x25, 0x58, 0x11, 0x77, 0xb7, 0xc7, 0x51, 0xa8, 0x3d, 0x1f, 0xa1, 0xbe, 0x76, 0x9a,
+	0x26, 0xb8, 0xf9, 0x18, 0xf5, 0x55, 0xd7, 0x14, 0x7d, 0xf9, 0x5e, 0xbf, 0xff, 0x4c, 0xb9, 0x2d,
+	0x95, 0xfb, 0xa2, 0x0c, 0xf6, 0x2f, 0x06, 0xa8, 0x4c, 0x79, 0x9b, 0x94, 0x30, 0x9e, 0x42, 0x4c,
+	0x78, 0x4e, 0xe3, 0x01, 0x58, 0x0c, 0x46, 0x46, 0xdd, 0xc3, 0xd5, 0xf3, 0x43, 0x48, 0x03, 0xb4,
+	0x30, 0x8a, 0xc3, 0x11, 0xca, 0xf8, 0x6c, 0x1d, 0xc7, 0xa9, 0x6f, 0x3f, 0x93, 0x92, 0x3b, 0xb3,
+	0x94, 0x9c, 0xe3, 0x6a, 0x7f, 0x6d, 0x80, 0xd2, 0x6c, 0x1e, 0xa2, 0x9a, 0x3d, 0xc8, 0xd5, 0xb8,
+	0x2d, 0xfa, 0xf2, 0xdd, 0x5c, 0x05, 0x0b, 0xb4, 0x87, 0x52, 0xc8, 0x69, 0x2a, 0xc7, 0x59, 0xd1,
+	0x1f, 0xae, 0xc5, 0xdf, 0xf4, 0x2e, 0x8c, 0x33, 0xc4, 0xca, 0x73, 0x95, 0xb9, 0xea, 0x92, 0x77,
+	0xf7, 0x9f, 0xa7, 0xeb, 0xa6, 0x0f, 0xf7, 0x86, 0x73, 0x5e, 0xa5, 0x11, 0x7d, 0xb8, 0x88, 0x49,
+	0x8c, 0x09, 0x6a, 0x7f, 0xce, 0x28, 0xf1, 0x75, 0x94, 0xfd, 0x83, 0x01, 0x4a, 0x53, 0x7c, 0xf3,
+	0x8a, 0x7a, 0x60, 0x21, 0xd1, 0x16, 0x59, 0xce, 0xab, 0x83, 0x0f, 0xe3, 0xae, 0xd7, 0x84, 0x33,
+	0xe9, 0x78, 0xef, 0x1e, 0xfd, 0x69, 0x15, 0x8e, 0x4e, 0x2c, 0xe3, 0xc9, 0x89, 0x65, 0xfc, 0x71,
+	0x62, 0x19, 0xdf, 0x9e, 0x5a, 0x85, 0x27, 0xa7, 0x56, 0xe1, 0xb7, 0x53, 0xab, 0xf0, 0xd9, 0xdd,
+	0xb1, 0x1b, 0xd9, 0xa4, 0x2c, 0x79, 0x98, 0x7f, 0x20, 0x85, 0xee, 0xbe, 0xfa, 0x50, 0x92, 0xb7,
+	0xb2, 0x33, 0x2f, 0x27, 0xf5, 0x9b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x66, 0x3f, 0x6b, 0x9f,
+	0xc7, 0x09, 0x00, 0x00,
 }
 
 func (m *StoreCodeAuthorization) Marshal() (dAtA []byte, err error) {
@@ -981,6 +1084,89 @@ func (m *AcceptedMessageKeysFilter) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *AcceptedMessageConstraintsFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptedMessageConstraintsFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptedMessageConstraintsFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Constraints) > 0 {
+		for iNdEx := len(m.Constraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Constraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageFieldConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageFieldConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageFieldConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AcceptedMessagesFilter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1177,6 +1363,44 @@ func (m *AcceptedMessageKeysFilter) Size() (n int) {
 	return n
 }
 
+func (m *AcceptedMessageConstraintsFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Constraints) > 0 {
+		for _, e := range m.Constraints {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageFieldConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, b := range m.Values {
+			l = len(b)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *AcceptedMessagesFilter) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2124,6 +2348,238 @@ func (m *AcceptedMessageKeysFilter) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *AcceptedMessageConstraintsFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcceptedMessageConstraintsFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcceptedMessageConstraintsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constraints = append(m.Constraints, MessageFieldConstraint{})
+			if err := m.Constraints[len(m.Constraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MessageFieldConstraint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageFieldConstraint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageFieldConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, make([]byte, postIndex-iNdEx))
+			copy(m.Values[len(m.Values)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *AcceptedMessagesFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
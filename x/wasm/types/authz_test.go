@@ -52,6 +52,72 @@ func TestContractAuthzFilterValidate(t *testing.T) {
 			src:    NewAcceptedMessageKeysFilter(" ", "bar"),
 			expErr: true,
 		},
+		"allow constraints - single": {
+			src: NewAcceptedMessageConstraintsFilter(MessageFieldConstraint{Path: "transfer.amount", Operator: "<=", Values: []RawContractMessage{[]byte(`"1000"`)}}),
+		},
+		"allow constraints - multi": {
+			src: NewAcceptedMessageConstraintsFilter(
+				MessageFieldConstraint{Path: "swap.ask_asset", Operator: "in", Values: []RawContractMessage{[]byte(`"uatom"`), []byte(`"uosmo"`)}},
+				MessageFieldConstraint{Path: "swap.offer.amount", Operator: ">", Values: []RawContractMessage{[]byte(`0`)}},
+				MessageFieldConstraint{Path: "swap.offer.amount", Operator: "<", Values: []RawContractMessage{[]byte(`1000`)}},
+				MessageFieldConstraint{Path: "swap.path.0", Operator: "==", Values: []RawContractMessage{[]byte(`{"denom":"uatom"}`)}},
+			),
+		},
+		"allow constraints - empty": {
+			src:    NewAcceptedMessageConstraintsFilter(),
+			expErr: true,
+		},
+		"allow constraints - duplicate": {
+			src: NewAcceptedMessageConstraintsFilter(
+				MessageFieldConstraint{Path: "transfer.amount", Operator: "<=", Values: []RawContractMessage{[]byte(`1`)}},
+				MessageFieldConstraint{Path: "transfer.amount", Operator: "<=", Values: []RawContractMessage{[]byte(`2`)}},
+			),
+			expErr: true,
+		},
+		"allow constraints - empty path": {
+			src:    NewAcceptedMessageConstraintsFilter(MessageFieldConstraint{Operator: "==", Values: []RawContractMessage{[]byte(`1`)}}),
+			expErr: true,
+		},
+		"allow constraints - empty path segment": {
+			src:    NewAcceptedMessageConstraintsFilter(MessageFieldConstraint{Path: "transfer..amount", Operator: "==", Values: []RawContractMessage{[]byte(`1`)}}),
+			expErr: true,
+		},
+		"allow constraints - whitespace path": {
+			src:    NewAcceptedMessageConstraintsFilter(MessageFieldConstraint{Path: "transfer. amount", Operator: "==", Values: []RawContractMessage{[]byte(`1`)}}),
+			expErr: true,
+		},
+		"allow constraints - unknown operator": {
+			src:    NewAcceptedMessageConstraintsFilter(MessageFieldConstraint{Path: "amount", Operator: "=~", Values: []RawContractMessage{[]byte(`1`)}}),
+			expErr: true,
+		},
+		"allow constraints - multiple values": {
+			src:    NewAcceptedMessageConstraintsFilter(MessageFieldConstraint{Path: "amount", Operator: "==", Values: []RawContractMessage{[]byte(`1`), []byte(`2`)}}),
+			expErr: true,
+		},
+		"allow constraints - no values": {
+			src:    NewAcceptedMessageConstraintsFilter(MessageFieldConstraint{Path: "amount", Operator: "in"}),
+			expErr: true,
+		},
+		"allow constraints - duplicate values": {
+			src:    NewAcceptedMessageConstraintsFilter(MessageFieldConstraint{Path: "amount", Operator: "in", Values: []RawContractMessage{[]byte(`1`), []byte(`1.0`)}}),
+			expErr: true,
+		},
+		"allow constraints - non json value": {
+			src:    NewAcceptedMessageConstraintsFilter(MessageFieldConstraint{Path: "recipient", Operator: "==", Values: []RawContractMessage{[]byte(`bob`)}}),
+			expErr: true,
+		},
+		"allow constraints - duplicate keys in value": {
+			src:    NewAcceptedMessageConstraintsFilter(MessageFieldConstraint{Path: "recipient", Operator: "==", Values: []RawContractMessage{[]byte(`{"a":1,"a":2}`)}}),
+			expErr: true,
+		},
+		"allow constraints - non numeric value": {
+			src:    NewAcceptedMessageConstraintsFilter(MessageFieldConstraint{Path: "amount", Operator: "<=", Values: []RawContractMessage{[]byte(`"1e3"`)}}),
+			expErr: true,
+		},
+		"allow constraints - exponent too large": {
+			src:    NewAcceptedMessageConstraintsFilter(MessageFieldConstraint{Path: "amount", Operator: "<=", Values: []RawContractMessage{[]byte(`1e1000000`)}}),
+			expErr: true,
+		},
 		"allow message - single": {
 			src: NewAcceptedMessagesFilter([]byte(`{}`)),
 		},
@@ -95,6 +161,22 @@ func TestContractAuthzFilterValidate(t *testing.T) {
 }
 
 func TestContractAuthzFilterAccept(t *testing.T) {
+	constraintsFilter := NewAcceptedMessageConstraintsFilter(
+		MessageFieldConstraint{Path: "swap.ask_asset", Operator: "in", Values: []RawContractMessage{[]byte(`"uatom"`), []byte(`"uosmo"`)}},
+		MessageFieldConstraint{Path: "swap.offer.amount", Operator: "<=", Values: []RawContractMessage{[]byte(`1000`)}},
+		MessageFieldConstraint{Path: "swap.recipient", Operator: "==", Values: []RawContractMessage{[]byte(`"bob"`)}},
+		MessageFieldConstraint{Path: "swap.path.0", Operator: "!=", Values: []RawContractMessage{[]byte(`{"denom":"uatom"}`)}},
+	)
+	// constraintsGas returns the gas for the msg and the values of the evaluated constraints
+	constraintsGas := func(msg string, evaluated int) storetypes.Gas {
+		gas := storetypes.Gas(len(msg))
+		for _, c := range constraintsFilter.Constraints[:evaluated] {
+			for _, v := range c.Values {
+				gas += storetypes.Gas(len(v))
+			}
+		}
+		return gas
+	}
 	specs := map[string]struct {
 		filter         ContractAuthzFilterX
 		src            RawContractMessage
@@ -146,6 +228,70 @@ func TestContractAuthzFilterAccept(t *testing.T) {
 			src:    []byte(`not a json msg`),
 			expErr: true,
 		},
+		"allow constraints - accepted": {
+			filter:         constraintsFilter,
+			src:            []byte(`{"swap":{"ask_asset":"uatom","offer":{"amount":"1000"},"recipient":"bob","path":[{"denom":"uosmo"}]}}`),
+			exp:            true,
+			expGasConsumed: constraintsGas(`{"swap":{"ask_asset":"uatom","offer":{"amount":"1000"},"recipient":"bob","path":[{"denom":"uosmo"}]}}`, 4),
+		},
+		"allow constraints - accepts number fields": {
+			filter:         constraintsFilter,
+			src:            []byte(`{"swap":{"ask_asset":"uosmo","offer":{"amount":1e3},"recipient":"bob","path":[{"denom":"uosmo"}]}}`),
+			exp:            true,
+			expGasConsumed: constraintsGas(`{"swap":{"ask_asset":"uosmo","offer":{"amount":1e3},"recipient":"bob","path":[{"denom":"uosmo"}]}}`, 4),
+		},
+		"allow constraints - amount above limit": {
+			filter:         constraintsFilter,
+			src:            []byte(`{"swap":{"ask_asset":"uatom","offer":{"amount":"1001"},"recipient":"bob","path":[{"denom":"uosmo"}]}}`),
+			exp:            false,
+			expGasConsumed: constraintsGas(`{"swap":{"ask_asset":"uatom","offer":{"amount":"1001"},"recipient":"bob","path":[{"denom":"uosmo"}]}}`, 2),
+		},
+		"allow constraints - amount not a number": {
+			filter:         constraintsFilter,
+			src:            []byte(`{"swap":{"ask_asset":"uatom","offer":{"amount":"1e3"},"recipient":"bob","path":[{"denom":"uosmo"}]}}`),
+			exp:            false,
+			expGasConsumed: constraintsGas(`{"swap":{"ask_asset":"uatom","offer":{"amount":"1e3"},"recipient":"bob","path":[{"denom":"uosmo"}]}}`, 2),
+		},
+		"allow constraints - asset not in list": {
+			filter:         constraintsFilter,
+			src:            []byte(`{"swap":{"ask_asset":"ujuno","offer":{"amount":"1"},"recipient":"bob","path":[{"denom":"uosmo"}]}}`),
+			exp:            false,
+			expGasConsumed: constraintsGas(`{"swap":{"ask_asset":"ujuno","offer":{"amount":"1"},"recipient":"bob","path":[{"denom":"uosmo"}]}}`, 1),
+		},
+		"allow constraints - other recipient": {
+			filter:         constraintsFilter,
+			src:            []byte(`{"swap":{"ask_asset":"uatom","offer":{"amount":"1"},"recipient":"alice","path":[{"denom":"uosmo"}]}}`),
+			exp:            false,
+			expGasConsumed: constraintsGas(`{"swap":{"ask_asset":"uatom","offer":{"amount":"1"},"recipient":"alice","path":[{"denom":"uosmo"}]}}`, 3),
+		},
+		"allow constraints - missing field": {
+			filter:         constraintsFilter,
+			src:            []byte(`{"swap":{"ask_asset":"uatom","offer":{"amount":"1"}}}`),
+			exp:            false,
+			expGasConsumed: constraintsGas(`{"swap":{"ask_asset":"uatom","offer":{"amount":"1"}}}`, 3),
+		},
+		"allow constraints - excluded path element": {
+			filter:         constraintsFilter,
+			src:            []byte(`{"swap":{"ask_asset":"uatom","offer":{"amount":"1"},"recipient":"bob","path":[{"denom":"uatom"}]}}`),
+			exp:            false,
+			expGasConsumed: constraintsGas(`{"swap":{"ask_asset":"uatom","offer":{"amount":"1"},"recipient":"bob","path":[{"denom":"uatom"}]}}`, 4),
+		},
+		"allow constraints - unsupported array msg": {
+			filter:         constraintsFilter,
+			src:            []byte(`[{"swap":{}}]`),
+			exp:            false,
+			expGasConsumed: constraintsGas(`[{"swap":{}}]`, 0),
+		},
+		"allow constraints - duplicate keys": {
+			filter: constraintsFilter,
+			src:    []byte(`{"swap":{"ask_asset":"uatom","ask_asset":"ujuno"}}`),
+			expErr: true,
+		},
+		"allow constraints - invalid msg": {
+			filter: constraintsFilter,
+			src:    []byte(`not a json msg`),
+			expErr: true,
+		},
 		"allow message - single": {
 			filter: NewAcceptedMessagesFilter([]byte(`{}`)),
 			src:    []byte(`{}`),
@@ -28,12 +28,35 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRemoveCodeUploadParamsAddresses{}, "wasm/MsgRemoveCodeUploadParamsAddresses", nil)
 	cdc.RegisterConcrete(&MsgStoreAndMigrateContract{}, "wasm/MsgStoreAndMigrateContract", nil)
 	cdc.RegisterConcrete(&MsgUpdateContractLabel{}, "wasm/MsgUpdateContractLabel", nil)
+	cdc.RegisterConcrete(&MsgFreezeContract{}, "wasm/MsgFreezeContract", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeContract{}, "wasm/MsgUnfreezeContract", nil)
+	cdc.RegisterConcrete(&MsgDeprecateCodes{}, "wasm/MsgDeprecateCodes", nil)
+	cdc.RegisterConcrete(&MsgUndeprecateCodes{}, "wasm/MsgUndeprecateCodes", nil)
+	cdc.RegisterConcrete(&MsgRegisterCronSchedule{}, "wasm/MsgRegisterCronSchedule", nil)
+	cdc.RegisterConcrete(&MsgRemoveCronSchedule{}, "wasm/MsgRemoveCronSchedule", nil)
+	cdc.RegisterConcrete(&MsgSubscribeEpochHook{}, "wasm/MsgSubscribeEpochHook", nil)
+	cdc.RegisterConcrete(&MsgUnsubscribeEpochHook{}, "wasm/MsgUnsubscribeEpochHook", nil)
+	cdc.RegisterConcrete(&MsgRegisterFeeShare{}, "wasm/MsgRegisterFeeShare", nil)
+	cdc.RegisterConcrete(&MsgCancelFeeShare{}, "wasm/MsgCancelFeeShare", nil)
+	cdc.RegisterConcrete(&MsgSetFeeSponsorship{}, "wasm/MsgSetFeeSponsorship", nil)
+	cdc.RegisterConcrete(&MsgRemoveFeeSponsorship{}, "wasm/MsgRemoveFeeSponsorship", nil)
+	cdc.RegisterConcrete(&MsgProposeContractAdmin{}, "wasm/MsgProposeContractAdmin", nil)
+	cdc.RegisterConcrete(&MsgAcceptContractAdmin{}, "wasm/MsgAcceptContractAdmin", nil)
+	cdc.RegisterConcrete(&MsgCancelContractAdminTransfer{}, "wasm/MsgCancelContractAdminTransfer", nil)
+	cdc.RegisterConcrete(&MsgSetCodeMetadata{}, "wasm/MsgSetCodeMetadata", nil)
+	cdc.RegisterConcrete(&MsgRegisterContractName{}, "wasm/MsgRegisterContractName", nil)
+	cdc.RegisterConcrete(&MsgTransferContractName{}, "wasm/MsgTransferContractName", nil)
+	cdc.RegisterConcrete(&MsgReleaseContractName{}, "wasm/MsgReleaseContractName", nil)
+	cdc.RegisterConcrete(&MsgDeleteContract{}, "wasm/MsgDeleteContract", nil)
+	cdc.RegisterConcrete(&MsgSetCodeGasMultiplier{}, "wasm/MsgSetCodeGasMultiplier", nil)
+	cdc.RegisterConcrete(&MsgSetCodeSchema{}, "wasm/MsgSetCodeSchema", nil)
 
 	cdc.RegisterInterface((*ContractInfoExtension)(nil), nil)
 
 	cdc.RegisterInterface((*ContractAuthzFilterX)(nil), nil)
 	cdc.RegisterConcrete(&AllowAllMessagesFilter{}, "wasm/AllowAllMessagesFilter", nil)
 	cdc.RegisterConcrete(&AcceptedMessageKeysFilter{}, "wasm/AcceptedMessageKeysFilter", nil)
+	cdc.RegisterConcrete(&AcceptedMessageConstraintsFilter{}, "wasm/AcceptedMessageConstraintsFilter", nil)
 	cdc.RegisterConcrete(&AcceptedMessagesFilter{}, "wasm/AcceptedMessagesFilter", nil)
 
 	cdc.RegisterInterface((*ContractAuthzLimitX)(nil), nil)
@@ -83,6 +106,28 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgRemoveCodeUploadParamsAddresses{},
 		&MsgStoreAndMigrateContract{},
 		&MsgUpdateContractLabel{},
+		&MsgFreezeContract{},
+		&MsgUnfreezeContract{},
+		&MsgDeprecateCodes{},
+		&MsgUndeprecateCodes{},
+		&MsgRegisterCronSchedule{},
+		&MsgRemoveCronSchedule{},
+		&MsgSubscribeEpochHook{},
+		&MsgUnsubscribeEpochHook{},
+		&MsgRegisterFeeShare{},
+		&MsgCancelFeeShare{},
+		&MsgSetFeeSponsorship{},
+		&MsgRemoveFeeSponsorship{},
+		&MsgProposeContractAdmin{},
+		&MsgAcceptContractAdmin{},
+		&MsgCancelContractAdminTransfer{},
+		&MsgSetCodeMetadata{},
+		&MsgRegisterContractName{},
+		&MsgTransferContractName{},
+		&MsgReleaseContractName{},
+		&MsgDeleteContract{},
+		&MsgSetCodeGasMultiplier{},
+		&MsgSetCodeSchema{},
 	)
 	registry.RegisterInterface("cosmwasm.wasm.v1.ContractInfoExtension", (*ContractInfoExtension)(nil))
 
@@ -91,6 +136,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*ContractAuthzFilterX)(nil),
 		&AllowAllMessagesFilter{},
 		&AcceptedMessageKeysFilter{},
+		&AcceptedMessageConstraintsFilter{},
 		&AcceptedMessagesFilter{},
 	)
 
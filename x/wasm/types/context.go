@@ -23,6 +23,8 @@ const (
 
 	// contracts in the current tx
 	contextKeyTxContracts contextKey = iota
+	// account paying the storage deposits of the current message
+	contextKeyStorageDepositPayer contextKey = iota
 
 	// contextKeyExecModeSimulation contextKey = iota
 	_
@@ -101,3 +103,27 @@ func TxContractsFromContext(ctx context.Context) (TxContracts, bool) {
 	val, ok := ctx.Value(contextKeyTxContracts).(TxContracts)
 	return val, ok
 }
+
+// WithStorageDepositPayer stores the account that pays the storage deposits of all contract calls in the current
+// message. An empty address leaves the deposits to the contracts.
+func WithStorageDepositPayer(ctx sdk.Context, payer sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(contextKeyStorageDepositPayer, payer)
+}
+
+// StorageDepositPayer reads the storage deposit payer from the context
+func StorageDepositPayer(ctx context.Context) (sdk.AccAddress, bool) {
+	val, ok := ctx.Value(contextKeyStorageDepositPayer).(sdk.AccAddress)
+	return val, ok
+}
+
+// WithTxContractsScope returns a context that tracks the called contract addresses separately from the tx contracts
+// of the parent context. The addresses are added to the parent only when the returned commit function is called so
+// that contracts with reverted state changes are not tracked. The executed code checksums are shared.
+func WithTxContractsScope(ctx sdk.Context) (sdk.Context, func()) {
+	parent, ok := TxContractsFromContext(ctx)
+	if !ok {
+		return ctx, func() {}
+	}
+	scope := parent.newAddressScope()
+	return WithTxContracts(ctx, scope), func() { parent.mergeContractAddresses(scope) }
+}
@@ -0,0 +1,798 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+)
+
+// Contract message entry points of a contract schema
+const (
+	SchemaEntryPointInstantiate = "instantiate"
+	SchemaEntryPointExecute     = "execute"
+	SchemaEntryPointQuery       = "query"
+	SchemaEntryPointMigrate     = "migrate"
+	SchemaEntryPointSudo        = "sudo"
+)
+
+// AllSchemaEntryPoints are the contract message entry points that can be described by a contract schema
+var AllSchemaEntryPoints = []string{
+	SchemaEntryPointInstantiate,
+	SchemaEntryPointExecute,
+	SchemaEntryPointQuery,
+	SchemaEntryPointMigrate,
+	SchemaEntryPointSudo,
+}
+
+// ContractSchema is the JSON schema of the contract messages as generated by cosmwasm-schema.
+// Only the message schemas are used, other fields like the responses are ignored.
+//
+// The validation supports the subset of JSON schema draft 07 that is generated for Rust types:
+// type, enum, const, properties, required, additionalProperties, items, minItems, maxItems,
+// oneOf, anyOf, allOf, minimum, maximum, integer formats and local $ref to definitions.
+// Unknown keywords are ignored.
+type ContractSchema struct {
+	entryPoints map[string]*schemaRoot
+}
+
+// ParseContractSchema parses the JSON schema of the contract messages and checks that it is supported
+func ParseContractSchema(bz []byte) (*ContractSchema, error) {
+	if len(bz) > MaxCodeSchemaSize {
+		return nil, errorsmod.Wrapf(ErrLimit, "schema cannot be longer than %d bytes", MaxCodeSchemaSize)
+	}
+	var doc map[string]any
+	if err := decodeJSONWithNumbers(bz, &doc); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalid, "schema: %s", err)
+	}
+	if doc == nil {
+		return nil, errorsmod.Wrap(ErrInvalid, "schema must be a JSON object")
+	}
+	s := &ContractSchema{entryPoints: make(map[string]*schemaRoot)}
+	for _, entryPoint := range AllSchemaEntryPoints {
+		v, ok := doc[entryPoint]
+		if !ok || v == nil {
+			continue
+		}
+		root, err := compileSchemaRoot(v)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalid, "%s schema: %s", entryPoint, err)
+		}
+		s.entryPoints[entryPoint] = root
+	}
+	if len(s.entryPoints) == 0 {
+		return nil, errorsmod.Wrap(ErrEmpty, "schema has no message entry points")
+	}
+	return s, nil
+}
+
+// ParseCompressedContractSchema uncompresses and parses a gzip compressed contract schema
+func ParseCompressedContractSchema(gzipSrc []byte) (*ContractSchema, error) {
+	bz, err := UncompressContractSchema(gzipSrc)
+	if err != nil {
+		return nil, err
+	}
+	return ParseContractSchema(bz)
+}
+
+// UncompressContractSchema uncompresses a gzip compressed contract schema up to MaxCodeSchemaSize bytes
+func UncompressContractSchema(gzipSrc []byte) ([]byte, error) {
+	if !ioutils.IsGzip(gzipSrc) {
+		return nil, errorsmod.Wrap(ErrInvalid, "schema is not gzip compressed")
+	}
+	bz, err := ioutils.Uncompress(gzipSrc, int64(MaxCodeSchemaSize))
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalid, "schema: %s", err)
+	}
+	return bz, nil
+}
+
+// HasEntryPoint returns true when the schema describes the messages of the entry point
+func (s ContractSchema) HasEntryPoint(entryPoint string) bool {
+	_, ok := s.entryPoints[entryPoint]
+	return ok
+}
+
+// Variants returns the names of the message variants of the entry point in schema order.
+// These are the top level keys of the JSON object messages or the plain string values.
+func (s ContractSchema) Variants(entryPoint string) []string {
+	root, ok := s.entryPoints[entryPoint]
+	if !ok {
+		return nil
+	}
+	return root.variantNames(root.node, 0)
+}
+
+// ValidateMessage validates the contract message against the schema of the entry point.
+// Entry points without schema accept any message. The number of visited schema nodes is
+// returned so that it can be charged.
+func (s ContractSchema) ValidateMessage(entryPoint string, msg []byte) (uint64, error) {
+	root, ok := s.entryPoints[entryPoint]
+	if !ok {
+		return 0, nil
+	}
+	var doc any
+	if err := decodeJSONWithNumbers(msg, &doc); err != nil {
+		return 0, errorsmod.Wrapf(ErrSchemaViolation, "%s msg: %s", entryPoint, err)
+	}
+	v := schemaValidator{root: root}
+	if err := v.validate(root.node, doc, "$"); err != nil {
+		return v.steps, errorsmod.Wrapf(ErrSchemaViolation, "%s msg: %s", entryPoint, err)
+	}
+	return v.steps, nil
+}
+
+// decodeJSONWithNumbers decodes JSON without loss of number precision and rejects trailing data
+func decodeJSONWithNumbers(bz []byte, dst any) error {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	if err := dec.Decode(dst); err != nil {
+		return err
+	}
+	if dec.More() {
+		return fmt.Errorf("unexpected data after JSON value")
+	}
+	return nil
+}
+
+// Limits for JSON numbers that are compared by value so that the conversion costs stay small
+const (
+	maxJSONNumberLength   = 100
+	maxJSONNumberExponent = 1000
+)
+
+// parseJSONNumber returns the value of a JSON number. Numbers longer than maxJSONNumberLength or
+// with an exponent above maxJSONNumberExponent are rejected.
+func parseJSONNumber(num json.Number) (*big.Rat, bool) {
+	s := num.String()
+	if len(s) > maxJSONNumberLength {
+		return nil, false
+	}
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(s[i+1:])
+		if err != nil || exp > maxJSONNumberExponent || exp < -maxJSONNumberExponent {
+			return nil, false
+		}
+	}
+	return new(big.Rat).SetString(s)
+}
+
+// schemaRoot is a compiled entry point schema with its definitions
+type schemaRoot struct {
+	node        *schemaNode
+	definitions map[string]*schemaNode
+}
+
+// schemaNode is a compiled JSON schema
+type schemaNode struct {
+	// boolean schema: true accepts and false rejects everything
+	boolean              *bool
+	ref                  string
+	types                []string
+	enum                 []any
+	constValue           any
+	hasConst             bool
+	properties           map[string]*schemaNode
+	required             []string
+	additionalProperties *schemaNode
+	items                *schemaNode
+	tupleItems           []*schemaNode
+	minItems, maxItems   *uint64
+	oneOf, anyOf, allOf  []*schemaNode
+	minimum, maximum     *big.Rat
+	format               string
+}
+
+var supportedSchemaTypes = map[string]struct{}{
+	"null": {}, "boolean": {}, "object": {}, "array": {}, "number": {}, "string": {}, "integer": {},
+}
+
+// integerFormatBounds are the value ranges of the integer formats generated for Rust types
+var integerFormatBounds = map[string][2]*big.Rat{
+	"uint8":  {new(big.Rat), new(big.Rat).SetUint64(1<<8 - 1)},
+	"uint16": {new(big.Rat), new(big.Rat).SetUint64(1<<16 - 1)},
+	"uint32": {new(big.Rat), new(big.Rat).SetUint64(1<<32 - 1)},
+	"uint64": {new(big.Rat), new(big.Rat).SetUint64(1<<64 - 1)},
+	"int8":   {new(big.Rat).SetInt64(-1 << 7), new(big.Rat).SetInt64(1<<7 - 1)},
+	"int16":  {new(big.Rat).SetInt64(-1 << 15), new(big.Rat).SetInt64(1<<15 - 1)},
+	"int32":  {new(big.Rat).SetInt64(-1 << 31), new(big.Rat).SetInt64(1<<31 - 1)},
+	"int64":  {new(big.Rat).SetInt64(-1 << 63), new(big.Rat).SetInt64(1<<63 - 1)},
+}
+
+func compileSchemaRoot(v any) (*schemaRoot, error) {
+	root := &schemaRoot{definitions: make(map[string]*schemaNode)}
+	if obj, ok := v.(map[string]any); ok {
+		for _, key := range []string{"definitions", "$defs"} {
+			defs, ok := obj[key]
+			if !ok {
+				continue
+			}
+			defsObj, ok := defs.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s must be an object", key)
+			}
+			for _, name := range sortedKeys(defsObj) {
+				node, err := compileSchemaNode(defsObj[name])
+				if err != nil {
+					return nil, fmt.Errorf("%s %s: %w", key, name, err)
+				}
+				root.definitions["#/"+key+"/"+name] = node
+			}
+		}
+	}
+	node, err := compileSchemaNode(v)
+	if err != nil {
+		return nil, err
+	}
+	root.node = node
+	root.definitions["#"] = node
+	// nodes are checked only once, across all definitions, to keep the costs linear
+	seen := make(map[*schemaNode]struct{})
+	if err := root.checkRefs(node, seen); err != nil {
+		return nil, err
+	}
+	for _, name := range sortedKeys(root.definitions) {
+		if err := root.checkRefs(root.definitions[name], seen); err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+// checkRefs ensures that all references of the node can be resolved
+func (r *schemaRoot) checkRefs(n *schemaNode, seen map[*schemaNode]struct{}) error {
+	if _, ok := seen[n]; ok {
+		return nil
+	}
+	seen[n] = struct{}{}
+	if n.ref != "" {
+		if _, ok := r.definitions[n.ref]; !ok {
+			return fmt.Errorf("unknown reference %q", n.ref)
+		}
+	}
+	children := make([]*schemaNode, 0, len(n.properties)+len(n.tupleItems)+len(n.oneOf)+len(n.anyOf)+len(n.allOf)+2)
+	for _, name := range sortedKeys(n.properties) {
+		children = append(children, n.properties[name])
+	}
+	children = append(children, n.tupleItems...)
+	children = append(children, n.oneOf...)
+	children = append(children, n.anyOf...)
+	children = append(children, n.allOf...)
+	for _, c := range []*schemaNode{n.additionalProperties, n.items} {
+		if c != nil {
+			children = append(children, c)
+		}
+	}
+	for _, c := range children {
+		if err := r.checkRefs(c, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func compileSchemaNode(v any) (*schemaNode, error) {
+	switch x := v.(type) {
+	case bool:
+		return &schemaNode{boolean: &x}, nil
+	case map[string]any:
+		return compileSchemaObject(x)
+	default:
+		return nil, fmt.Errorf("schema must be an object or boolean")
+	}
+}
+
+func compileSchemaObject(obj map[string]any) (*schemaNode, error) {
+	n := &schemaNode{}
+	if v, ok := obj["$ref"]; ok {
+		ref, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("$ref must be a string")
+		}
+		n.ref = ref
+	}
+	if v, ok := obj["type"]; ok {
+		switch x := v.(type) {
+		case string:
+			n.types = []string{x}
+		case []any:
+			for _, t := range x {
+				s, ok := t.(string)
+				if !ok {
+					return nil, fmt.Errorf("type must be a string or an array of strings")
+				}
+				n.types = append(n.types, s)
+			}
+		default:
+			return nil, fmt.Errorf("type must be a string or an array of strings")
+		}
+		for _, t := range n.types {
+			if _, ok := supportedSchemaTypes[t]; !ok {
+				return nil, fmt.Errorf("unsupported type %q", t)
+			}
+		}
+	}
+	if v, ok := obj["enum"]; ok {
+		values, ok := v.([]any)
+		if !ok {
+			return nil, fmt.Errorf("enum must be an array")
+		}
+		n.enum = values
+	}
+	if v, ok := obj["const"]; ok {
+		n.constValue, n.hasConst = v, true
+	}
+	if v, ok := obj["properties"]; ok {
+		props, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("properties must be an object")
+		}
+		n.properties = make(map[string]*schemaNode, len(props))
+		for _, name := range sortedKeys(props) {
+			p, err := compileSchemaNode(props[name])
+			if err != nil {
+				return nil, fmt.Errorf("property %s: %w", name, err)
+			}
+			n.properties[name] = p
+		}
+	}
+	if v, ok := obj["required"]; ok {
+		required, ok := v.([]any)
+		if !ok {
+			return nil, fmt.Errorf("required must be an array of strings")
+		}
+		for _, r := range required {
+			s, ok := r.(string)
+			if !ok {
+				return nil, fmt.Errorf("required must be an array of strings")
+			}
+			n.required = append(n.required, s)
+		}
+	}
+	if v, ok := obj["additionalProperties"]; ok {
+		p, err := compileSchemaNode(v)
+		if err != nil {
+			return nil, fmt.Errorf("additionalProperties: %w", err)
+		}
+		n.additionalProperties = p
+	}
+	if v, ok := obj["items"]; ok {
+		if tuple, ok := v.([]any); ok {
+			for i, item := range tuple {
+				p, err := compileSchemaNode(item)
+				if err != nil {
+					return nil, fmt.Errorf("items %d: %w", i, err)
+				}
+				n.tupleItems = append(n.tupleItems, p)
+			}
+		} else {
+			p, err := compileSchemaNode(v)
+			if err != nil {
+				return nil, fmt.Errorf("items: %w", err)
+			}
+			n.items = p
+		}
+	}
+	for _, f := range []struct {
+		key string
+		dst **uint64
+	}{{"minItems", &n.minItems}, {"maxItems", &n.maxItems}} {
+		key, dst := f.key, f.dst
+		v, ok := obj[key]
+		if !ok {
+			continue
+		}
+		num, ok := v.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a number", key)
+		}
+		r, ok := parseJSONNumber(num)
+		if !ok || !r.IsInt() || r.Sign() < 0 || !r.Num().IsUint64() {
+			return nil, fmt.Errorf("%s must be a non negative integer", key)
+		}
+		u := r.Num().Uint64()
+		*dst = &u
+	}
+	for _, f := range []struct {
+		key string
+		dst *[]*schemaNode
+	}{{"oneOf", &n.oneOf}, {"anyOf", &n.anyOf}, {"allOf", &n.allOf}} {
+		key, dst := f.key, f.dst
+		v, ok := obj[key]
+		if !ok {
+			continue
+		}
+		list, ok := v.([]any)
+		if !ok || len(list) == 0 {
+			return nil, fmt.Errorf("%s must be a non empty array", key)
+		}
+		for i, item := range list {
+			p, err := compileSchemaNode(item)
+			if err != nil {
+				return nil, fmt.Errorf("%s %d: %w", key, i, err)
+			}
+			*dst = append(*dst, p)
+		}
+	}
+	for _, f := range []struct {
+		key string
+		dst **big.Rat
+	}{{"minimum", &n.minimum}, {"maximum", &n.maximum}} {
+		key, dst := f.key, f.dst
+		v, ok := obj[key]
+		if !ok {
+			continue
+		}
+		num, ok := v.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a number", key)
+		}
+		r, ok := parseJSONNumber(num)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a number", key)
+		}
+		*dst = r
+	}
+	if v, ok := obj["format"]; ok {
+		if s, ok := v.(string); ok {
+			n.format = s
+		}
+	}
+	return n, nil
+}
+
+// maxVariantDepth is the max nesting of oneOf and anyOf schemas that is searched for variant names
+const maxVariantDepth = 8
+
+// variantNames returns the object keys or string values that select the variants of an enum schema
+func (r *schemaRoot) variantNames(n *schemaNode, depth int) []string {
+	n = r.resolve(n)
+	if depth > maxVariantDepth {
+		return nil
+	}
+	var names []string
+	for _, e := range n.enum {
+		if s, ok := e.(string); ok {
+			names = append(names, s)
+		}
+	}
+	if n.hasConst {
+		if s, ok := n.constValue.(string); ok {
+			names = append(names, s)
+		}
+	}
+	if len(n.required) == 1 && len(n.properties) == 1 {
+		if _, ok := n.properties[n.required[0]]; ok {
+			names = append(names, n.required[0])
+		}
+	}
+	for _, branches := range [][]*schemaNode{n.oneOf, n.anyOf} {
+		for _, b := range branches {
+			names = append(names, r.variantNames(b, depth+1)...)
+		}
+	}
+	return names
+}
+
+// resolve follows the references of a node without siblings to the referenced schema
+func (r *schemaRoot) resolve(n *schemaNode) *schemaNode {
+	for i := 0; n.ref != "" && i < 32; i++ {
+		target, ok := r.definitions[n.ref]
+		if !ok {
+			return n
+		}
+		n = target
+	}
+	return n
+}
+
+// schemaValidator validates a decoded JSON value against a compiled schema and counts the visited nodes
+type schemaValidator struct {
+	root  *schemaRoot
+	steps uint64
+}
+
+func (v *schemaValidator) validate(n *schemaNode, value any, path string) error {
+	v.steps++
+	if v.steps > MaxSchemaValidationSteps {
+		return fmt.Errorf("max %d validation steps exceeded", MaxSchemaValidationSteps)
+	}
+	if n.boolean != nil {
+		if !*n.boolean {
+			return fmt.Errorf("%s: no value allowed", path)
+		}
+		return nil
+	}
+	if n.ref != "" {
+		if err := v.validate(v.root.definitions[n.ref], value, path); err != nil {
+			return err
+		}
+	}
+	if len(n.types) != 0 && !matchesAnyType(n.types, value) {
+		return fmt.Errorf("%s: expected %s, got %s", path, strings.Join(n.types, " or "), jsonTypeName(value))
+	}
+	if n.hasConst && !jsonEqual(n.constValue, value) {
+		return fmt.Errorf("%s: expected constant value", path)
+	}
+	if len(n.enum) != 0 {
+		found := false
+		for _, e := range n.enum {
+			if jsonEqual(e, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			if s, ok := value.(string); ok {
+				return fmt.Errorf("%s: unknown variant %q, expected one of: %s", path, s, strings.Join(v.root.variantNames(n, 0), ", "))
+			}
+			return fmt.Errorf("%s: value is not one of the allowed values", path)
+		}
+	}
+	switch x := value.(type) {
+	case map[string]any:
+		if err := v.validateObject(n, x, path); err != nil {
+			return err
+		}
+	case []any:
+		if err := v.validateArray(n, x, path); err != nil {
+			return err
+		}
+	case json.Number:
+		if err := validateNumber(n, x, path); err != nil {
+			return err
+		}
+	}
+	for _, b := range n.allOf {
+		if err := v.validate(b, value, path); err != nil {
+			return err
+		}
+	}
+	if len(n.oneOf) != 0 {
+		matches, firstErr := 0, error(nil)
+		for _, b := range n.oneOf {
+			if err := v.validate(b, value, path); err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			matches++
+		}
+		switch {
+		case matches == 0:
+			return v.noVariantError(n.oneOf, value, path, firstErr)
+		case matches > 1:
+			return fmt.Errorf("%s: matches more than one variant", path)
+		}
+	}
+	if len(n.anyOf) != 0 {
+		var firstErr error
+		for _, b := range n.anyOf {
+			err := v.validate(b, value, path)
+			if err == nil {
+				firstErr = nil
+				break
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		if firstErr != nil {
+			return v.noVariantError(n.anyOf, value, path, firstErr)
+		}
+	}
+	return nil
+}
+
+// noVariantError returns the error of the variant selected by the object key or string value.
+// Otherwise, the unknown variant is reported with the expected variants.
+func (v *schemaValidator) noVariantError(branches []*schemaNode, value any, path string, firstErr error) error {
+	var selector string
+	switch x := value.(type) {
+	case map[string]any:
+		if len(x) != 1 {
+			return firstErr
+		}
+		for k := range x {
+			selector = k
+		}
+	case string:
+		selector = x
+	default:
+		return firstErr
+	}
+	var expected []string
+	for _, b := range branches {
+		names := v.root.variantNames(b, 0)
+		for _, name := range names {
+			if name == selector {
+				return v.validate(b, value, path)
+			}
+		}
+		expected = append(expected, names...)
+	}
+	if len(expected) == 0 {
+		return firstErr
+	}
+	return fmt.Errorf("%s: unknown variant %q, expected one of: %s", path, selector, strings.Join(expected, ", "))
+}
+
+func (v *schemaValidator) validateObject(n *schemaNode, obj map[string]any, path string) error {
+	for _, name := range n.required {
+		if _, ok := obj[name]; !ok {
+			return fmt.Errorf("%s: missing field %q", path, name)
+		}
+	}
+	for _, name := range sortedKeys(obj) {
+		fieldPath := path + "." + name
+		if p, ok := n.properties[name]; ok {
+			if err := v.validate(p, obj[name], fieldPath); err != nil {
+				return err
+			}
+			continue
+		}
+		if n.additionalProperties != nil {
+			if n.additionalProperties.boolean != nil && !*n.additionalProperties.boolean {
+				return fmt.Errorf("%s: unknown field %q", path, name)
+			}
+			if err := v.validate(n.additionalProperties, obj[name], fieldPath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *schemaValidator) validateArray(n *schemaNode, arr []any, path string) error {
+	if n.minItems != nil && uint64(len(arr)) < *n.minItems {
+		return fmt.Errorf("%s: expected at least %d items", path, *n.minItems)
+	}
+	if n.maxItems != nil && uint64(len(arr)) > *n.maxItems {
+		return fmt.Errorf("%s: expected at most %d items", path, *n.maxItems)
+	}
+	for i, item := range arr {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i < len(n.tupleItems):
+			if err := v.validate(n.tupleItems[i], item, itemPath); err != nil {
+				return err
+			}
+		case n.items != nil:
+			if err := v.validate(n.items, item, itemPath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateNumber(n *schemaNode, num json.Number, path string) error {
+	r, ok := parseJSONNumber(num)
+	if !ok {
+		return fmt.Errorf("%s: invalid number", path)
+	}
+	if n.minimum != nil && r.Cmp(n.minimum) < 0 {
+		return fmt.Errorf("%s: must be at least %s", path, n.minimum.RatString())
+	}
+	if n.maximum != nil && r.Cmp(n.maximum) > 0 {
+		return fmt.Errorf("%s: must be at most %s", path, n.maximum.RatString())
+	}
+	if bounds, ok := integerFormatBounds[n.format]; ok {
+		if !r.IsInt() || r.Cmp(bounds[0]) < 0 || r.Cmp(bounds[1]) > 0 {
+			return fmt.Errorf("%s: out of range for %s", path, n.format)
+		}
+	}
+	return nil
+}
+
+func matchesAnyType(types []string, value any) bool {
+	for _, t := range types {
+		switch x := value.(type) {
+		case nil:
+			if t == "null" {
+				return true
+			}
+		case bool:
+			if t == "boolean" {
+				return true
+			}
+		case string:
+			if t == "string" {
+				return true
+			}
+		case map[string]any:
+			if t == "object" {
+				return true
+			}
+		case []any:
+			if t == "array" {
+				return true
+			}
+		case json.Number:
+			if t == "number" {
+				return true
+			}
+			if t == "integer" {
+				if r, ok := parseJSONNumber(x); ok && r.IsInt() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func jsonTypeName(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case json.Number:
+		return "number"
+	default:
+		return "unknown"
+	}
+}
+
+// jsonEqual compares decoded JSON values. Numbers are compared by value.
+func jsonEqual(a, b any) bool {
+	switch x := a.(type) {
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		rx, okx := parseJSONNumber(x)
+		ry, oky := parseJSONNumber(y)
+		return okx && oky && rx.Cmp(ry) == 0
+	case map[string]any:
+		y, ok := b.(map[string]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k, xv := range x {
+			yv, ok := y[k]
+			if !ok || !jsonEqual(xv, yv) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		y, ok := b.([]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !jsonEqual(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,268 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+)
+
+const testContractSchema = `{
+  "contract_name": "example",
+  "contract_version": "1.0.0",
+  "idl_version": "1.0.0",
+  "instantiate": {
+    "type": "object",
+    "required": ["owner"],
+    "properties": {
+      "owner": {"type": "string"},
+      "limit": {"type": ["integer", "null"], "format": "uint32", "minimum": 0.0}
+    },
+    "additionalProperties": false
+  },
+  "execute": {
+    "oneOf": [
+      {"type": "string", "enum": ["reset"]},
+      {
+        "type": "object",
+        "required": ["transfer"],
+        "properties": {
+          "transfer": {
+            "type": "object",
+            "required": ["amount", "recipient"],
+            "properties": {
+              "amount": {"$ref": "#/definitions/Uint128"},
+              "recipient": {"type": "string"},
+              "memo": {"anyOf": [{"$ref": "#/definitions/Memo"}, {"type": "null"}]}
+            },
+            "additionalProperties": false
+          }
+        },
+        "additionalProperties": false
+      },
+      {
+        "type": "object",
+        "required": ["swap"],
+        "properties": {
+          "swap": {
+            "type": "object",
+            "required": ["path"],
+            "properties": {
+              "path": {"type": "array", "items": {"type": "string"}, "minItems": 1, "maxItems": 3}
+            },
+            "additionalProperties": false
+          }
+        },
+        "additionalProperties": false
+      }
+    ],
+    "definitions": {
+      "Uint128": {"type": "string"},
+      "Memo": {"type": "object", "required": ["text"], "properties": {"text": {"type": "string"}}}
+    }
+  },
+  "query": null,
+  "migrate": null,
+  "sudo": null,
+  "responses": {}
+}`
+
+func TestParseContractSchema(t *testing.T) {
+	specs := map[string]struct {
+		src    string
+		expErr error
+	}{
+		"cosmwasm-schema file": {
+			src: testContractSchema,
+		},
+		"not an object": {
+			src:    `[]`,
+			expErr: ErrInvalid,
+		},
+		"invalid json": {
+			src:    `{"execute":`,
+			expErr: ErrInvalid,
+		},
+		"no entry points": {
+			src:    `{"contract_name":"example","responses":{}}`,
+			expErr: ErrEmpty,
+		},
+		"unknown reference": {
+			src:    `{"execute":{"$ref":"#/definitions/Unknown"}}`,
+			expErr: ErrInvalid,
+		},
+		"unsupported type": {
+			src:    `{"execute":{"type":"date"}}`,
+			expErr: ErrInvalid,
+		},
+		"invalid required": {
+			src:    `{"execute":{"type":"object","required":"a"}}`,
+			expErr: ErrInvalid,
+		},
+		"empty oneOf": {
+			src:    `{"execute":{"oneOf":[]}}`,
+			expErr: ErrInvalid,
+		},
+		"trailing data": {
+			src:    `{"execute":true} {}`,
+			expErr: ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			_, gotErr := ParseContractSchema([]byte(spec.src))
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+// compressedTestContractSchema returns the gzip compressed test contract schema
+func compressedTestContractSchema(t testing.TB) []byte {
+	t.Helper()
+	compressed, err := ioutils.GzipIt([]byte(testContractSchema))
+	require.NoError(t, err)
+	return compressed
+}
+
+func TestParseCompressedContractSchema(t *testing.T) {
+	schema, err := ParseCompressedContractSchema(compressedTestContractSchema(t))
+	require.NoError(t, err)
+	assert.True(t, schema.HasEntryPoint(SchemaEntryPointExecute))
+
+	_, err = ParseCompressedContractSchema([]byte(testContractSchema))
+	require.ErrorIs(t, err, ErrInvalid)
+}
+
+func TestContractSchemaValidateMessage(t *testing.T) {
+	schema, err := ParseContractSchema([]byte(testContractSchema))
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		entryPoint string
+		msg        string
+		expErr     string
+	}{
+		"instantiate": {
+			entryPoint: SchemaEntryPointInstantiate,
+			msg:        `{"owner":"alice","limit":10}`,
+		},
+		"instantiate with null": {
+			entryPoint: SchemaEntryPointInstantiate,
+			msg:        `{"owner":"alice","limit":null}`,
+		},
+		"instantiate missing field": {
+			entryPoint: SchemaEntryPointInstantiate,
+			msg:        `{"limit":10}`,
+			expErr:     `instantiate msg: $: missing field "owner": schema violation`,
+		},
+		"instantiate unknown field": {
+			entryPoint: SchemaEntryPointInstantiate,
+			msg:        `{"owner":"alice","other":1}`,
+			expErr:     `instantiate msg: $: unknown field "other": schema violation`,
+		},
+		"instantiate negative uint": {
+			entryPoint: SchemaEntryPointInstantiate,
+			msg:        `{"owner":"alice","limit":-1}`,
+			expErr:     `instantiate msg: $.limit: must be at least 0: schema violation`,
+		},
+		"instantiate uint32 overflow": {
+			entryPoint: SchemaEntryPointInstantiate,
+			msg:        `{"owner":"alice","limit":4294967296}`,
+			expErr:     `instantiate msg: $.limit: out of range for uint32: schema violation`,
+		},
+		"instantiate not an integer": {
+			entryPoint: SchemaEntryPointInstantiate,
+			msg:        `{"owner":"alice","limit":1.5}`,
+			expErr:     `instantiate msg: $.limit: expected integer or null, got number: schema violation`,
+		},
+		"execute object variant": {
+			entryPoint: SchemaEntryPointExecute,
+			msg:        `{"transfer":{"amount":"100","recipient":"bob","memo":{"text":"hi"}}}`,
+		},
+		"execute unit variant": {
+			entryPoint: SchemaEntryPointExecute,
+			msg:        `"reset"`,
+		},
+		"execute array": {
+			entryPoint: SchemaEntryPointExecute,
+			msg:        `{"swap":{"path":["a","b","c"]}}`,
+		},
+		"execute unknown variant": {
+			entryPoint: SchemaEntryPointExecute,
+			msg:        `{"burn":{}}`,
+			expErr:     `execute msg: $: unknown variant "burn", expected one of: reset, transfer, swap: schema violation`,
+		},
+		"execute unknown unit variant": {
+			entryPoint: SchemaEntryPointExecute,
+			msg:        `"stop"`,
+			expErr:     `execute msg: $: unknown variant "stop", expected one of: reset, transfer, swap: schema violation`,
+		},
+		"execute invalid field type": {
+			entryPoint: SchemaEntryPointExecute,
+			msg:        `{"transfer":{"amount":100,"recipient":"bob"}}`,
+			expErr:     `execute msg: $.transfer.amount: expected string, got number: schema violation`,
+		},
+		"execute nested error in anyOf": {
+			entryPoint: SchemaEntryPointExecute,
+			msg:        `{"transfer":{"amount":"100","recipient":"bob","memo":{"text":1}}}`,
+			expErr:     `execute msg: $.transfer.memo.text: expected string, got number: schema violation`,
+		},
+		"execute too many items": {
+			entryPoint: SchemaEntryPointExecute,
+			msg:        `{"swap":{"path":["a","b","c","d"]}}`,
+			expErr:     `execute msg: $.swap.path: expected at most 3 items: schema violation`,
+		},
+		"execute number exponent too large": {
+			entryPoint: SchemaEntryPointInstantiate,
+			msg:        `{"owner":"alice","limit":1e999999}`,
+			expErr:     `instantiate msg: $.limit: expected integer or null, got number: schema violation`,
+		},
+		"execute invalid json": {
+			entryPoint: SchemaEntryPointExecute,
+			msg:        `{"transfer":`,
+			expErr:     `execute msg: unexpected EOF: schema violation`,
+		},
+		"entry point without schema": {
+			entryPoint: SchemaEntryPointMigrate,
+			msg:        `{"anything":{}}`,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			steps, gotErr := schema.ValidateMessage(spec.entryPoint, []byte(spec.msg))
+			if spec.expErr != "" {
+				require.ErrorIs(t, gotErr, ErrSchemaViolation)
+				assert.Equal(t, spec.expErr, gotErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			if spec.entryPoint != SchemaEntryPointMigrate {
+				assert.NotZero(t, steps)
+			}
+		})
+	}
+}
+
+func TestContractSchemaValidateMessageStepLimit(t *testing.T) {
+	schema, err := ParseContractSchema([]byte(`{"execute":{"$ref":"#/definitions/A","definitions":{"A":{"$ref":"#/definitions/A"}}}}`))
+	require.NoError(t, err)
+
+	steps, err := schema.ValidateMessage(SchemaEntryPointExecute, []byte(`{}`))
+	require.ErrorIs(t, err, ErrSchemaViolation)
+	assert.Equal(t, MaxSchemaValidationSteps+1, steps)
+}
+
+func TestContractSchemaVariants(t *testing.T) {
+	schema, err := ParseContractSchema([]byte(testContractSchema))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"reset", "transfer", "swap"}, schema.Variants(SchemaEntryPointExecute))
+	assert.Empty(t, schema.Variants(SchemaEntryPointInstantiate))
+	assert.Nil(t, schema.Variants(SchemaEntryPointQuery))
+}
@@ -92,6 +92,18 @@ var (
 
 	// ErrExceedMaxCallDepth error if max message stack size is exceeded
 	ErrExceedMaxCallDepth = errorsmod.Register(DefaultCodespace, 30, "max call depth exceeded")
+
+	// ErrContractFrozen error if a contract was frozen by governance
+	ErrContractFrozen = errorsmod.Register(DefaultCodespace, 31, "contract frozen")
+
+	// ErrCodeDeprecated error if a code id or its checksum was deprecated by governance
+	ErrCodeDeprecated = errorsmod.Register(DefaultCodespace, 32, "code deprecated")
+
+	// ErrInsufficientStorageDeposit error if the deposit for the contract storage can not be paid
+	ErrInsufficientStorageDeposit = errorsmod.Register(DefaultCodespace, 33, "insufficient storage deposit")
+
+	// ErrSchemaViolation error if a contract message does not match the JSON schema of the code
+	ErrSchemaViolation = errorsmod.Register(DefaultCodespace, 34, "schema violation")
 )
 
 // WasmVMErrorable mapped error type in wasmvm and are not redacted
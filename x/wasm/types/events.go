@@ -28,6 +28,37 @@ const (
 	EventTypeUpdateContractLabel    = "update_contract_label"
 	EventTypeUpdateCodeAccessConfig = "update_code_access_config"
 	EventTypePacketRecv             = "ibc_packet_received"
+	EventTypeFreezeContract         = "freeze_contract"
+	EventTypeUnfreezeContract       = "unfreeze_contract"
+	EventTypeDeprecateCode          = "deprecate_code"
+	EventTypeUndeprecateCode        = "undeprecate_code"
+	EventTypeRegisterCronSchedule   = "register_cron_schedule"
+	EventTypeRemoveCronSchedule     = "remove_cron_schedule"
+	EventTypeCronExecution          = "cron_execution"
+	EventTypeSubscribeEpochHook     = "subscribe_epoch_hook"
+	EventTypeUnsubscribeEpochHook   = "unsubscribe_epoch_hook"
+	EventTypeEpochHookExecution     = "epoch_hook_execution"
+	EventTypeRegisterFeeShare       = "register_fee_share"
+	EventTypeCancelFeeShare         = "cancel_fee_share"
+	EventTypeDistributeFeeShare     = "distribute_fee_share"
+	EventTypeSetFeeSponsorship      = "set_fee_sponsorship"
+	EventTypeRemoveFeeSponsorship   = "remove_fee_sponsorship"
+	EventTypeSponsorFee             = "sponsor_fee"
+	EventTypeProposeContractAdmin   = "propose_contract_admin"
+	EventTypeCancelAdminTransfer    = "cancel_contract_admin_transfer"
+	EventTypeSetCodeMetadata        = "set_code_metadata"
+	EventTypeRegisterContractName   = "register_contract_name"
+	EventTypeTransferContractName   = "transfer_contract_name"
+	EventTypeReleaseContractName    = "release_contract_name"
+	EventTypeStorageDeposit         = "storage_deposit"
+	EventTypeStorageRefund          = "storage_refund"
+	EventTypeDeleteContract         = "delete_contract"
+	EventTypeAutoPinCode            = "auto_pin_code"
+	EventTypeAutoUnpinCode          = "auto_unpin_code"
+	EventTypeAutoPinCodeFailed      = "auto_pin_code_failed"
+	EventTypeAutoUnpinCodeFailed    = "auto_unpin_code_failed"
+	EventTypeSetCodeGasMultiplier   = "set_code_gas_multiplier"
+	EventTypeSetCodeSchema          = "set_code_schema"
 	// add new types to IsAcceptedEventOnRecvPacketErrorAck
 )
 
@@ -77,4 +108,26 @@ const (
 	AttributeKeyAuthorizedAddresses = "authorized_addresses"
 	AttributeKeyAckSuccess          = "success"
 	AttributeKeyAckError            = "error"
+	AttributeKeyCronSchedule        = "schedule_name"
+	AttributeKeyCronSuccess         = "success"
+	AttributeKeyCronError           = "error"
+	AttributeKeyCronDisabled        = "schedule_disabled"
+	AttributeKeyEpochIdentifier     = "epoch_identifier"
+	AttributeKeyEpochNumber         = "epoch_number"
+	AttributeKeyEpochHookSuccess    = "success"
+	AttributeKeyEpochHookError      = "error"
+	AttributeKeyWithdrawAddress     = "withdraw_address"
+	AttributeKeyFeeShareAmount      = "amount"
+	AttributeKeyFeePayer            = "fee_payer"
+	AttributeKeySponsoredFee        = "fee"
+	AttributeKeyContractName        = "contract_name"
+	AttributeKeyPreviousContract    = "previous_contract_address"
+	AttributeKeyDepositPayer        = "payer"
+	AttributeKeyDepositAmount       = "amount"
+	AttributeKeySweepRecipient      = "sweep_recipient"
+	AttributeKeyExecutions          = "executions"
+	AttributeKeyCodeSize            = "code_size"
+	AttributeKeyAutoPinError        = "error"
+	AttributeKeyMultiplierBps       = "multiplier_bps"
+	AttributeKeyValidateMessages    = "validate_messages"
 )
@@ -16,16 +16,32 @@ type ViewKeeper interface {
 	GetContractHistory(ctx context.Context, contractAddr sdk.AccAddress) []ContractCodeHistoryEntry
 	QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
 	QueryRaw(ctx context.Context, contractAddress sdk.AccAddress, key []byte) []byte
+	QueryRawRange(ctx context.Context, contractAddress sdk.AccAddress, start, end []byte, limit uint16, reverse bool) (results []wasmvmtypes.RawRangeEntry, nextKey []byte)
 	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
 	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *ContractInfo
 	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, ContractInfo) bool)
 	IterateContractsByCreator(ctx context.Context, creator sdk.AccAddress, cb func(address sdk.AccAddress) bool)
+	IterateContractsByAdmin(ctx context.Context, admin sdk.AccAddress, cb func(address sdk.AccAddress) bool)
+	IterateCodesByChecksum(ctx context.Context, checksum []byte, cb func(codeID uint64) bool)
+	IterateContractsByStorageSize(ctx context.Context, cb func(address sdk.AccAddress, usage ContractStorageUsage) bool)
 	IterateContractsByCode(ctx context.Context, codeID uint64, cb func(address sdk.AccAddress) bool)
 	IterateContractState(ctx context.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
 	GetCodeInfo(ctx context.Context, codeID uint64) *CodeInfo
 	IterateCodeInfos(ctx context.Context, cb func(uint64, CodeInfo) bool)
 	GetByteCode(ctx context.Context, codeID uint64) ([]byte, error)
 	IsPinnedCode(ctx context.Context, codeID uint64) bool
+	IsContractFrozen(ctx context.Context, contractAddress sdk.AccAddress) bool
+	IsCodeDeprecated(ctx context.Context, codeID uint64) bool
+	GetCronSchedule(ctx context.Context, name string) *CronSchedule
+	GetFeeShare(ctx context.Context, contractAddr sdk.AccAddress) *FeeShare
+	GetFeeSponsorship(ctx context.Context, contractAddr sdk.AccAddress) *FeeSponsorship
+	GetPendingAdminTransfer(ctx context.Context, contractAddr sdk.AccAddress) *PendingAdminTransfer
+	GetContractName(ctx context.Context, contractAddr sdk.AccAddress) string
+	GetContractStorageUsage(ctx context.Context, contractAddr sdk.AccAddress) ContractStorageUsage
+	GetStorageDeposit(ctx context.Context, contractAddr sdk.AccAddress) *sdk.Coin
+	GetContractByName(ctx context.Context, name string) sdk.AccAddress
+	GetCodeGasMultiplier(ctx context.Context, codeID uint64) *CodeGasMultiplier
+	GetCodeSchema(ctx context.Context, codeID uint64) *CodeSchema
 	GetParams(ctx context.Context) Params
 	GetWasmLimits() wasmvmtypes.WasmLimits
 }
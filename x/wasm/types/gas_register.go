@@ -144,6 +144,85 @@ func DefaultGasRegisterConfig() WasmGasRegisterConfig {
 	}
 }
 
+// ValidateBasic performs basic validation of the on-chain gas costs
+func (p GasRegisterParams) ValidateBasic() error {
+	if p.GasMultiplier == 0 {
+		return errorsmod.Wrap(ErrEmpty, "gas multiplier")
+	}
+	if p.UncompressCostDenominator == 0 {
+		return errorsmod.Wrap(ErrEmpty, "uncompress cost denominator")
+	}
+	return nil
+}
+
+// Config returns the gas register config for the on-chain gas costs
+func (p GasRegisterParams) Config() WasmGasRegisterConfig {
+	return WasmGasRegisterConfig{
+		InstanceCost:         p.InstanceCost,
+		InstanceCostDiscount: p.InstanceCostDiscount,
+		CompileCost:          p.CompileCost,
+		UncompressCost: wasmvmtypes.UFraction{
+			Numerator:   p.UncompressCostNumerator,
+			Denominator: p.UncompressCostDenominator,
+		},
+		GasMultiplier:              p.GasMultiplier,
+		EventPerAttributeCost:      p.EventPerAttributeCost,
+		EventAttributeDataCost:     p.EventAttributeDataCost,
+		EventAttributeDataFreeTier: p.EventAttributeDataFreeTier,
+		ContractMessageDataCost:    p.ContractMessageDataCost,
+		CustomEventCost:            p.CustomEventCost,
+	}
+}
+
+// CodeGasRegister applies the gas multiplier of a code to the setup costs and the
+// wasmvm gas conversion of the parent gas register
+type CodeGasRegister struct {
+	GasRegister
+	multiplierBps uint64
+}
+
+// NewCodeGasRegister constructor. The multiplier is in basis points (1/10000).
+func NewCodeGasRegister(parent GasRegister, multiplierBps uint32) CodeGasRegister {
+	if multiplierBps == 0 {
+		panic(errorsmod.Wrap(sdkerrors.ErrLogic, "multiplier can not be 0"))
+	}
+	return CodeGasRegister{GasRegister: parent, multiplierBps: uint64(multiplierBps)}
+}
+
+// SetupContractCost costs of the parent gas register with the multiplier applied
+func (g CodeGasRegister) SetupContractCost(discount bool, msgLen int) storetypes.Gas {
+	return mulDiv(g.GasRegister.SetupContractCost(discount, msgLen), g.multiplierBps, bpsDenominator)
+}
+
+// ReplyCosts costs of the parent gas register with the multiplier applied
+func (g CodeGasRegister) ReplyCosts(discount bool, reply wasmvmtypes.Reply) storetypes.Gas {
+	return mulDiv(g.GasRegister.ReplyCosts(discount, reply), g.multiplierBps, bpsDenominator)
+}
+
+// ToWasmVMGas converts from Cosmos SDK gas units to wasmvm gas. The wasmvm gas is
+// divided by the multiplier so that the contract gets less or more runtime gas for
+// the same amount of SDK gas.
+func (g CodeGasRegister) ToWasmVMGas(source storetypes.Gas) uint64 {
+	return mulDiv(g.GasRegister.ToWasmVMGas(source), bpsDenominator, g.multiplierBps)
+}
+
+// FromWasmVMGas converts from wasmvm gas to Cosmos SDK gas units with the multiplier applied
+func (g CodeGasRegister) FromWasmVMGas(source uint64) storetypes.Gas {
+	return mulDiv(g.GasRegister.FromWasmVMGas(source), g.multiplierBps, bpsDenominator)
+}
+
+// bpsDenominator is the basis points of a multiplier of 1
+const bpsDenominator = 10_000
+
+// mulDiv returns x * numerator / denominator and panics with out of gas on overflow
+func mulDiv(x, numerator, denominator uint64) uint64 {
+	r := sdkmath.NewIntFromUint64(x).Mul(sdkmath.NewIntFromUint64(numerator)).Quo(sdkmath.NewIntFromUint64(denominator))
+	if !r.IsUint64() {
+		panic(storetypes.ErrorGasOverflow{Descriptor: "code gas multiplier"})
+	}
+	return r.Uint64()
+}
+
 // WasmGasRegister implements GasRegister interface
 type WasmGasRegister struct {
 	c WasmGasRegisterConfig
@@ -367,3 +367,47 @@ func TestUncompressCosts(t *testing.T) {
 		})
 	}
 }
+
+func TestCodeGasRegister(t *testing.T) {
+	specs := map[string]struct {
+		multiplierBps  uint32
+		expSetupCost   storetypes.Gas
+		expReplyCost   storetypes.Gas
+		expToWasmVMGas uint64
+		expFromWasmVM  storetypes.Gas
+	}{
+		"regular price": {
+			multiplierBps:  10_000,
+			expSetupCost:   DefaultInstanceCost,
+			expReplyCost:   DefaultInstanceCostDiscount,
+			expToWasmVMGas: 1_000 * DefaultGasMultiplier,
+			expFromWasmVM:  1_000,
+		},
+		"discount": {
+			multiplierBps:  5_000,
+			expSetupCost:   DefaultInstanceCost / 2,
+			expReplyCost:   DefaultInstanceCostDiscount / 2,
+			expToWasmVMGas: 2_000 * DefaultGasMultiplier,
+			expFromWasmVM:  500,
+		},
+		"surcharge": {
+			multiplierBps:  20_000,
+			expSetupCost:   DefaultInstanceCost * 2,
+			expReplyCost:   DefaultInstanceCostDiscount * 2,
+			expToWasmVMGas: 500 * DefaultGasMultiplier,
+			expFromWasmVM:  2_000,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			r := NewCodeGasRegister(NewDefaultWasmGasRegister(), spec.multiplierBps)
+			assert.Equal(t, spec.expSetupCost, r.SetupContractCost(false, 0))
+			assert.Equal(t, spec.expReplyCost, r.ReplyCosts(true, wasmvmtypes.Reply{Result: wasmvmtypes.SubMsgResult{Ok: &wasmvmtypes.SubMsgResponse{}}}))
+			assert.Equal(t, spec.expToWasmVMGas, r.ToWasmVMGas(1_000))
+			assert.Equal(t, spec.expFromWasmVM, r.FromWasmVMGas(1_000*DefaultGasMultiplier))
+			// not affected
+			assert.Equal(t, NewDefaultWasmGasRegister().UncompressCosts(100), r.UncompressCosts(100))
+		})
+	}
+	assert.Panics(t, func() { NewCodeGasRegister(NewDefaultWasmGasRegister(), 0) })
+}
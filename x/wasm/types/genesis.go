@@ -33,6 +33,143 @@ func (s GenesisState) ValidateBasic() error {
 			return errorsmod.Wrapf(err, "sequence: %d", i)
 		}
 	}
+	for i := range s.DeprecatedChecksums {
+		if err := ValidateChecksum(s.DeprecatedChecksums[i]); err != nil {
+			return errorsmod.Wrapf(err, "deprecated checksum: %d", i)
+		}
+	}
+	cronNames := make(map[string]struct{}, len(s.CronSchedules))
+	for i := range s.CronSchedules {
+		if err := s.CronSchedules[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "cron schedule: %d", i)
+		}
+		if _, ok := cronNames[s.CronSchedules[i].Name]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "cron schedule: %s", s.CronSchedules[i].Name)
+		}
+		cronNames[s.CronSchedules[i].Name] = struct{}{}
+	}
+	subscriptions := make(map[string]struct{}, len(s.EpochHookSubscriptions))
+	for i := range s.EpochHookSubscriptions {
+		sub := s.EpochHookSubscriptions[i]
+		if err := sub.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "epoch hook subscription: %d", i)
+		}
+		key := string(GetEpochHookSubscriptionKey(sub.EpochIdentifier, sdk.MustAccAddressFromBech32(sub.Contract)))
+		if _, ok := subscriptions[key]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "epoch hook subscription: %s %s", sub.EpochIdentifier, sub.Contract)
+		}
+		subscriptions[key] = struct{}{}
+	}
+	feeShares := make(map[string]struct{}, len(s.FeeShares))
+	for i := range s.FeeShares {
+		if err := s.FeeShares[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "fee share: %d", i)
+		}
+		if _, ok := feeShares[s.FeeShares[i].Contract]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "fee share: %s", s.FeeShares[i].Contract)
+		}
+		feeShares[s.FeeShares[i].Contract] = struct{}{}
+	}
+	sponsorships := make(map[string]struct{}, len(s.FeeSponsorships))
+	for i := range s.FeeSponsorships {
+		if err := s.FeeSponsorships[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "fee sponsorship: %d", i)
+		}
+		if _, ok := sponsorships[s.FeeSponsorships[i].Contract]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "fee sponsorship: %s", s.FeeSponsorships[i].Contract)
+		}
+		sponsorships[s.FeeSponsorships[i].Contract] = struct{}{}
+	}
+	adminTransfers := make(map[string]struct{}, len(s.PendingAdminTransfers))
+	for i := range s.PendingAdminTransfers {
+		if err := s.PendingAdminTransfers[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "pending admin transfer: %d", i)
+		}
+		if _, ok := adminTransfers[s.PendingAdminTransfers[i].Contract]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "pending admin transfer: %s", s.PendingAdminTransfers[i].Contract)
+		}
+		adminTransfers[s.PendingAdminTransfers[i].Contract] = struct{}{}
+	}
+	names := make(map[string]struct{}, len(s.ContractNames))
+	namedContracts := make(map[string]struct{}, len(s.ContractNames))
+	for i := range s.ContractNames {
+		if err := s.ContractNames[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "contract name: %d", i)
+		}
+		if _, ok := names[s.ContractNames[i].Name]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "contract name: %s", s.ContractNames[i].Name)
+		}
+		names[s.ContractNames[i].Name] = struct{}{}
+		if _, ok := namedContracts[s.ContractNames[i].Contract]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "named contract: %s", s.ContractNames[i].Contract)
+		}
+		namedContracts[s.ContractNames[i].Contract] = struct{}{}
+	}
+	deposits := make(map[string]struct{}, len(s.StorageDeposits))
+	for i := range s.StorageDeposits {
+		if err := s.StorageDeposits[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "storage deposit: %d", i)
+		}
+		if _, ok := deposits[s.StorageDeposits[i].Contract]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "storage deposit: %s", s.StorageDeposits[i].Contract)
+		}
+		deposits[s.StorageDeposits[i].Contract] = struct{}{}
+	}
+	contracts := make(map[string]struct{}, len(s.Contracts))
+	for i := range s.Contracts {
+		contracts[s.Contracts[i].ContractAddress] = struct{}{}
+	}
+	for i := range s.DeletedContracts {
+		if err := s.DeletedContracts[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "deleted contract: %d", i)
+		}
+		if _, ok := contracts[s.DeletedContracts[i].ContractAddress]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "deleted contract: %s", s.DeletedContracts[i].ContractAddress)
+		}
+		contracts[s.DeletedContracts[i].ContractAddress] = struct{}{}
+	}
+	executionCounts := make(map[string]struct{}, len(s.CodeExecutionCounts))
+	for i := range s.CodeExecutionCounts {
+		c := s.CodeExecutionCounts[i]
+		if err := c.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "code execution count: %d", i)
+		}
+		key := string(GetCodeExecutionCountKey(c.Height, c.CodeID))
+		if _, ok := executionCounts[key]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "code execution count: %d %d", c.Height, c.CodeID)
+		}
+		executionCounts[key] = struct{}{}
+	}
+	autoPinned := make(map[uint64]struct{}, len(s.AutoPinnedCodes))
+	for i := range s.AutoPinnedCodes {
+		if err := s.AutoPinnedCodes[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "auto pinned code: %d", i)
+		}
+		if _, ok := autoPinned[s.AutoPinnedCodes[i].CodeID]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "auto pinned code: %d", s.AutoPinnedCodes[i].CodeID)
+		}
+		autoPinned[s.AutoPinnedCodes[i].CodeID] = struct{}{}
+	}
+	gasMultipliers := make(map[uint64]struct{}, len(s.CodeGasMultipliers))
+	for i := range s.CodeGasMultipliers {
+		if err := s.CodeGasMultipliers[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "code gas multiplier: %d", i)
+		}
+		if _, ok := gasMultipliers[s.CodeGasMultipliers[i].CodeID]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "code gas multiplier: %d", s.CodeGasMultipliers[i].CodeID)
+		}
+		gasMultipliers[s.CodeGasMultipliers[i].CodeID] = struct{}{}
+	}
+	schemas := make(map[uint64]struct{}, len(s.CodeSchemas))
+	for i := range s.CodeSchemas {
+		if err := s.CodeSchemas[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "code schema: %d", i)
+		}
+		if _, ok := schemas[s.CodeSchemas[i].CodeID]; ok {
+			return errorsmod.Wrapf(ErrDuplicate, "code schema: %d", s.CodeSchemas[i].CodeID)
+		}
+		schemas[s.CodeSchemas[i].CodeID] = struct{}{}
+	}
 
 	return nil
 }
@@ -77,6 +214,64 @@ func (c Contract) ValidateBasic() error {
 	return nil
 }
 
+func (c DeletedContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(c.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "contract address")
+	}
+	if len(c.ContractCodeHistory) == 0 {
+		return ErrEmpty.Wrap("code history")
+	}
+	for i, v := range c.ContractCodeHistory {
+		if err := v.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "code history element %d", i)
+		}
+	}
+	if c.ContractCodeHistory[len(c.ContractCodeHistory)-1].Operation != ContractCodeHistoryOperationTypeDelete {
+		return errorsmod.Wrap(ErrInvalid, "code history must end with a delete operation")
+	}
+	return nil
+}
+
+func (c CodeExecutionCount) ValidateBasic() error {
+	if c.Height <= 0 {
+		return errorsmod.Wrap(ErrInvalid, "height")
+	}
+	if c.CodeID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "code id")
+	}
+	if c.Executions == 0 {
+		return errorsmod.Wrap(ErrEmpty, "executions")
+	}
+	return nil
+}
+
+func (c AutoPinnedCode) ValidateBasic() error {
+	if c.CodeID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "code id")
+	}
+	if c.CodeSize == 0 {
+		return errorsmod.Wrap(ErrEmpty, "code size")
+	}
+	return nil
+}
+
+func (c CodeGasMultiplier) ValidateBasic() error {
+	if c.CodeID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "code id")
+	}
+	return validateCodeGasMultiplierBps(c.MultiplierBps)
+}
+
+func (c CodeSchema) ValidateBasic() error {
+	if c.CodeID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "code id")
+	}
+	if _, err := ParseCompressedContractSchema(c.Schema); err != nil {
+		return errorsmod.Wrap(err, "schema")
+	}
+	return nil
+}
+
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
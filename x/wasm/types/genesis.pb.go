@@ -34,6 +34,34 @@ type GenesisState struct {
 	Codes     []Code     `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
 	Contracts []Contract `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
 	Sequences []Sequence `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	// DeprecatedChecksums are the code checksums deprecated by governance
+	DeprecatedChecksums [][]byte `protobuf:"bytes,5,rep,name=deprecated_checksums,json=deprecatedChecksums,proto3" json:"deprecated_checksums,omitempty"`
+	// CronSchedules are the contract sudo calls registered by governance
+	CronSchedules []CronSchedule `protobuf:"bytes,6,rep,name=cron_schedules,json=cronSchedules,proto3" json:"cron_schedules,omitempty"`
+	// EpochHookSubscriptions are the contract subscriptions to epoch ends
+	EpochHookSubscriptions []EpochHookSubscription `protobuf:"bytes,7,rep,name=epoch_hook_subscriptions,json=epochHookSubscriptions,proto3" json:"epoch_hook_subscriptions,omitempty"`
+	// FeeShares are the contract registrations for a share of the tx fees
+	FeeShares []FeeShare `protobuf:"bytes,8,rep,name=fee_shares,json=feeShares,proto3" json:"fee_shares,omitempty"`
+	// FeeSponsorships are the contracts that pay the fees of txs executing them
+	FeeSponsorships []FeeSponsorship `protobuf:"bytes,9,rep,name=fee_sponsorships,json=feeSponsorships,proto3" json:"fee_sponsorships,omitempty"`
+	// PendingAdminTransfers are the proposed contract admin changes that were
+	// not accepted yet
+	PendingAdminTransfers []PendingAdminTransfer `protobuf:"bytes,10,rep,name=pending_admin_transfers,json=pendingAdminTransfers,proto3" json:"pending_admin_transfers,omitempty"`
+	// ContractNames are the names claimed by contracts
+	ContractNames []ContractName `protobuf:"bytes,11,rep,name=contract_names,json=contractNames,proto3" json:"contract_names,omitempty"`
+	// StorageDeposits are the deposits held for the storage of contracts
+	StorageDeposits []StorageDeposit `protobuf:"bytes,12,rep,name=storage_deposits,json=storageDeposits,proto3" json:"storage_deposits,omitempty"`
+	// DeletedContracts are the tombstones of deleted contracts
+	DeletedContracts []DeletedContract `protobuf:"bytes,13,rep,name=deleted_contracts,json=deletedContracts,proto3" json:"deleted_contracts,omitempty"`
+	// CodeExecutionCounts are the code executions per block within the auto pin
+	// window
+	CodeExecutionCounts []CodeExecutionCount `protobuf:"bytes,14,rep,name=code_execution_counts,json=codeExecutionCounts,proto3" json:"code_execution_counts,omitempty"`
+	// AutoPinnedCodes are the codes that were pinned automatically
+	AutoPinnedCodes []AutoPinnedCode `protobuf:"bytes,15,rep,name=auto_pinned_codes,json=autoPinnedCodes,proto3" json:"auto_pinned_codes,omitempty"`
+	// CodeGasMultipliers are the gas multipliers of codes set by governance
+	CodeGasMultipliers []CodeGasMultiplier `protobuf:"bytes,16,rep,name=code_gas_multipliers,json=codeGasMultipliers,proto3" json:"code_gas_multipliers,omitempty"`
+	// CodeSchemas are the JSON schemas of the contract messages of codes
+	CodeSchemas []CodeSchema `protobuf:"bytes,17,rep,name=code_schemas,json=codeSchemas,proto3" json:"code_schemas,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -102,6 +130,97 @@ func (m *GenesisState) GetSequences() []Sequence {
 	return nil
 }
 
+func (m *GenesisState) GetDeprecatedChecksums() [][]byte {
+	if m != nil {
+		return m.DeprecatedChecksums
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCronSchedules() []CronSchedule {
+	if m != nil {
+		return m.CronSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochHookSubscriptions() []EpochHookSubscription {
+	if m != nil {
+		return m.EpochHookSubscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeShares() []FeeShare {
+	if m != nil {
+		return m.FeeShares
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeSponsorships() []FeeSponsorship {
+	if m != nil {
+		return m.FeeSponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingAdminTransfers() []PendingAdminTransfer {
+	if m != nil {
+		return m.PendingAdminTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractNames() []ContractName {
+	if m != nil {
+		return m.ContractNames
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStorageDeposits() []StorageDeposit {
+	if m != nil {
+		return m.StorageDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeletedContracts() []DeletedContract {
+	if m != nil {
+		return m.DeletedContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCodeExecutionCounts() []CodeExecutionCount {
+	if m != nil {
+		return m.CodeExecutionCounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoPinnedCodes() []AutoPinnedCode {
+	if m != nil {
+		return m.AutoPinnedCodes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCodeGasMultipliers() []CodeGasMultiplier {
+	if m != nil {
+		return m.CodeGasMultipliers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCodeSchemas() []CodeSchema {
+	if m != nil {
+		return m.CodeSchemas
+	}
+	return nil
+}
+
 // Code struct encompasses CodeInfo and CodeBytes
 type Code struct {
 	CodeID    uint64   `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
@@ -109,6 +228,8 @@ type Code struct {
 	CodeBytes []byte   `protobuf:"bytes,3,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
 	// Pinned to wasmvm cache
 	Pinned bool `protobuf:"varint,4,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Deprecated by governance
+	Deprecated bool `protobuf:"varint,5,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
 }
 
 func (m *Code) Reset()         { *m = Code{} }
@@ -177,12 +298,21 @@ func (m *Code) GetPinned() bool {
 	return false
 }
 
+func (m *Code) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
 // Contract struct encompasses ContractAddress, ContractInfo, and ContractState
 type Contract struct {
 	ContractAddress     string                     `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	ContractInfo        ContractInfo               `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState       []Model                    `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
 	ContractCodeHistory []ContractCodeHistoryEntry `protobuf:"bytes,4,rep,name=contract_code_history,json=contractCodeHistory,proto3" json:"contract_code_history"`
+	// Frozen by governance
+	Frozen bool `protobuf:"varint,5,opt,name=frozen,proto3" json:"frozen,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -251,6 +381,13 @@ func (m *Contract) GetContractCodeHistory() []ContractCodeHistoryEntry {
 	return nil
 }
 
+func (m *Contract) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 // Sequence key and value of an id generation counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -309,54 +446,279 @@ func (m *Sequence) GetValue() uint64 {
 	return 0
 }
 
+// DeletedContract is the tombstone of a deleted contract. Its address can not
+// be used again.
+type DeletedContract struct {
+	ContractAddress     string                     `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	ContractCodeHistory []ContractCodeHistoryEntry `protobuf:"bytes,2,rep,name=contract_code_history,json=contractCodeHistory,proto3" json:"contract_code_history"`
+}
+
+func (m *DeletedContract) Reset()         { *m = DeletedContract{} }
+func (m *DeletedContract) String() string { return proto.CompactTextString(m) }
+func (*DeletedContract) ProtoMessage()    {}
+func (*DeletedContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ab3f539b23472a6, []int{4}
+}
+
+func (m *DeletedContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *DeletedContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletedContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *DeletedContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletedContract.Merge(m, src)
+}
+
+func (m *DeletedContract) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *DeletedContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletedContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletedContract proto.InternalMessageInfo
+
+func (m *DeletedContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *DeletedContract) GetContractCodeHistory() []ContractCodeHistoryEntry {
+	if m != nil {
+		return m.ContractCodeHistory
+	}
+	return nil
+}
+
+// CodeExecutionCount is the number of executions of a code in a block
+type CodeExecutionCount struct {
+	Height     int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	CodeID     uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	Executions uint64 `protobuf:"varint,3,opt,name=executions,proto3" json:"executions,omitempty"`
+}
+
+func (m *CodeExecutionCount) Reset()         { *m = CodeExecutionCount{} }
+func (m *CodeExecutionCount) String() string { return proto.CompactTextString(m) }
+func (*CodeExecutionCount) ProtoMessage()    {}
+func (*CodeExecutionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ab3f539b23472a6, []int{5}
+}
+
+func (m *CodeExecutionCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CodeExecutionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeExecutionCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *CodeExecutionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeExecutionCount.Merge(m, src)
+}
+
+func (m *CodeExecutionCount) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CodeExecutionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeExecutionCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeExecutionCount proto.InternalMessageInfo
+
+func (m *CodeExecutionCount) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CodeExecutionCount) GetCodeID() uint64 {
+	if m != nil {
+		return m.CodeID
+	}
+	return 0
+}
+
+func (m *CodeExecutionCount) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+// AutoPinnedCode is a code that was pinned automatically for its usage
+type AutoPinnedCode struct {
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// CodeSize is the size of the wasm code in bytes that is accounted in the
+	// auto pin memory budget
+	CodeSize uint64 `protobuf:"varint,2,opt,name=code_size,json=codeSize,proto3" json:"code_size,omitempty"`
+}
+
+func (m *AutoPinnedCode) Reset()         { *m = AutoPinnedCode{} }
+func (m *AutoPinnedCode) String() string { return proto.CompactTextString(m) }
+func (*AutoPinnedCode) ProtoMessage()    {}
+func (*AutoPinnedCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ab3f539b23472a6, []int{6}
+}
+
+func (m *AutoPinnedCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *AutoPinnedCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoPinnedCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *AutoPinnedCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoPinnedCode.Merge(m, src)
+}
+
+func (m *AutoPinnedCode) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *AutoPinnedCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoPinnedCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoPinnedCode proto.InternalMessageInfo
+
+func (m *AutoPinnedCode) GetCodeID() uint64 {
+	if m != nil {
+		return m.CodeID
+	}
+	return 0
+}
+
+func (m *AutoPinnedCode) GetCodeSize() uint64 {
+	if m != nil {
+		return m.CodeSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmwasm.wasm.v1.GenesisState")
 	proto.RegisterType((*Code)(nil), "cosmwasm.wasm.v1.Code")
 	proto.RegisterType((*Contract)(nil), "cosmwasm.wasm.v1.Contract")
 	proto.RegisterType((*Sequence)(nil), "cosmwasm.wasm.v1.Sequence")
+	proto.RegisterType((*DeletedContract)(nil), "cosmwasm.wasm.v1.DeletedContract")
+	proto.RegisterType((*CodeExecutionCount)(nil), "cosmwasm.wasm.v1.CodeExecutionCount")
+	proto.RegisterType((*AutoPinnedCode)(nil), "cosmwasm.wasm.v1.AutoPinnedCode")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/genesis.proto", fileDescriptor_2ab3f539b23472a6) }
 
 var fileDescriptor_2ab3f539b23472a6 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xae, 0x0d, 0xad, 0x57, 0xd8, 0x30, 0x63, 0x84, 0x6a, 0xa4, 0x55, 0x91, 0x50,
-	0x35, 0x41, 0xa3, 0x8d, 0x23, 0x17, 0xc8, 0x86, 0xa0, 0x4c, 0x20, 0x94, 0x1e, 0x90, 0x76, 0xa9,
-	0xd2, 0xd8, 0xeb, 0x2c, 0x96, 0xb8, 0xc4, 0x6e, 0x21, 0xdf, 0x82, 0x4f, 0x81, 0x38, 0x72, 0xe0,
-	0x43, 0xec, 0xc6, 0x84, 0x84, 0xc4, 0xa9, 0x42, 0xed, 0x01, 0x89, 0x4f, 0x81, 0xfc, 0x27, 0x59,
-	0xd4, 0xae, 0x17, 0xab, 0xf6, 0xf3, 0xbe, 0xbf, 0xbe, 0xef, 0xe3, 0x37, 0x06, 0x76, 0x40, 0x59,
-	0xf8, 0xd1, 0x67, 0xa1, 0x23, 0x97, 0xc9, 0x9e, 0x33, 0xc4, 0x11, 0x66, 0x84, 0x75, 0x46, 0x31,
-	0xe5, 0x14, 0x6e, 0xa6, 0x7a, 0x47, 0x2e, 0x93, 0xbd, 0xfa, 0xd6, 0x90, 0x0e, 0xa9, 0x14, 0x1d,
-	0xf1, 0x4b, 0xc5, 0xd5, 0x77, 0x96, 0x38, 0x3c, 0x19, 0x61, 0x4d, 0xa9, 0xdf, 0xf4, 0x43, 0x12,
-	0x51, 0x47, 0xae, 0xfa, 0xe8, 0xae, 0x48, 0xa0, 0xac, 0xaf, 0x48, 0x6a, 0xa3, 0xa4, 0xd6, 0x8f,
-	0x22, 0xa8, 0xbd, 0x50, 0x55, 0xf4, 0xb8, 0xcf, 0x31, 0x7c, 0x02, 0xcc, 0x91, 0x1f, 0xfb, 0x21,
-	0xb3, 0x8c, 0xa6, 0xd1, 0x5e, 0xdf, 0xb7, 0x3a, 0x8b, 0x55, 0x75, 0xde, 0x4a, 0xdd, 0xad, 0x9e,
-	0x4f, 0x1b, 0x85, 0xaf, 0x7f, 0xbf, 0xed, 0x1a, 0x9e, 0x4e, 0x81, 0xaf, 0x40, 0x39, 0xa0, 0x08,
-	0x33, 0xab, 0xd8, 0x5c, 0x6b, 0xaf, 0xef, 0x6f, 0x2f, 0xe7, 0x1e, 0x50, 0x84, 0xdd, 0x1d, 0x91,
-	0xf9, 0x6f, 0xda, 0xd8, 0x90, 0xc1, 0x0f, 0x69, 0x48, 0x38, 0x0e, 0x47, 0x3c, 0x51, 0x30, 0x85,
-	0x80, 0xc7, 0xa0, 0x1a, 0xd0, 0x88, 0xc7, 0x7e, 0xc0, 0x99, 0xb5, 0x26, 0x79, 0xf5, 0xab, 0x78,
-	0x2a, 0xc4, 0x6d, 0x6a, 0xe6, 0xad, 0x2c, 0x69, 0x91, 0x7b, 0x89, 0x13, 0x6c, 0x86, 0x3f, 0x8c,
-	0x71, 0x14, 0x60, 0x66, 0x95, 0x56, 0xb1, 0x7b, 0x3a, 0xe4, 0x92, 0x9d, 0x25, 0x2d, 0xb1, 0x33,
-	0xa5, 0xf5, 0xc5, 0x00, 0x25, 0xd1, 0x25, 0xbc, 0x0f, 0xae, 0x89, 0x4e, 0xfa, 0x04, 0x49, 0x2b,
-	0x4b, 0x2e, 0x98, 0x4d, 0x1b, 0xa6, 0x90, 0xba, 0x87, 0x9e, 0x29, 0xa4, 0x2e, 0x82, 0xae, 0xe8,
-	0x52, 0x04, 0x45, 0x27, 0xd4, 0x2a, 0x4a, 0xc7, 0xeb, 0x57, 0xbb, 0xd6, 0x8d, 0x4e, 0x68, 0xde,
-	0xf3, 0x4a, 0xa0, 0x0f, 0xe1, 0x3d, 0x00, 0x24, 0x63, 0x90, 0x70, 0x2c, 0xac, 0x32, 0xda, 0x35,
-	0x4f, 0x52, 0x5d, 0x71, 0x00, 0xb7, 0x81, 0x39, 0x22, 0x51, 0x84, 0x91, 0x55, 0x6a, 0x1a, 0xed,
-	0x8a, 0xa7, 0x77, 0xad, 0x5f, 0x45, 0x50, 0x49, 0xed, 0x83, 0x07, 0x60, 0x33, 0xb5, 0xa7, 0xef,
-	0x23, 0x14, 0x63, 0xa6, 0x06, 0xa0, 0xea, 0x5a, 0x3f, 0xbf, 0x3f, 0xda, 0xd2, 0x33, 0xf3, 0x4c,
-	0x29, 0x3d, 0x1e, 0x93, 0x68, 0xe8, 0x6d, 0xa4, 0x19, 0xfa, 0x18, 0xbe, 0x01, 0xd7, 0x33, 0x48,
-	0xae, 0x21, 0x7b, 0xf5, 0xb5, 0x2d, 0x36, 0x55, 0x0b, 0x72, 0x02, 0xec, 0x82, 0x1b, 0x19, 0x8f,
-	0x89, 0xe9, 0xd4, 0x73, 0x70, 0x67, 0x19, 0xf8, 0x9a, 0x22, 0x7c, 0x96, 0x27, 0x65, 0x95, 0xa8,
-	0xb1, 0x26, 0xe0, 0x76, 0x86, 0x92, 0x66, 0x9d, 0x12, 0xc6, 0x69, 0x9c, 0xe8, 0xdb, 0xdf, 0x5d,
-	0x5d, 0xa2, 0xf0, 0xfe, 0xa5, 0x0a, 0x7e, 0x1e, 0xf1, 0x38, 0xc9, 0xff, 0x49, 0x36, 0x6c, 0xb9,
-	0xa0, 0x96, 0x0b, 0x2a, 0xe9, 0xe4, 0xc0, 0x26, 0x30, 0x09, 0xea, 0xbf, 0xc7, 0x89, 0x34, 0xb3,
-	0xe6, 0x56, 0x67, 0xd3, 0x46, 0xb9, 0x7b, 0x78, 0x84, 0x13, 0xaf, 0x4c, 0xd0, 0x11, 0x4e, 0xe0,
-	0x16, 0x28, 0x4f, 0xfc, 0xb3, 0x31, 0x96, 0x5e, 0x95, 0x3c, 0xb5, 0x71, 0x9f, 0x9e, 0xcf, 0x6c,
-	0xe3, 0x62, 0x66, 0x1b, 0x7f, 0x66, 0xb6, 0xf1, 0x79, 0x6e, 0x17, 0x2e, 0xe6, 0x76, 0xe1, 0xf7,
-	0xdc, 0x2e, 0x1c, 0x3f, 0x18, 0x12, 0x7e, 0x3a, 0x1e, 0x74, 0x02, 0x1a, 0x3a, 0x07, 0x94, 0x85,
-	0xef, 0xd2, 0x77, 0x00, 0x39, 0x9f, 0xd4, 0x7b, 0x20, 0x1f, 0x83, 0x81, 0x29, 0xbf, 0xef, 0xc7,
-	0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x86, 0xe3, 0xf2, 0x58, 0x75, 0x04, 0x00, 0x00,
+	// 1177 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xbf, 0x6f, 0xdb, 0xc6,
+	0x17, 0x37, 0x6d, 0x49, 0xb1, 0xce, 0x8a, 0x7f, 0x5c, 0x14, 0x87, 0x5f, 0x25, 0x5f, 0x4a, 0x91,
+	0x0b, 0xc7, 0x35, 0x5a, 0x0b, 0x49, 0xc7, 0x2e, 0x35, 0x6d, 0x37, 0x71, 0x83, 0x04, 0x81, 0xd4,
+	0x22, 0x40, 0x16, 0x82, 0x26, 0x4f, 0xd2, 0xc1, 0x22, 0x8f, 0xe6, 0x3b, 0xba, 0x91, 0x81, 0x0e,
+	0x1d, 0x3b, 0x14, 0xe8, 0xde, 0x7f, 0xa0, 0x63, 0x87, 0xfe, 0x03, 0x5d, 0x8a, 0x8c, 0x41, 0xa7,
+	0x4e, 0x46, 0x61, 0x0f, 0x05, 0x02, 0xf4, 0x7f, 0x28, 0xee, 0x78, 0xa2, 0x4e, 0x12, 0x55, 0x74,
+	0xc8, 0x22, 0x88, 0x7c, 0x9f, 0x1f, 0x8f, 0xef, 0xee, 0xbd, 0x3b, 0x64, 0x79, 0x0c, 0x82, 0xaf,
+	0x5d, 0x08, 0x5a, 0xf2, 0xe7, 0xfc, 0x61, 0xab, 0x47, 0x42, 0x02, 0x14, 0xf6, 0xa2, 0x98, 0x71,
+	0x86, 0xd7, 0x47, 0xf1, 0x3d, 0xf9, 0x73, 0xfe, 0xb0, 0x56, 0xed, 0xb1, 0x1e, 0x93, 0xc1, 0x96,
+	0xf8, 0x97, 0xe2, 0x6a, 0xf7, 0x66, 0x74, 0xf8, 0x30, 0x22, 0x4a, 0xa5, 0xb6, 0xe1, 0x06, 0x34,
+	0x64, 0x2d, 0xf9, 0xab, 0x5e, 0xfd, 0x4f, 0x10, 0x18, 0x38, 0xa9, 0x52, 0xfa, 0x90, 0x86, 0x9a,
+	0x3f, 0xae, 0xa2, 0xca, 0xe3, 0x34, 0x8b, 0x0e, 0x77, 0x39, 0xc1, 0x9f, 0xa2, 0x52, 0xe4, 0xc6,
+	0x6e, 0x00, 0xa6, 0xd1, 0x30, 0x76, 0x56, 0x1e, 0x99, 0x7b, 0xd3, 0x59, 0xed, 0xbd, 0x90, 0x71,
+	0xbb, 0xfc, 0xe6, 0xb2, 0xbe, 0xf0, 0xd3, 0x5f, 0x3f, 0xef, 0x1a, 0x6d, 0x45, 0xc1, 0x5f, 0xa0,
+	0xa2, 0xc7, 0x7c, 0x02, 0xe6, 0x62, 0x63, 0x69, 0x67, 0xe5, 0xd1, 0xe6, 0x2c, 0xf7, 0x80, 0xf9,
+	0xc4, 0xbe, 0x27, 0x98, 0xef, 0x2e, 0xeb, 0x6b, 0x12, 0xfc, 0x11, 0x0b, 0x28, 0x27, 0x41, 0xc4,
+	0x87, 0xa9, 0x58, 0x2a, 0x81, 0x5f, 0xa1, 0xb2, 0xc7, 0x42, 0x1e, 0xbb, 0x1e, 0x07, 0x73, 0x49,
+	0xea, 0xd5, 0xf2, 0xf4, 0x52, 0x88, 0xdd, 0x50, 0x9a, 0xb7, 0x32, 0xd2, 0xb4, 0xee, 0x58, 0x4e,
+	0x68, 0x03, 0x39, 0x4b, 0x48, 0xe8, 0x11, 0x30, 0x0b, 0xf3, 0xb4, 0x3b, 0x0a, 0x32, 0xd6, 0xce,
+	0x48, 0x33, 0xda, 0x59, 0x04, 0x7f, 0x85, 0xaa, 0x3e, 0x89, 0x62, 0xe2, 0xb9, 0x9c, 0xf8, 0x8e,
+	0xd7, 0x27, 0xde, 0x29, 0x24, 0x01, 0x98, 0xc5, 0xc6, 0xd2, 0x4e, 0xc5, 0x6e, 0xbe, 0xbb, 0xac,
+	0x5b, 0x79, 0xf1, 0xb1, 0x62, 0xfb, 0xd6, 0x38, 0x7e, 0x30, 0x0a, 0xe3, 0x1e, 0x5a, 0xf5, 0x62,
+	0x16, 0x3a, 0xe0, 0xf5, 0x89, 0x9f, 0x0c, 0x08, 0x98, 0x25, 0x99, 0xb7, 0x95, 0x53, 0x93, 0x98,
+	0x85, 0x1d, 0x05, 0xcb, 0x72, 0x37, 0x27, 0xd9, 0x9a, 0xdd, 0x4d, 0x4f, 0xc3, 0x03, 0xfe, 0xde,
+	0x40, 0x26, 0x89, 0x98, 0xd7, 0x77, 0xfa, 0x8c, 0x9d, 0x3a, 0x90, 0x9c, 0x80, 0x17, 0xd3, 0x88,
+	0x53, 0x16, 0x82, 0x79, 0x43, 0x7a, 0x3e, 0x98, 0xf5, 0x3c, 0x12, 0x8c, 0x27, 0x8c, 0x9d, 0x76,
+	0x34, 0xbc, 0xbd, 0xab, 0xcc, 0x9b, 0xf3, 0x04, 0xb5, 0x34, 0x36, 0x49, 0x9e, 0x04, 0xe0, 0x97,
+	0x08, 0x75, 0x09, 0x71, 0xa0, 0xef, 0xc6, 0x04, 0xcc, 0xe5, 0x79, 0x8b, 0xf5, 0x39, 0x21, 0x1d,
+	0x01, 0xc9, 0x36, 0x57, 0x75, 0xcc, 0xd2, 0x5c, 0xca, 0x5d, 0x85, 0x03, 0xcc, 0xd0, 0xba, 0x84,
+	0x44, 0x2c, 0x04, 0x16, 0x43, 0x9f, 0x46, 0x60, 0x96, 0xa5, 0x7c, 0x23, 0x5f, 0x7e, 0x0c, 0xb4,
+	0x9b, 0xca, 0xa4, 0x36, 0xad, 0xa0, 0x59, 0xad, 0x75, 0x27, 0x38, 0x80, 0xbf, 0x33, 0xd0, 0x9d,
+	0x88, 0x84, 0x3e, 0x0d, 0x7b, 0x8e, 0xeb, 0x07, 0x34, 0x74, 0x78, 0xec, 0x86, 0xd0, 0x25, 0x31,
+	0x98, 0x48, 0x1a, 0x6f, 0xe7, 0x34, 0x5b, 0x4a, 0xd8, 0x17, 0xf8, 0x2f, 0x15, 0xdc, 0xfe, 0x50,
+	0xd9, 0xdf, 0x9f, 0x23, 0xa7, 0x65, 0x71, 0x3b, 0xca, 0x11, 0x48, 0xb7, 0x93, 0x6a, 0x07, 0x27,
+	0x74, 0x03, 0x02, 0xe6, 0xca, 0xdc, 0xed, 0xa4, 0x70, 0xcf, 0xdd, 0x40, 0xdf, 0x4e, 0x13, 0xec,
+	0x89, 0xed, 0xa4, 0xe1, 0x65, 0x95, 0x81, 0xb3, 0xd8, 0xed, 0x11, 0xc7, 0x27, 0x11, 0x03, 0xca,
+	0xc1, 0xac, 0xcc, 0xab, 0x72, 0x27, 0x45, 0x1e, 0xa6, 0xc0, 0x71, 0x95, 0xa7, 0x15, 0xf4, 0x2a,
+	0xc3, 0x04, 0x07, 0x30, 0xa0, 0x0d, 0x9f, 0x0c, 0x88, 0x6c, 0xae, 0x6c, 0x7e, 0xdc, 0x94, 0x8e,
+	0xf7, 0x67, 0x1d, 0x0f, 0x53, 0x68, 0x36, 0x46, 0xb6, 0x94, 0xe5, 0xdd, 0x19, 0x0d, 0xcd, 0x73,
+	0xdd, 0x9f, 0x64, 0x01, 0xfe, 0xd6, 0x40, 0xb7, 0xc5, 0xd8, 0x72, 0xc8, 0x6b, 0xe2, 0x25, 0x62,
+	0xe3, 0x3a, 0x1e, 0x4b, 0x42, 0x0e, 0xe6, 0xaa, 0x74, 0xfe, 0x20, 0x7f, 0x12, 0x1e, 0x8d, 0xd0,
+	0x07, 0x02, 0x6c, 0x3f, 0x50, 0xe6, 0xf5, 0x5c, 0x29, 0x7d, 0x42, 0x78, 0x33, 0x64, 0xc0, 0x67,
+	0x68, 0xc3, 0x4d, 0x38, 0x73, 0x22, 0x1a, 0x86, 0x32, 0x71, 0x31, 0x88, 0xd7, 0xe6, 0x95, 0x7a,
+	0x3f, 0xe1, 0xec, 0x85, 0x44, 0xca, 0x91, 0x9c, 0x7d, 0xf7, 0x8c, 0x84, 0x5e, 0x6b, 0x77, 0x82,
+	0x04, 0xf8, 0x1b, 0x54, 0x95, 0xa9, 0xf6, 0x5c, 0x70, 0x82, 0x64, 0xc0, 0x69, 0x34, 0xa0, 0x62,
+	0x37, 0xaf, 0x4b, 0xd7, 0xad, 0xfc, 0x8f, 0x7e, 0xec, 0xc2, 0xb3, 0x0c, 0x6b, 0x6f, 0x2b, 0x63,
+	0x2b, 0x4f, 0x48, 0xf3, 0xc6, 0xde, 0x34, 0x15, 0xb0, 0x83, 0x2a, 0x92, 0x25, 0xa6, 0x5a, 0xe0,
+	0x82, 0xb9, 0x21, 0x6d, 0xef, 0xe5, 0xdb, 0x76, 0x24, 0xc8, 0xb6, 0x94, 0xdf, 0xa6, 0xce, 0xd4,
+	0x7c, 0x56, 0xbc, 0x0c, 0x0b, 0xcd, 0xdf, 0x0c, 0x54, 0x10, 0x5c, 0xbc, 0x85, 0x6e, 0x48, 0x3c,
+	0xf5, 0xe5, 0xb1, 0x58, 0xb0, 0xd1, 0xd5, 0x65, 0xbd, 0x24, 0x42, 0xc7, 0x87, 0xed, 0x92, 0x08,
+	0x1d, 0xfb, 0xd8, 0x16, 0x27, 0x96, 0x00, 0x85, 0x5d, 0x66, 0x2e, 0xca, 0xd3, 0xb3, 0x96, 0x9f,
+	0xcb, 0x71, 0xd8, 0x65, 0xfa, 0xf9, 0xb9, 0xec, 0xa9, 0x97, 0xf8, 0xff, 0x08, 0x49, 0x8d, 0x93,
+	0x21, 0x27, 0xe2, 0xd8, 0x33, 0x76, 0x2a, 0x6d, 0xa9, 0x6a, 0x8b, 0x17, 0x78, 0x13, 0x95, 0xd2,
+	0xb5, 0x31, 0x0b, 0x0d, 0x63, 0x67, 0xb9, 0xad, 0x9e, 0xb0, 0x85, 0xd0, 0xf8, 0xd0, 0x30, 0x8b,
+	0x32, 0xa6, 0xbd, 0x69, 0xfe, 0xbd, 0x88, 0x96, 0x47, 0xbb, 0x15, 0x1f, 0xa0, 0xf5, 0xac, 0x7b,
+	0x5d, 0xdf, 0x8f, 0x09, 0xa4, 0x87, 0x7d, 0xd9, 0x36, 0x7f, 0xff, 0xe5, 0xe3, 0xaa, 0xba, 0x1f,
+	0xec, 0xa7, 0x91, 0x0e, 0x8f, 0x69, 0xd8, 0x6b, 0xaf, 0x8d, 0x18, 0xea, 0x35, 0x7e, 0x8e, 0xb2,
+	0x46, 0xd7, 0x3f, 0xf8, 0x5f, 0xe6, 0xc7, 0xf4, 0x47, 0x57, 0x3c, 0x2d, 0x80, 0x8f, 0xb5, 0x81,
+	0x04, 0xe2, 0x26, 0xa2, 0xce, 0xfc, 0x3b, 0xb3, 0x82, 0xcf, 0x98, 0x4f, 0x06, 0xba, 0x52, 0x96,
+	0x49, 0x7a, 0x85, 0xa1, 0xa2, 0x17, 0x95, 0x94, 0x2c, 0x66, 0x9f, 0x8a, 0x21, 0x31, 0x54, 0x27,
+	0xfd, 0xee, 0xfc, 0x14, 0xc5, 0xda, 0x3c, 0x49, 0xc1, 0x47, 0x21, 0x8f, 0x87, 0xba, 0x49, 0x76,
+	0xb1, 0xd0, 0x40, 0x62, 0x3d, 0xba, 0x31, 0xbb, 0x20, 0xa1, 0xaa, 0xb9, 0x7a, 0x6a, 0xda, 0x68,
+	0x79, 0x74, 0x7b, 0xc0, 0x0d, 0x54, 0xa2, 0xbe, 0x73, 0x4a, 0x86, 0xb2, 0xc8, 0x15, 0xbb, 0x7c,
+	0x75, 0x59, 0x2f, 0x1e, 0x1f, 0x3e, 0x25, 0xc3, 0x76, 0x91, 0xfa, 0x4f, 0xc9, 0x10, 0x57, 0x51,
+	0xf1, 0xdc, 0x1d, 0x24, 0x44, 0xd6, 0xb0, 0xd0, 0x4e, 0x1f, 0x9a, 0xbf, 0x1a, 0x68, 0x6d, 0x6a,
+	0x3c, 0xbd, 0x9f, 0xa5, 0x9b, 0x5b, 0x9f, 0xc5, 0xf7, 0x5d, 0x9f, 0xe6, 0x19, 0xc2, 0xb3, 0x73,
+	0x4e, 0x54, 0xad, 0x4f, 0x68, 0xaf, 0xcf, 0x65, 0xee, 0x4b, 0x6d, 0xf5, 0xa4, 0x77, 0xd9, 0xe2,
+	0xdc, 0x2e, 0xb3, 0x10, 0xca, 0x26, 0x63, 0xda, 0x21, 0x85, 0xb6, 0xf6, 0xa6, 0xd9, 0x46, 0xab,
+	0x93, 0xb3, 0xed, 0xbf, 0x35, 0xef, 0x5d, 0xd5, 0xbc, 0x40, 0x2f, 0x46, 0xeb, 0x20, 0xbb, 0xb2,
+	0x43, 0x2f, 0x88, 0xfd, 0xd9, 0x9b, 0x2b, 0xcb, 0x78, 0x7b, 0x65, 0x19, 0x7f, 0x5e, 0x59, 0xc6,
+	0x0f, 0xd7, 0xd6, 0xc2, 0xdb, 0x6b, 0x6b, 0xe1, 0x8f, 0x6b, 0x6b, 0xe1, 0xd5, 0x76, 0x8f, 0xf2,
+	0x7e, 0x72, 0xb2, 0xe7, 0xb1, 0xa0, 0x75, 0xc0, 0x20, 0x78, 0x39, 0xba, 0x96, 0xfb, 0xad, 0xd7,
+	0xe9, 0xf5, 0x5c, 0xde, 0xcd, 0x4f, 0x4a, 0xf2, 0xba, 0xfd, 0xc9, 0x3f, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0xfa, 0x60, 0x56, 0x8a, 0x04, 0x0c, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -379,10 +741,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Sequences) > 0 {
-		for iNdEx := len(m.Sequences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CodeSchemas) > 0 {
+		for iNdEx := len(m.CodeSchemas) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Sequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CodeSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -390,13 +752,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.Contracts) > 0 {
-		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.CodeGasMultipliers) > 0 {
+		for iNdEx := len(m.CodeGasMultipliers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CodeGasMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -404,13 +768,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.Codes) > 0 {
-		for iNdEx := len(m.Codes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AutoPinnedCodes) > 0 {
+		for iNdEx := len(m.AutoPinnedCodes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Codes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AutoPinnedCodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -418,44 +784,231 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x7a
 		}
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.CodeExecutionCounts) > 0 {
+		for iNdEx := len(m.CodeExecutionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodeExecutionCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Code) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.DeletedContracts) > 0 {
+		for iNdEx := len(m.DeletedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeletedContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Code) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pinned {
-		i--
+	if len(m.StorageDeposits) > 0 {
+		for iNdEx := len(m.StorageDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ContractNames) > 0 {
+		for iNdEx := len(m.ContractNames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractNames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PendingAdminTransfers) > 0 {
+		for iNdEx := len(m.PendingAdminTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAdminTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.FeeSponsorships) > 0 {
+		for iNdEx := len(m.FeeSponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.FeeShares) > 0 {
+		for iNdEx := len(m.FeeShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.EpochHookSubscriptions) > 0 {
+		for iNdEx := len(m.EpochHookSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochHookSubscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CronSchedules) > 0 {
+		for iNdEx := len(m.CronSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CronSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DeprecatedChecksums) > 0 {
+		for iNdEx := len(m.DeprecatedChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeprecatedChecksums[iNdEx])
+			copy(dAtA[i:], m.DeprecatedChecksums[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeprecatedChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Sequences) > 0 {
+		for iNdEx := len(m.Sequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Codes) > 0 {
+		for iNdEx := len(m.Codes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Codes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Code) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Code) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Pinned {
+		i--
 		if m.Pinned {
 			dAtA[i] = 1
 		} else {
@@ -509,6 +1062,16 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.ContractCodeHistory) > 0 {
 		for iNdEx := len(m.ContractCodeHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -592,65 +1155,261 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DeletedContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DeletedContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletedContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Codes) > 0 {
-		for _, e := range m.Codes {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Contracts) > 0 {
-		for _, e := range m.Contracts {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ContractCodeHistory) > 0 {
+		for iNdEx := len(m.ContractCodeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractCodeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Sequences) > 0 {
-		for _, e := range m.Sequences {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Code) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CodeExecutionCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeExecutionCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeExecutionCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Executions != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.CodeID != 0 {
-		n += 1 + sovGenesis(uint64(m.CodeID))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.CodeInfo.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = len(m.CodeBytes)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoPinnedCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoPinnedCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoPinnedCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CodeSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CodeSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Codes) > 0 {
+		for _, e := range m.Codes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Sequences) > 0 {
+		for _, e := range m.Sequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeprecatedChecksums) > 0 {
+		for _, b := range m.DeprecatedChecksums {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CronSchedules) > 0 {
+		for _, e := range m.CronSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochHookSubscriptions) > 0 {
+		for _, e := range m.EpochHookSubscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeShares) > 0 {
+		for _, e := range m.FeeShares {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeSponsorships) > 0 {
+		for _, e := range m.FeeSponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingAdminTransfers) > 0 {
+		for _, e := range m.PendingAdminTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractNames) > 0 {
+		for _, e := range m.ContractNames {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StorageDeposits) > 0 {
+		for _, e := range m.StorageDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeletedContracts) > 0 {
+		for _, e := range m.DeletedContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CodeExecutionCounts) > 0 {
+		for _, e := range m.CodeExecutionCounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoPinnedCodes) > 0 {
+		for _, e := range m.AutoPinnedCodes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CodeGasMultipliers) > 0 {
+		for _, e := range m.CodeGasMultipliers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CodeSchemas) > 0 {
+		for _, e := range m.CodeSchemas {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Code) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovGenesis(uint64(m.CodeID))
+	}
+	l = m.CodeInfo.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.CodeBytes)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
 	}
 	if m.Pinned {
 		n += 2
 	}
+	if m.Deprecated {
+		n += 2
+	}
 	return n
 }
 
@@ -678,6 +1437,9 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.Frozen {
+		n += 2
+	}
 	return n
 }
 
@@ -697,6 +1459,58 @@ func (m *Sequence) Size() (n int) {
 	return n
 }
 
+func (m *DeletedContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ContractCodeHistory) > 0 {
+		for _, e := range m.ContractCodeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CodeExecutionCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovGenesis(uint64(m.CodeID))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovGenesis(uint64(m.Executions))
+	}
+	return n
+}
+
+func (m *AutoPinnedCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovGenesis(uint64(m.CodeID))
+	}
+	if m.CodeSize != 0 {
+		n += 1 + sovGenesis(uint64(m.CodeSize))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -769,7 +1583,809 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codes = append(m.Codes, Code{})
+			if err := m.Codes[len(m.Codes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, Contract{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequences = append(m.Sequences, Sequence{})
+			if err := m.Sequences[len(m.Sequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeprecatedChecksums = append(m.DeprecatedChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.DeprecatedChecksums[len(m.DeprecatedChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronSchedules = append(m.CronSchedules, CronSchedule{})
+			if err := m.CronSchedules[len(m.CronSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochHookSubscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochHookSubscriptions = append(m.EpochHookSubscriptions, EpochHookSubscription{})
+			if err := m.EpochHookSubscriptions[len(m.EpochHookSubscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeShares = append(m.FeeShares, FeeShare{})
+			if err := m.FeeShares[len(m.FeeShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsorships = append(m.FeeSponsorships, FeeSponsorship{})
+			if err := m.FeeSponsorships[len(m.FeeSponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdminTransfers = append(m.PendingAdminTransfers, PendingAdminTransfer{})
+			if err := m.PendingAdminTransfers[len(m.PendingAdminTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractNames", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractNames = append(m.ContractNames, ContractName{})
+			if err := m.ContractNames[len(m.ContractNames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDeposits = append(m.StorageDeposits, StorageDeposit{})
+			if err := m.StorageDeposits[len(m.StorageDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedContracts = append(m.DeletedContracts, DeletedContract{})
+			if err := m.DeletedContracts[len(m.DeletedContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeExecutionCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeExecutionCounts = append(m.CodeExecutionCounts, CodeExecutionCount{})
+			if err := m.CodeExecutionCounts[len(m.CodeExecutionCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPinnedCodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoPinnedCodes = append(m.AutoPinnedCodes, AutoPinnedCode{})
+			if err := m.AutoPinnedCodes[len(m.AutoPinnedCodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeGasMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeGasMultipliers = append(m.CodeGasMultipliers, CodeGasMultiplier{})
+			if err := m.CodeGasMultipliers[len(m.CodeGasMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeSchemas = append(m.CodeSchemas, CodeSchema{})
+			if err := m.CodeSchemas[len(m.CodeSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Code) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Code: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Code: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeBytes = append(m.CodeBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeBytes == nil {
+				m.CodeBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pinned = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Contract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -796,14 +2412,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Codes = append(m.Codes, Code{})
-			if err := m.Codes[len(m.Codes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -830,14 +2445,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, Contract{})
-			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractState = append(m.ContractState, Model{})
+			if err := m.ContractState[len(m.ContractState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCodeHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -864,11 +2479,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sequences = append(m.Sequences, Sequence{})
-			if err := m.Sequences[len(m.Sequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractCodeHistory = append(m.ContractCodeHistory, ContractCodeHistoryEntry{})
+			if err := m.ContractCodeHistory[len(m.ContractCodeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -891,7 +2526,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Code) Unmarshal(dAtA []byte) error {
+func (m *Sequence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -914,67 +2549,15 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Code: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Code: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
-			}
-			m.CodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IDKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1001,16 +2584,16 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeBytes = append(m.CodeBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeBytes == nil {
-				m.CodeBytes = []byte{}
+			m.IDKey = append(m.IDKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.IDKey == nil {
+				m.IDKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pinned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var v int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1020,12 +2603,11 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Value |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Pinned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1048,7 +2630,7 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Contract) Unmarshal(dAtA []byte) error {
+func (m *DeletedContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1071,10 +2653,10 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeletedContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeletedContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1111,7 +2693,7 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCodeHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1138,15 +2720,67 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractCodeHistory = append(m.ContractCodeHistory, ContractCodeHistoryEntry{})
+			if err := m.ContractCodeHistory[len(m.ContractCodeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CodeExecutionCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeExecutionCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeExecutionCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1156,31 +2790,16 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractState = append(m.ContractState, Model{})
-			if err := m.ContractState[len(m.ContractState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractCodeHistory", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			var msglen int
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1190,26 +2809,30 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
 			}
-			m.ContractCodeHistory = append(m.ContractCodeHistory, ContractCodeHistoryEntry{})
-			if err := m.ContractCodeHistory[len(m.ContractCodeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1232,7 +2855,7 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Sequence) Unmarshal(dAtA []byte) error {
+func (m *AutoPinnedCode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1255,17 +2878,17 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoPinnedCode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoPinnedCode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IDKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			var byteLen int
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1275,31 +2898,16 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IDKey = append(m.IDKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.IDKey == nil {
-				m.IDKey = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeSize", wireType)
 			}
-			m.Value = 0
+			m.CodeSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1309,7 +2917,7 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= uint64(b&0x7F) << shift
+				m.CodeSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -18,6 +18,7 @@ import (
 const invalidAddress = "invalid address"
 
 func TestValidateGenesisState(t *testing.T) {
+	deletedAddr := sdk.AccAddress(make([]byte, ContractAddrLen)).String()
 	specs := map[string]struct {
 		srcMutator func(*GenesisState)
 		expError   bool
@@ -49,6 +50,267 @@ func TestValidateGenesisState(t *testing.T) {
 			},
 			expError: true,
 		},
+		"epoch hook subscriptions": {
+			srcMutator: func(s *GenesisState) {
+				s.EpochHookSubscriptions = []EpochHookSubscription{
+					{Contract: s.Contracts[0].ContractAddress, EpochIdentifier: "week", GasLimit: 1},
+					{Contract: s.Contracts[0].ContractAddress, EpochIdentifier: "day", GasLimit: 1},
+				}
+			},
+		},
+		"epoch hook subscription invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.EpochHookSubscriptions = []EpochHookSubscription{
+					{Contract: s.Contracts[0].ContractAddress, EpochIdentifier: "week"},
+				}
+			},
+			expError: true,
+		},
+		"fee shares": {
+			srcMutator: func(s *GenesisState) {
+				s.FeeShares = []FeeShare{
+					{Contract: s.Contracts[0].ContractAddress, WithdrawAddress: s.Contracts[0].ContractInfo.Creator},
+				}
+			},
+		},
+		"fee share invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.FeeShares = []FeeShare{
+					{Contract: s.Contracts[0].ContractAddress},
+				}
+			},
+			expError: true,
+		},
+		"fee share duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.FeeShares = []FeeShare{
+					{Contract: s.Contracts[0].ContractAddress, WithdrawAddress: s.Contracts[0].ContractInfo.Creator},
+					{Contract: s.Contracts[0].ContractAddress, WithdrawAddress: s.Contracts[0].ContractAddress},
+				}
+			},
+			expError: true,
+		},
+		"fee sponsorships": {
+			srcMutator: func(s *GenesisState) {
+				s.FeeSponsorships = []FeeSponsorship{
+					{Contract: s.Contracts[0].ContractAddress, PerBlockLimit: sdk.NewCoins(sdk.NewInt64Coin("denom", 1)), PerSenderLimit: sdk.NewCoins(sdk.NewInt64Coin("denom", 1)), ApprovalGasLimit: 1},
+				}
+			},
+		},
+		"fee sponsorship invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.FeeSponsorships = []FeeSponsorship{
+					{Contract: s.Contracts[0].ContractAddress, ApprovalGasLimit: 1},
+				}
+			},
+			expError: true,
+		},
+		"fee sponsorship duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.FeeSponsorships = []FeeSponsorship{
+					{Contract: s.Contracts[0].ContractAddress, PerBlockLimit: sdk.NewCoins(sdk.NewInt64Coin("denom", 1)), PerSenderLimit: sdk.NewCoins(sdk.NewInt64Coin("denom", 1)), ApprovalGasLimit: 1},
+					{Contract: s.Contracts[0].ContractAddress, PerBlockLimit: sdk.NewCoins(sdk.NewInt64Coin("denom", 2)), PerSenderLimit: sdk.NewCoins(sdk.NewInt64Coin("denom", 2)), ApprovalGasLimit: 2},
+				}
+			},
+			expError: true,
+		},
+		"pending admin transfers": {
+			srcMutator: func(s *GenesisState) {
+				s.PendingAdminTransfers = []PendingAdminTransfer{
+					{Contract: s.Contracts[0].ContractAddress, NewAdmin: s.Contracts[0].ContractInfo.Creator},
+				}
+			},
+		},
+		"pending admin transfer invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.PendingAdminTransfers = []PendingAdminTransfer{
+					{Contract: s.Contracts[0].ContractAddress},
+				}
+			},
+			expError: true,
+		},
+		"pending admin transfer duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.PendingAdminTransfers = []PendingAdminTransfer{
+					{Contract: s.Contracts[0].ContractAddress, NewAdmin: s.Contracts[0].ContractInfo.Creator},
+					{Contract: s.Contracts[0].ContractAddress, NewAdmin: s.Contracts[0].ContractAddress},
+				}
+			},
+			expError: true,
+		},
+		"contract names": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractNames = []ContractName{
+					{Name: "mantra.dex.pool-factory", Contract: s.Contracts[0].ContractAddress},
+				}
+			},
+		},
+		"contract name invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractNames = []ContractName{
+					{Name: "Invalid Name", Contract: s.Contracts[0].ContractAddress},
+				}
+			},
+			expError: true,
+		},
+		"contract name duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractNames = []ContractName{
+					{Name: "mantra.dex.pool-factory", Contract: s.Contracts[0].ContractAddress},
+					{Name: "mantra.dex.pool-factory", Contract: s.Contracts[0].ContractInfo.Creator},
+				}
+			},
+			expError: true,
+		},
+		"contract with two names": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractNames = []ContractName{
+					{Name: "first", Contract: s.Contracts[0].ContractAddress},
+					{Name: "second", Contract: s.Contracts[0].ContractAddress},
+				}
+			},
+			expError: true,
+		},
+		"storage deposits": {
+			srcMutator: func(s *GenesisState) {
+				s.StorageDeposits = []StorageDeposit{
+					{Contract: s.Contracts[0].ContractAddress, Amount: sdk.NewInt64Coin("denom", 1)},
+				}
+			},
+		},
+		"storage deposit zero amount": {
+			srcMutator: func(s *GenesisState) {
+				s.StorageDeposits = []StorageDeposit{
+					{Contract: s.Contracts[0].ContractAddress, Amount: sdk.NewInt64Coin("denom", 0)},
+				}
+			},
+			expError: true,
+		},
+		"storage deposit duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.StorageDeposits = []StorageDeposit{
+					{Contract: s.Contracts[0].ContractAddress, Amount: sdk.NewInt64Coin("denom", 1)},
+					{Contract: s.Contracts[0].ContractAddress, Amount: sdk.NewInt64Coin("denom", 2)},
+				}
+			},
+			expError: true,
+		},
+		"deleted contract": {
+			srcMutator: func(s *GenesisState) {
+				s.DeletedContracts = []DeletedContract{deletedContractFixture(deletedAddr)}
+			},
+		},
+		"deleted contract without delete operation": {
+			srcMutator: func(s *GenesisState) {
+				deleted := deletedContractFixture(deletedAddr)
+				deleted.ContractCodeHistory = deleted.ContractCodeHistory[:1]
+				s.DeletedContracts = []DeletedContract{deleted}
+			},
+			expError: true,
+		},
+		"deleted contract without history": {
+			srcMutator: func(s *GenesisState) {
+				deleted := deletedContractFixture(deletedAddr)
+				deleted.ContractCodeHistory = nil
+				s.DeletedContracts = []DeletedContract{deleted}
+			},
+			expError: true,
+		},
+		"deleted contract duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.DeletedContracts = []DeletedContract{deletedContractFixture(deletedAddr), deletedContractFixture(deletedAddr)}
+			},
+			expError: true,
+		},
+		"deleted contract is active contract": {
+			srcMutator: func(s *GenesisState) {
+				s.DeletedContracts = []DeletedContract{deletedContractFixture(s.Contracts[0].ContractAddress)}
+			},
+			expError: true,
+		},
+		"code execution counts": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeExecutionCounts = []CodeExecutionCount{
+					{Height: 1, CodeID: 1, Executions: 2},
+					{Height: 2, CodeID: 1, Executions: 1},
+				}
+			},
+		},
+		"code execution count without executions": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeExecutionCounts = []CodeExecutionCount{{Height: 1, CodeID: 1}}
+			},
+			expError: true,
+		},
+		"code execution count duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeExecutionCounts = []CodeExecutionCount{
+					{Height: 1, CodeID: 1, Executions: 2},
+					{Height: 1, CodeID: 1, Executions: 1},
+				}
+			},
+			expError: true,
+		},
+		"auto pinned codes": {
+			srcMutator: func(s *GenesisState) {
+				s.AutoPinnedCodes = []AutoPinnedCode{{CodeID: 1, CodeSize: 100}}
+			},
+		},
+		"auto pinned code without size": {
+			srcMutator: func(s *GenesisState) {
+				s.AutoPinnedCodes = []AutoPinnedCode{{CodeID: 1}}
+			},
+			expError: true,
+		},
+		"auto pinned code duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.AutoPinnedCodes = []AutoPinnedCode{{CodeID: 1, CodeSize: 100}, {CodeID: 1, CodeSize: 200}}
+			},
+			expError: true,
+		},
+		"code gas multipliers": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeGasMultipliers = []CodeGasMultiplier{{CodeID: 1, MultiplierBps: 5_000}}
+			},
+		},
+		"code gas multiplier out of bounds": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeGasMultipliers = []CodeGasMultiplier{{CodeID: 1, MultiplierBps: MaxCodeGasMultiplierBps + 1}}
+			},
+			expError: true,
+		},
+		"code gas multiplier duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeGasMultipliers = []CodeGasMultiplier{{CodeID: 1, MultiplierBps: 5_000}, {CodeID: 1, MultiplierBps: 20_000}}
+			},
+			expError: true,
+		},
+		"code schemas": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeSchemas = []CodeSchema{{CodeID: 1, Schema: compressedTestContractSchema(t), ValidateMessages: true}}
+			},
+		},
+		"code schema not compressed": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeSchemas = []CodeSchema{{CodeID: 1, Schema: []byte(testContractSchema)}}
+			},
+			expError: true,
+		},
+		"code schema duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.CodeSchemas = []CodeSchema{{CodeID: 1, Schema: compressedTestContractSchema(t)}, {CodeID: 1, Schema: compressedTestContractSchema(t)}}
+			},
+			expError: true,
+		},
+		"epoch hook subscription duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.EpochHookSubscriptions = []EpochHookSubscription{
+					{Contract: s.Contracts[0].ContractAddress, EpochIdentifier: "week", GasLimit: 1},
+					{Contract: s.Contracts[0].ContractAddress, EpochIdentifier: "week", GasLimit: 2},
+				}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -211,3 +473,15 @@ func TestGenesisContractInfoMarshalUnmarshal(t *testing.T) {
 	require.NoError(t, dest.ReadExtension(&destExt))
 	assert.Equal(t, destExt.GetTitle(), "bar")
 }
+
+func deletedContractFixture(addr string) DeletedContract {
+	return DeletedContract{
+		ContractAddress: addr,
+		ContractCodeHistory: []ContractCodeHistoryEntry{
+			ContractCodeHistoryEntryFixture(),
+			ContractCodeHistoryEntryFixture(func(e *ContractCodeHistoryEntry) {
+				e.Operation = ContractCodeHistoryOperationTypeDelete
+			}),
+		},
+	}
+}
@@ -1,8 +1,16 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
 	"slices"
+	"strconv"
+	"strings"
 )
 
 // isJSONObjectWithTopLevelKey returns true if the given bytes are a valid JSON object
@@ -31,3 +39,112 @@ func isJSONObjectWithTopLevelKey(jsonBytes RawContractMessage, allowedKeys []str
 
 	panic("Reached unreachable code. This is a bug.")
 }
+
+// decimalStringPattern matches strings with a decimal number as used by the CosmWasm
+// Uint128 or Decimal types
+var decimalStringPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// jsonNumericValue returns the value of a JSON number or of a string with a decimal number
+func jsonNumericValue(v any) (*big.Rat, bool) {
+	switch x := v.(type) {
+	case json.Number:
+		return parseJSONNumber(x)
+	case string:
+		if len(x) > maxJSONNumberLength || !decimalStringPattern.MatchString(x) {
+			return nil, false
+		}
+		return new(big.Rat).SetString(x)
+	default:
+		return nil, false
+	}
+}
+
+// decodeStrictJSON decodes a JSON value without loss of number precision. Objects with
+// duplicate keys are rejected so that the decoded value can not differ from what the
+// contract sees. The nesting depth must have been checked before, for example with json.Valid.
+func decodeStrictJSON(bz []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	v, err := decodeStrictJSONValue(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	return v, nil
+}
+
+func decodeStrictJSONValue(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return tok, nil
+	}
+	switch delim {
+	case '{':
+		obj := make(map[string]any)
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := keyTok.(string)
+			if !ok {
+				return nil, errors.New("invalid object key")
+			}
+			if _, exists := obj[key]; exists {
+				return nil, fmt.Errorf("duplicate key %q", key)
+			}
+			if obj[key], err = decodeStrictJSONValue(dec); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	case '[':
+		arr := make([]any, 0)
+		for dec.More() {
+			v, err := decodeStrictJSONValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return arr, nil
+	default:
+		return nil, fmt.Errorf("unexpected delimiter %q", delim)
+	}
+}
+
+// jsonPathValue returns the value at the dot separated path in a decoded JSON value.
+// Array elements are selected by their index.
+func jsonPathValue(doc any, path string) (any, bool) {
+	v := doc
+	for _, segment := range strings.Split(path, ".") {
+		switch x := v.(type) {
+		case map[string]any:
+			var ok bool
+			if v, ok = x[segment]; !ok {
+				return nil, false
+			}
+		case []any:
+			idx, err := strconv.ParseUint(segment, 10, 32)
+			if err != nil || idx >= uint64(len(x)) {
+				return nil, false
+			}
+			v = x[idx]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
@@ -131,3 +131,85 @@ func TestDuplicateKeyGivesSameResult(t *testing.T) {
 		assert.Equal(t, "bar", document["event⑨thing"])
 	}
 }
+
+func TestJSONPathValue(t *testing.T) {
+	doc, err := decodeStrictJSON([]byte(`{"swap":{"amount":"100","path":[{"denom":"uatom"},{"denom":"uosmo"}],"memo":null}}`))
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		path     string
+		exp      any
+		expFound bool
+	}{
+		"object field": {
+			path:     "swap.amount",
+			exp:      "100",
+			expFound: true,
+		},
+		"array element": {
+			path:     "swap.path.1.denom",
+			exp:      "uosmo",
+			expFound: true,
+		},
+		"null field": {
+			path:     "swap.memo",
+			expFound: true,
+		},
+		"unknown field": {
+			path: "swap.other",
+		},
+		"index out of range": {
+			path: "swap.path.2",
+		},
+		"not an index": {
+			path: "swap.path.first",
+		},
+		"into string": {
+			path: "swap.amount.value",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, found := jsonPathValue(doc, spec.path)
+			assert.Equal(t, spec.expFound, found)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestDecodeStrictJSON(t *testing.T) {
+	got, err := decodeStrictJSON([]byte(`{"a":[1.5,true,null],"b":{"c":"d"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": []any{json.Number("1.5"), true, nil}, "b": map[string]any{"c": "d"}}, got)
+
+	_, err = decodeStrictJSON([]byte(`{"a":{"b":1,"b":2}}`))
+	require.Error(t, err)
+	_, err = decodeStrictJSON([]byte(`{} {}`))
+	require.Error(t, err)
+}
+
+func TestJSONNumericValue(t *testing.T) {
+	specs := map[string]struct {
+		src   any
+		exp   string
+		expOK bool
+	}{
+		"number":             {src: json.Number("1000"), exp: "1000", expOK: true},
+		"number exponent":    {src: json.Number("1e3"), exp: "1000", expOK: true},
+		"decimal string":     {src: "-1.5", exp: "-3/2", expOK: true},
+		"exponent too large": {src: json.Number("1e1001")},
+		"exponent string":    {src: "1e3"},
+		"fraction string":    {src: "1/3"},
+		"hex string":         {src: "0x10"},
+		"bool":               {src: true},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, ok := jsonNumericValue(spec.src)
+			require.Equal(t, spec.expOK, ok)
+			if ok {
+				assert.Equal(t, spec.exp, got.RatString())
+			}
+		})
+	}
+}
@@ -24,6 +24,9 @@ const (
 	RouterKey = ModuleName
 )
 
+// StorageDepositEscrowAddress is the account that holds the storage deposits of all contracts
+var StorageDepositEscrowAddress = sdk.AccAddress(address.Module(ModuleName, []byte("storage_deposit")))
+
 var (
 	CodeKeyPrefix                                  = []byte{0x01}
 	ContractKeyPrefix                              = []byte{0x02}
@@ -36,6 +39,30 @@ var (
 	ContractsByCreatorPrefix                       = []byte{0x09}
 	ParamsKey                                      = []byte{0x10}
 	AsyncAckKeyPrefix                              = []byte{0x11}
+	FrozenContractPrefix                           = []byte{0x12}
+	DeprecatedCodeIDPrefix                         = []byte{0x13}
+	DeprecatedChecksumPrefix                       = []byte{0x14}
+	CronSchedulePrefix                             = []byte{0x15}
+	EpochHookSubscriptionPrefix                    = []byte{0x16}
+	FeeSharePrefix                                 = []byte{0x17}
+	FeeSponsorshipPrefix                           = []byte{0x18}
+	FeeSponsorshipUsagePrefix                      = []byte{0x19}
+	PendingAdminTransferPrefix                     = []byte{0x1a}
+	ContractNamePrefix                             = []byte{0x1b}
+	ContractNameByAddressPrefix                    = []byte{0x1c}
+	ContractsByAdminPrefix                         = []byte{0x1d}
+	CodesByChecksumPrefix                          = []byte{0x1e}
+	ContractStorageUsagePrefix                     = []byte{0x1f}
+	ContractsByStorageSizePrefix                   = []byte{0x20}
+	StorageDepositPrefix                           = []byte{0x21}
+	DeletedContractPrefix                          = []byte{0x22}
+	CodeExecutionCountPrefix                       = []byte{0x23}
+	CodeExecutionStatsPrefix                       = []byte{0x24}
+	CodesByExecutionCountPrefix                    = []byte{0x25}
+	AutoPinnedCodePrefix                           = []byte{0x26}
+	CodeGasMultiplierPrefix                        = []byte{0x27}
+	CodeSchemaPrefix                               = []byte{0x28}
+	FeeSponsorshipSenderUsagePrefix                = []byte{0x29}
 
 	KeySequenceCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeySequenceInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
@@ -52,12 +79,169 @@ func GetContractAddressKey(addr sdk.AccAddress) []byte {
 	return append(ContractKeyPrefix, addr...)
 }
 
+// GetFrozenContractKey returns the key for the frozen flag of a WASM contract instance
+func GetFrozenContractKey(addr sdk.AccAddress) []byte {
+	return append(FrozenContractPrefix, addr...)
+}
+
+// GetDeprecatedCodeIDKey returns the key for the deprecation flag of a code id
+func GetDeprecatedCodeIDKey(codeID uint64) []byte {
+	return append(DeprecatedCodeIDPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetDeprecatedChecksumKey returns the key for the deprecation flag of a code checksum
+func GetDeprecatedChecksumKey(checksum []byte) []byte {
+	return append(DeprecatedChecksumPrefix, checksum...)
+}
+
+// GetCronScheduleKey returns the key for a cron schedule
+func GetCronScheduleKey(name string) []byte {
+	return append(CronSchedulePrefix, name...)
+}
+
+// GetEpochHookSubscriptionsPrefix returns the prefix for all subscriptions to the epoch identifier:
+// `<prefix><identifier length><identifier>`
+func GetEpochHookSubscriptionsPrefix(epochIdentifier string) []byte {
+	return append(EpochHookSubscriptionPrefix, address.MustLengthPrefix([]byte(epochIdentifier))...)
+}
+
+// GetEpochHookSubscriptionKey returns the key for a contract subscription to the epoch identifier:
+// `<prefix><identifier length><identifier><contractAddr>`
+func GetEpochHookSubscriptionKey(epochIdentifier string, contractAddr sdk.AccAddress) []byte {
+	return append(GetEpochHookSubscriptionsPrefix(epochIdentifier), contractAddr...)
+}
+
+// GetFeeShareKey returns the key for the fee share registration of a contract
+func GetFeeShareKey(contractAddr sdk.AccAddress) []byte {
+	return append(FeeSharePrefix, contractAddr...)
+}
+
+// GetFeeSponsorshipKey returns the key for the fee sponsorship of a contract
+func GetFeeSponsorshipKey(contractAddr sdk.AccAddress) []byte {
+	return append(FeeSponsorshipPrefix, contractAddr...)
+}
+
+// GetFeeSponsorshipUsageKey returns the key for the fees paid by a sponsoring contract in the current block
+func GetFeeSponsorshipUsageKey(contractAddr sdk.AccAddress) []byte {
+	return append(FeeSponsorshipUsagePrefix, contractAddr...)
+}
+
+// GetFeeSponsorshipSenderUsagesPrefix returns the prefix for the fees paid by a sponsoring contract per sender:
+// `<prefix><contractAddr length><contractAddr>`
+func GetFeeSponsorshipSenderUsagesPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(FeeSponsorshipSenderUsagePrefix, address.MustLengthPrefix(contractAddr)...)
+}
+
+// GetFeeSponsorshipSenderUsageKey returns the key for the fees paid by a sponsoring contract for a sender in a block:
+// `<prefix><contractAddr length><contractAddr><height><sender>`
+func GetFeeSponsorshipSenderUsageKey(contractAddr sdk.AccAddress, height int64, sender sdk.AccAddress) []byte {
+	prefix := GetFeeSponsorshipSenderUsagesPrefix(contractAddr)
+	return append(append(prefix, sdk.Uint64ToBigEndian(uint64(height))...), sender...)
+}
+
+// GetPendingAdminTransferKey returns the key for the pending admin transfer of a contract
+func GetPendingAdminTransferKey(contractAddr sdk.AccAddress) []byte {
+	return append(PendingAdminTransferPrefix, contractAddr...)
+}
+
+// GetContractNameKey returns the key for the contract address of a claimed name
+func GetContractNameKey(name string) []byte {
+	return append(ContractNamePrefix, []byte(name)...)
+}
+
+// GetContractNameByAddressKey returns the key for the name claimed by a contract
+func GetContractNameByAddressKey(contractAddr sdk.AccAddress) []byte {
+	return append(ContractNameByAddressPrefix, contractAddr...)
+}
+
 // GetContractsByCreatorPrefix returns the contracts by creator prefix for the WASM contract instance
 func GetContractsByCreatorPrefix(addr sdk.AccAddress) []byte {
 	bz := address.MustLengthPrefix(addr)
 	return append(ContractsByCreatorPrefix, bz...)
 }
 
+// GetContractsByAdminPrefix returns the contracts by admin prefix: `<prefix><adminAddress length><adminAddress>`
+func GetContractsByAdminPrefix(addr sdk.AccAddress) []byte {
+	bz := address.MustLengthPrefix(addr)
+	return append(ContractsByAdminPrefix, bz...)
+}
+
+// GetContractByAdminSecondaryIndexKey returns the key for the secondary index: `<prefix><adminAddress length><adminAddress><contractAddr>`
+func GetContractByAdminSecondaryIndexKey(adminAddr, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractsByAdminPrefix(adminAddr), contractAddr...)
+}
+
+// GetCodesByChecksumPrefix returns the prefix for all code ids with the checksum: `<prefix><checksum length><checksum>`
+func GetCodesByChecksumPrefix(checksum []byte) []byte {
+	return append(CodesByChecksumPrefix, address.MustLengthPrefix(checksum)...)
+}
+
+// GetCodeByChecksumSecondaryIndexKey returns the key for the secondary index: `<prefix><checksum length><checksum><codeID>`
+func GetCodeByChecksumSecondaryIndexKey(checksum []byte, codeID uint64) []byte {
+	return append(GetCodesByChecksumPrefix(checksum), sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetContractStorageUsageKey returns the key for the storage usage counters of a WASM contract instance
+func GetContractStorageUsageKey(addr sdk.AccAddress) []byte {
+	return append(ContractStorageUsagePrefix, addr...)
+}
+
+// GetContractByStorageSizeSecondaryIndexKey returns the key for the secondary index: `<prefix><bytes><contractAddr>`
+// Entries are ordered by the number of bytes a contract stores, ascending.
+func GetContractByStorageSizeSecondaryIndexKey(bytes uint64, contractAddr sdk.AccAddress) []byte {
+	r := make([]byte, 0, len(ContractsByStorageSizePrefix)+8+len(contractAddr))
+	r = append(r, ContractsByStorageSizePrefix...)
+	r = append(r, sdk.Uint64ToBigEndian(bytes)...)
+	return append(r, contractAddr...)
+}
+
+// GetStorageDepositKey returns the key for the storage deposit held for a WASM contract instance
+func GetStorageDepositKey(addr sdk.AccAddress) []byte {
+	return append(StorageDepositPrefix, addr...)
+}
+
+// GetDeletedContractKey returns the key for the tombstone of a deleted WASM contract instance
+func GetDeletedContractKey(addr sdk.AccAddress) []byte {
+	return append(DeletedContractPrefix, addr...)
+}
+
+// GetCodeExecutionCountKey returns the key for the number of executions of a code in a block
+func GetCodeExecutionCountKey(height int64, codeID uint64) []byte {
+	r := make([]byte, 0, len(CodeExecutionCountPrefix)+16)
+	r = append(r, CodeExecutionCountPrefix...)
+	r = append(r, sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(r, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodeExecutionStatsKey returns the key for the number of executions of a code within the auto pin window
+func GetCodeExecutionStatsKey(codeID uint64) []byte {
+	return append(CodeExecutionStatsPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodeByExecutionCountSecondaryIndexKey returns the key for the index of codes ordered by executions within
+// the auto pin window. Key layout: <prefix><executions><code id>
+func GetCodeByExecutionCountSecondaryIndexKey(executions, codeID uint64) []byte {
+	r := make([]byte, 0, len(CodesByExecutionCountPrefix)+16)
+	r = append(r, CodesByExecutionCountPrefix...)
+	r = append(r, sdk.Uint64ToBigEndian(executions)...)
+	return append(r, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetAutoPinnedCodeKey returns the key for a code that was pinned automatically
+func GetAutoPinnedCodeKey(codeID uint64) []byte {
+	return append(AutoPinnedCodePrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodeGasMultiplierKey returns the key for the gas multiplier of a code
+func GetCodeGasMultiplierKey(codeID uint64) []byte {
+	return append(CodeGasMultiplierPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodeSchemaKey returns the key for the JSON schema of a code
+func GetCodeSchemaKey(codeID uint64) []byte {
+	return append(CodeSchemaPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
 // GetContractStorePrefix returns the store prefix for the WASM contract instance
 func GetContractStorePrefix(addr sdk.AccAddress) []byte {
 	return append(ContractStorePrefix, addr...)
@@ -146,3 +146,13 @@ func TestGetContractByCreatorSecondaryIndexKey(t *testing.T) {
 	}
 	assert.Equal(t, exp, got)
 }
+
+func TestGetCodeByExecutionCountSecondaryIndexKey(t *testing.T) {
+	got := GetCodeByExecutionCountSecondaryIndexKey(2, 1)
+	exp := []byte{
+		0x25,                   // prefix
+		0, 0, 0, 0, 0, 0, 0, 2, // executions
+		0, 0, 0, 0, 0, 0, 0, 1, // code id
+	}
+	assert.Equal(t, exp, got)
+}
@@ -107,9 +107,40 @@ func (p Params) ValidateBasic() error {
 	if err := p.CodeUploadAccess.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "upload access")
 	}
+	if p.DeveloperFeeShareBps > MaxFeeShareBps {
+		return errorsmod.Wrapf(ErrInvalid, "developer fee share must not be greater than %d bps", MaxFeeShareBps)
+	}
+	if p.StorageDepositPerByte != 0 || p.StorageDepositDenom != "" {
+		if err := sdk.ValidateDenom(p.StorageDepositDenom); err != nil {
+			return errorsmod.Wrap(err, "storage deposit denom")
+		}
+	}
+	if p.AutoPinEnabled() {
+		if p.AutoPinMaxCodes == 0 {
+			return errorsmod.Wrap(ErrEmpty, "auto pin max codes")
+		}
+		if p.AutoPinMemoryBudget == 0 {
+			return errorsmod.Wrap(ErrEmpty, "auto pin memory budget")
+		}
+	}
+	if p.GasRegister != nil {
+		if err := p.GasRegister.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "gas register")
+		}
+	}
 	return nil
 }
 
+// AutoPinEnabled returns true when the most executed codes are pinned automatically
+func (p Params) AutoPinEnabled() bool {
+	return p.AutoPinWindowBlocks != 0
+}
+
+// StorageDepositEnabled returns true when contracts must hold a deposit for the bytes they store
+func (p Params) StorageDepositEnabled() bool {
+	return p.StorageDepositPerByte != 0
+}
+
 func validateAccessType(a AccessType) error {
 	if a == AccessTypeUnspecified {
 		return errorsmod.Wrap(ErrEmpty, "type")
@@ -51,6 +51,95 @@ func TestValidateParams(t *testing.T) {
 				InstantiateDefaultPermission: AccessTypeAnyOfAddresses,
 			},
 		},
+		"all good with developer fee share": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				DeveloperFeeShareBps:         MaxFeeShareBps,
+			},
+		},
+		"reject developer fee share above max": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				DeveloperFeeShareBps:         MaxFeeShareBps + 1,
+			},
+			expErr: true,
+		},
+		"all good with storage deposit": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				StorageDepositDenom:          "uom",
+				StorageDepositPerByte:        10,
+			},
+		},
+		"reject storage deposit without denom": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				StorageDepositPerByte:        10,
+			},
+			expErr: true,
+		},
+		"reject invalid storage deposit denom": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				StorageDepositDenom:          "1",
+			},
+			expErr: true,
+		},
+		"all good with auto pin": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				AutoPinWindowBlocks:          100,
+				AutoPinMaxCodes:              10,
+				AutoPinMemoryBudget:          10 << 20,
+			},
+		},
+		"reject auto pin without max codes": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				AutoPinWindowBlocks:          100,
+				AutoPinMemoryBudget:          10 << 20,
+			},
+			expErr: true,
+		},
+		"reject auto pin without memory budget": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				AutoPinWindowBlocks:          100,
+				AutoPinMaxCodes:              10,
+			},
+			expErr: true,
+		},
+		"all good with gas register": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  &GasRegisterParams{GasMultiplier: 1, UncompressCostDenominator: 1},
+			},
+		},
+		"reject gas register without multiplier": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  &GasRegisterParams{UncompressCostDenominator: 1},
+			},
+			expErr: true,
+		},
+		"reject gas register without uncompress cost denominator": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasRegister:                  &GasRegisterParams{GasMultiplier: 1},
+			},
+			expErr: true,
+		},
 		"reject empty type in instantiate permission": {
 			src: Params{
 				CodeUploadAccess: AllowNobody,
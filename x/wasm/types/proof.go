@@ -0,0 +1,31 @@
+package types
+
+import (
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRawContractStateStoreKey returns the key of a raw contract state entry within the wasm module store:
+// `<contract store prefix><contractAddr><key>`
+func GetRawContractStateStoreKey(contractAddr sdk.AccAddress, key []byte) []byte {
+	return append(GetContractStorePrefix(contractAddr), key...)
+}
+
+// GetRawContractStateMerklePath returns the ICS23 merkle path of a raw contract state entry from the app hash
+func GetRawContractStateMerklePath(contractAddr sdk.AccAddress, key []byte) commitmenttypesv2.MerklePath {
+	return commitmenttypesv2.NewMerklePath([]byte(StoreKey), GetRawContractStateStoreKey(contractAddr, key))
+}
+
+// VerifyRawContractStateProof verifies the ICS23 merkle proof of a raw contract state entry against the app hash.
+// An empty value verifies that the key does not exist. The state of a block at height H is committed by the
+// app hash in the header of block H+1.
+func VerifyRawContractStateProof(appHash []byte, contractAddr sdk.AccAddress, key, value []byte, proof commitmenttypes.MerkleProof) error {
+	root := commitmenttypes.NewMerkleRoot(appHash)
+	path := GetRawContractStateMerklePath(contractAddr, key)
+	if len(value) == 0 {
+		return proof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), root, path)
+	}
+	return proof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, path, value)
+}
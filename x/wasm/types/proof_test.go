@@ -0,0 +1,112 @@
+package types
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestVerifyRawContractStateProof(t *testing.T) {
+	contractAddr := sdk.AccAddress(make([]byte, ContractAddrLen))
+	otherContractAddr := sdk.AccAddress(append(make([]byte, ContractAddrLen-1), 1))
+
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	storeKey := storetypes.NewKVStoreKey(StoreKey)
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(storetypes.NewKVStoreKey("other"), storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetKVStore(storeKey).Set(GetRawContractStateStoreKey(contractAddr, []byte("foo")), []byte(`"bar"`))
+	ms.GetKVStore(storeKey).Set(GetRawContractStateStoreKey(contractAddr, []byte("zzz")), []byte(`1`))
+	commitID := ms.Commit()
+
+	queryProof := func(t *testing.T, contractAddr sdk.AccAddress, key []byte) commitmenttypes.MerkleProof {
+		rsp, err := ms.Query(&storetypes.RequestQuery{
+			Path:   "/" + StoreKey + "/key",
+			Data:   GetRawContractStateStoreKey(contractAddr, key),
+			Height: commitID.Version,
+			Prove:  true,
+		})
+		require.NoError(t, err)
+		proof, err := commitmenttypes.ConvertProofs(rsp.ProofOps)
+		require.NoError(t, err)
+		return proof
+	}
+
+	specs := map[string]struct {
+		appHash      []byte
+		contractAddr sdk.AccAddress
+		key          []byte
+		value        []byte
+		proofKey     []byte
+		expErr       bool
+	}{
+		"existing key": {
+			key:   []byte("foo"),
+			value: []byte(`"bar"`),
+		},
+		"absent key": {
+			key: []byte("baz"),
+		},
+		"wrong value": {
+			key:    []byte("foo"),
+			value:  []byte(`"other"`),
+			expErr: true,
+		},
+		"existing key claimed absent": {
+			key:    []byte("foo"),
+			expErr: true,
+		},
+		"proof for other key": {
+			key:      []byte("zzz"),
+			value:    []byte(`1`),
+			proofKey: []byte("foo"),
+			expErr:   true,
+		},
+		"other contract": {
+			contractAddr: otherContractAddr,
+			key:          []byte("foo"),
+			value:        []byte(`"bar"`),
+			expErr:       true,
+		},
+		"wrong app hash": {
+			appHash: make([]byte, 32),
+			key:     []byte("foo"),
+			value:   []byte(`"bar"`),
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			appHash := commitID.Hash
+			if spec.appHash != nil {
+				appHash = spec.appHash
+			}
+			addr := contractAddr
+			if spec.contractAddr != nil {
+				addr = spec.contractAddr
+			}
+			proofKey := spec.key
+			if spec.proofKey != nil {
+				proofKey = spec.proofKey
+			}
+			proof := queryProof(t, contractAddr, proofKey)
+
+			gotErr := VerifyRawContractStateProof(appHash, addr, spec.key, spec.value, proof)
+			if spec.expErr {
+				assert.Error(t, gotErr)
+				return
+			}
+			assert.NoError(t, gotErr)
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"strings"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -73,6 +75,11 @@ func (msg MsgStoreCode) ValidateBasic() error {
 			return errorsmod.Wrap(err, "instantiate permission")
 		}
 	}
+	if msg.Metadata != nil {
+		if err := msg.Metadata.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "metadata")
+		}
+	}
 	return nil
 }
 
@@ -553,3 +560,468 @@ func (msg MsgUpdateContractLabel) ValidateBasic() error {
 	}
 	return nil
 }
+
+func (msg MsgFreezeContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgFreezeContract) Type() string {
+	return "freeze-contract"
+}
+
+func (msg MsgFreezeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgUnfreezeContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUnfreezeContract) Type() string {
+	return "unfreeze-contract"
+}
+
+func (msg MsgUnfreezeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgDeprecateCodes) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDeprecateCodes) Type() string {
+	return "deprecate-codes"
+}
+
+func (msg MsgDeprecateCodes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	return validateDeprecationTargets(msg.CodeIDs, msg.Checksums)
+}
+
+func (msg MsgUndeprecateCodes) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUndeprecateCodes) Type() string {
+	return "undeprecate-codes"
+}
+
+func (msg MsgUndeprecateCodes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	return validateDeprecationTargets(msg.CodeIDs, msg.Checksums)
+}
+
+// validateDeprecationTargets ensures that at least one code id or checksum is set,
+// that neither list has duplicates and that the total does not exceed the max number of code IDs
+func validateDeprecationTargets(codeIDs []uint64, checksums [][]byte) error {
+	switch n := len(codeIDs) + len(checksums); {
+	case n == 0:
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty code ids and checksums")
+	case n > maxCodeIDCount:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "total number of code ids and checksums is greater than %d", maxCodeIDCount)
+	}
+	if hasDuplicates(codeIDs) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "duplicate code ids")
+	}
+	seen := make([]string, len(checksums))
+	for i, c := range checksums {
+		if err := ValidateChecksum(c); err != nil {
+			return errorsmod.Wrapf(err, "checksum %d", i)
+		}
+		seen[i] = string(c)
+	}
+	if hasDuplicates(seen) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "duplicate checksums")
+	}
+	return nil
+}
+
+// ValidateChecksum ensures the given bytes have the length of a wasm code checksum
+func ValidateChecksum(checksum []byte) error {
+	if len(checksum) != wasmvmtypes.ChecksumLen {
+		return errorsmod.Wrapf(ErrInvalid, "checksum must be %d bytes", wasmvmtypes.ChecksumLen)
+	}
+	return nil
+}
+
+func (msg MsgRegisterCronSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRegisterCronSchedule) Type() string {
+	return "register-cron-schedule"
+}
+
+func (msg MsgRegisterCronSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	return CronSchedule{
+		Name:      msg.Name,
+		Contract:  msg.Contract,
+		Msg:       msg.Msg,
+		Interval:  msg.Interval,
+		GasLimit:  msg.GasLimit,
+		MaxErrors: msg.MaxErrors,
+	}.ValidateBasic()
+}
+
+func (msg MsgRemoveCronSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRemoveCronSchedule) Type() string {
+	return "remove-cron-schedule"
+}
+
+func (msg MsgRemoveCronSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	if err := ValidateCronScheduleName(msg.Name); err != nil {
+		return errorsmod.Wrap(err, "name")
+	}
+	return nil
+}
+
+func (msg MsgSubscribeEpochHook) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSubscribeEpochHook) Type() string {
+	return "subscribe-epoch-hook"
+}
+
+func (msg MsgSubscribeEpochHook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	return EpochHookSubscription{
+		Contract:        msg.Contract,
+		EpochIdentifier: msg.EpochIdentifier,
+		GasLimit:        msg.GasLimit,
+	}.ValidateBasic()
+}
+
+func (msg MsgUnsubscribeEpochHook) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUnsubscribeEpochHook) Type() string {
+	return "unsubscribe-epoch-hook"
+}
+
+func (msg MsgUnsubscribeEpochHook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := ValidateEpochIdentifier(msg.EpochIdentifier); err != nil {
+		return errorsmod.Wrap(err, "epoch identifier")
+	}
+	return nil
+}
+
+func (msg MsgRegisterFeeShare) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRegisterFeeShare) Type() string {
+	return "register-fee-share"
+}
+
+func (msg MsgRegisterFeeShare) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	return FeeShare{
+		Contract:        msg.Contract,
+		WithdrawAddress: msg.WithdrawAddress,
+	}.ValidateBasic()
+}
+
+func (msg MsgCancelFeeShare) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelFeeShare) Type() string {
+	return "cancel-fee-share"
+}
+
+func (msg MsgCancelFeeShare) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgSetFeeSponsorship) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetFeeSponsorship) Type() string {
+	return "set-fee-sponsorship"
+}
+
+func (msg MsgSetFeeSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	return FeeSponsorship{
+		Contract:         msg.Contract,
+		PerBlockLimit:    msg.PerBlockLimit,
+		PerSenderLimit:   msg.PerSenderLimit,
+		ApprovalGasLimit: msg.ApprovalGasLimit,
+	}.ValidateBasic()
+}
+
+func (msg MsgRemoveFeeSponsorship) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRemoveFeeSponsorship) Type() string {
+	return "remove-fee-sponsorship"
+}
+
+func (msg MsgRemoveFeeSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgProposeContractAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgProposeContractAdmin) Type() string {
+	return "propose-contract-admin"
+}
+
+func (msg MsgProposeContractAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+		return errorsmod.Wrap(err, "new admin")
+	}
+	if strings.EqualFold(msg.Sender, msg.NewAdmin) {
+		return errorsmod.Wrap(ErrInvalid, "new admin is the same as the old")
+	}
+	return nil
+}
+
+func (msg MsgAcceptContractAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAcceptContractAdmin) Type() string {
+	return "accept-contract-admin"
+}
+
+func (msg MsgAcceptContractAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgCancelContractAdminTransfer) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelContractAdminTransfer) Type() string {
+	return "cancel-contract-admin-transfer"
+}
+
+func (msg MsgCancelContractAdminTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgSetCodeMetadata) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetCodeMetadata) Type() string {
+	return "set-code-metadata"
+}
+
+func (msg MsgSetCodeMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "code id")
+	}
+	if err := msg.Metadata.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "metadata")
+	}
+	return nil
+}
+
+func (msg MsgRegisterContractName) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRegisterContractName) Type() string {
+	return "register-contract-name"
+}
+
+func (msg MsgRegisterContractName) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := ValidateContractName(msg.Name); err != nil {
+		return errorsmod.Wrap(err, "name")
+	}
+	return nil
+}
+
+func (msg MsgTransferContractName) Route() string {
+	return RouterKey
+}
+
+func (msg MsgTransferContractName) Type() string {
+	return "transfer-contract-name"
+}
+
+func (msg MsgTransferContractName) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if err := ValidateContractName(msg.Name); err != nil {
+		return errorsmod.Wrap(err, "name")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewContract); err != nil {
+		return errorsmod.Wrap(err, "new contract")
+	}
+	return nil
+}
+
+func (msg MsgReleaseContractName) Route() string {
+	return RouterKey
+}
+
+func (msg MsgReleaseContractName) Type() string {
+	return "release-contract-name"
+}
+
+func (msg MsgReleaseContractName) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if err := ValidateContractName(msg.Name); err != nil {
+		return errorsmod.Wrap(err, "name")
+	}
+	return nil
+}
+
+func (msg MsgDeleteContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDeleteContract) Type() string {
+	return "delete-contract"
+}
+
+func (msg MsgDeleteContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if msg.SweepRecipient != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.SweepRecipient); err != nil {
+			return errorsmod.Wrap(err, "sweep recipient")
+		}
+	}
+	return nil
+}
+
+func (msg MsgSetCodeGasMultiplier) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetCodeGasMultiplier) Type() string {
+	return "set-code-gas-multiplier"
+}
+
+func (msg MsgSetCodeGasMultiplier) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	if msg.CodeID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "code id")
+	}
+	if msg.MultiplierBps == 0 {
+		return nil
+	}
+	return validateCodeGasMultiplierBps(msg.MultiplierBps)
+}
+
+func (msg MsgSetCodeSchema) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetCodeSchema) Type() string {
+	return "set-code-schema"
+}
+
+func (msg MsgSetCodeSchema) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "code id")
+	}
+	if len(msg.Schema) == 0 {
+		if msg.ValidateMessages {
+			return errorsmod.Wrap(ErrInvalid, "message validation requires a schema")
+		}
+		return nil
+	}
+	if _, err := ParseCompressedContractSchema(msg.Schema); err != nil {
+		return errorsmod.Wrap(err, "schema")
+	}
+	return nil
+}
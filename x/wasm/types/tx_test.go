@@ -65,6 +65,22 @@ func TestStoreCodeValidation(t *testing.T) {
 			},
 			valid: false,
 		},
+		"with metadata": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Metadata:     &CodeMetadata{SourceRepository: "https://github.com/CosmWasm/cw-plus", SchemaHash: "abcd"},
+			},
+			valid: true,
+		},
+		"invalid metadata": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Metadata:     &CodeMetadata{SchemaHash: "not hex"},
+			},
+			valid: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -1484,3 +1500,1226 @@ func TestMsgUpdateContractLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgFreezeContractValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgFreezeContract
+		expErr bool
+	}{
+		"all good": {
+			src: MsgFreezeContract{
+				Authority: goodAddress,
+				Contract:  otherGoodAddress,
+			},
+		},
+		"bad authority": {
+			src: MsgFreezeContract{
+				Authority: badAddress,
+				Contract:  otherGoodAddress,
+			},
+			expErr: true,
+		},
+		"empty authority": {
+			src: MsgFreezeContract{
+				Contract: otherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgFreezeContract{
+				Authority: goodAddress,
+				Contract:  badAddress,
+			},
+			expErr: true,
+		},
+		"empty contract addr": {
+			src: MsgFreezeContract{
+				Authority: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnfreezeContractValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUnfreezeContract
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUnfreezeContract{
+				Authority: goodAddress,
+				Contract:  otherGoodAddress,
+			},
+		},
+		"bad authority": {
+			src: MsgUnfreezeContract{
+				Authority: badAddress,
+				Contract:  otherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgUnfreezeContract{
+				Authority: goodAddress,
+				Contract:  badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDeprecateCodesValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	checksum := bytes.Repeat([]byte{0x1}, 32)
+	specs := map[string]struct {
+		src    MsgDeprecateCodes
+		expErr bool
+	}{
+		"all good": {
+			src: MsgDeprecateCodes{
+				Authority: goodAddress,
+				CodeIDs:   []uint64{1},
+				Checksums: [][]byte{checksum},
+			},
+		},
+		"code ids only": {
+			src: MsgDeprecateCodes{
+				Authority: goodAddress,
+				CodeIDs:   []uint64{1},
+			},
+		},
+		"checksums only": {
+			src: MsgDeprecateCodes{
+				Authority: goodAddress,
+				Checksums: [][]byte{checksum},
+			},
+		},
+		"bad authority": {
+			src: MsgDeprecateCodes{
+				Authority: badAddress,
+				CodeIDs:   []uint64{1},
+			},
+			expErr: true,
+		},
+		"empty authority": {
+			src: MsgDeprecateCodes{
+				CodeIDs: []uint64{1},
+			},
+			expErr: true,
+		},
+		"empty code ids and checksums": {
+			src: MsgDeprecateCodes{
+				Authority: goodAddress,
+			},
+			expErr: true,
+		},
+		"exceeds max code ids": {
+			src: MsgDeprecateCodes{
+				Authority: goodAddress,
+				CodeIDs:   genCodeIDs(50),
+				Checksums: [][]byte{checksum},
+			},
+			expErr: true,
+		},
+		"duplicate code ids": {
+			src: MsgDeprecateCodes{
+				Authority: goodAddress,
+				CodeIDs:   []uint64{1, 1},
+			},
+			expErr: true,
+		},
+		"duplicate checksums": {
+			src: MsgDeprecateCodes{
+				Authority: goodAddress,
+				Checksums: [][]byte{checksum, checksum},
+			},
+			expErr: true,
+		},
+		"invalid checksum length": {
+			src: MsgDeprecateCodes{
+				Authority: goodAddress,
+				Checksums: [][]byte{checksum[1:]},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUndeprecateCodesValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	checksum := bytes.Repeat([]byte{0x1}, 32)
+	specs := map[string]struct {
+		src    MsgUndeprecateCodes
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUndeprecateCodes{
+				Authority: goodAddress,
+				CodeIDs:   []uint64{1},
+				Checksums: [][]byte{checksum},
+			},
+		},
+		"bad authority": {
+			src: MsgUndeprecateCodes{
+				Authority: badAddress,
+				CodeIDs:   []uint64{1},
+			},
+			expErr: true,
+		},
+		"empty code ids and checksums": {
+			src: MsgUndeprecateCodes{
+				Authority: goodAddress,
+			},
+			expErr: true,
+		},
+		"invalid checksum length": {
+			src: MsgUndeprecateCodes{
+				Authority: goodAddress,
+				Checksums: [][]byte{checksum[1:]},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRegisterCronScheduleValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgRegisterCronSchedule
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRegisterCronSchedule{
+				Authority: goodAddress,
+				Name:      "my-schedule_1.0",
+				Contract:  goodAddress,
+				Msg:       []byte(`{"foo":"bar"}`),
+				Interval:  1,
+				GasLimit:  100_000,
+				MaxErrors: 3,
+			},
+		},
+		"bad authority": {
+			src: MsgRegisterCronSchedule{
+				Authority: badAddress,
+				Name:      "my-schedule",
+				Contract:  goodAddress,
+				Msg:       []byte(`{"foo":"bar"}`),
+				Interval:  1,
+				GasLimit:  100_000,
+			},
+			expErr: true,
+		},
+		"empty name": {
+			src: MsgRegisterCronSchedule{
+				Authority: goodAddress,
+				Contract:  goodAddress,
+				Msg:       []byte(`{"foo":"bar"}`),
+				Interval:  1,
+				GasLimit:  100_000,
+			},
+			expErr: true,
+		},
+		"invalid name": {
+			src: MsgRegisterCronSchedule{
+				Authority: goodAddress,
+				Name:      "my schedule",
+				Contract:  goodAddress,
+				Msg:       []byte(`{"foo":"bar"}`),
+				Interval:  1,
+				GasLimit:  100_000,
+			},
+			expErr: true,
+		},
+		"name too long": {
+			src: MsgRegisterCronSchedule{
+				Authority: goodAddress,
+				Name:      strings.Repeat("a", MaxCronScheduleNameSize+1),
+				Contract:  goodAddress,
+				Msg:       []byte(`{"foo":"bar"}`),
+				Interval:  1,
+				GasLimit:  100_000,
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgRegisterCronSchedule{
+				Authority: goodAddress,
+				Name:      "my-schedule",
+				Contract:  badAddress,
+				Msg:       []byte(`{"foo":"bar"}`),
+				Interval:  1,
+				GasLimit:  100_000,
+			},
+			expErr: true,
+		},
+		"invalid msg": {
+			src: MsgRegisterCronSchedule{
+				Authority: goodAddress,
+				Name:      "my-schedule",
+				Contract:  goodAddress,
+				Msg:       []byte(`not json`),
+				Interval:  1,
+				GasLimit:  100_000,
+			},
+			expErr: true,
+		},
+		"empty interval": {
+			src: MsgRegisterCronSchedule{
+				Authority: goodAddress,
+				Name:      "my-schedule",
+				Contract:  goodAddress,
+				Msg:       []byte(`{"foo":"bar"}`),
+				GasLimit:  100_000,
+			},
+			expErr: true,
+		},
+		"empty gas limit": {
+			src: MsgRegisterCronSchedule{
+				Authority: goodAddress,
+				Name:      "my-schedule",
+				Contract:  goodAddress,
+				Msg:       []byte(`{"foo":"bar"}`),
+				Interval:  1,
+			},
+			expErr: true,
+		},
+		"gas limit exceeds max": {
+			src: MsgRegisterCronSchedule{
+				Authority: goodAddress,
+				Name:      "my-schedule",
+				Contract:  goodAddress,
+				Msg:       []byte(`{"foo":"bar"}`),
+				Interval:  1,
+				GasLimit:  MaxCronGasLimit + 1,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveCronScheduleValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgRemoveCronSchedule
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRemoveCronSchedule{
+				Authority: goodAddress,
+				Name:      "my-schedule",
+			},
+		},
+		"bad authority": {
+			src: MsgRemoveCronSchedule{
+				Authority: badAddress,
+				Name:      "my-schedule",
+			},
+			expErr: true,
+		},
+		"empty name": {
+			src: MsgRemoveCronSchedule{
+				Authority: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSubscribeEpochHookValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgSubscribeEpochHook
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSubscribeEpochHook{
+				Sender:          goodAddress,
+				Contract:        goodAddress,
+				EpochIdentifier: "week",
+				GasLimit:        100_000,
+			},
+		},
+		"max gas limit": {
+			src: MsgSubscribeEpochHook{
+				Sender:          goodAddress,
+				Contract:        goodAddress,
+				EpochIdentifier: "week",
+				GasLimit:        MaxEpochHookGasLimit,
+			},
+		},
+		"bad sender": {
+			src: MsgSubscribeEpochHook{
+				Sender:          badAddress,
+				Contract:        goodAddress,
+				EpochIdentifier: "week",
+				GasLimit:        100_000,
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgSubscribeEpochHook{
+				Sender:          goodAddress,
+				Contract:        badAddress,
+				EpochIdentifier: "week",
+				GasLimit:        100_000,
+			},
+			expErr: true,
+		},
+		"empty epoch identifier": {
+			src: MsgSubscribeEpochHook{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+				GasLimit: 100_000,
+			},
+			expErr: true,
+		},
+		"epoch identifier with whitespace": {
+			src: MsgSubscribeEpochHook{
+				Sender:          goodAddress,
+				Contract:        goodAddress,
+				EpochIdentifier: "my week",
+				GasLimit:        100_000,
+			},
+			expErr: true,
+		},
+		"epoch identifier too long": {
+			src: MsgSubscribeEpochHook{
+				Sender:          goodAddress,
+				Contract:        goodAddress,
+				EpochIdentifier: strings.Repeat("a", MaxEpochIdentifierSize+1),
+				GasLimit:        100_000,
+			},
+			expErr: true,
+		},
+		"empty gas limit": {
+			src: MsgSubscribeEpochHook{
+				Sender:          goodAddress,
+				Contract:        goodAddress,
+				EpochIdentifier: "week",
+			},
+			expErr: true,
+		},
+		"gas limit exceeds max": {
+			src: MsgSubscribeEpochHook{
+				Sender:          goodAddress,
+				Contract:        goodAddress,
+				EpochIdentifier: "week",
+				GasLimit:        MaxEpochHookGasLimit + 1,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnsubscribeEpochHookValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgUnsubscribeEpochHook
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUnsubscribeEpochHook{
+				Sender:          goodAddress,
+				Contract:        goodAddress,
+				EpochIdentifier: "week",
+			},
+		},
+		"bad sender": {
+			src: MsgUnsubscribeEpochHook{
+				Sender:          badAddress,
+				Contract:        goodAddress,
+				EpochIdentifier: "week",
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgUnsubscribeEpochHook{
+				Sender:          goodAddress,
+				Contract:        badAddress,
+				EpochIdentifier: "week",
+			},
+			expErr: true,
+		},
+		"empty epoch identifier": {
+			src: MsgUnsubscribeEpochHook{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRegisterFeeShareValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgRegisterFeeShare
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRegisterFeeShare{
+				Sender:          goodAddress,
+				Contract:        goodAddress,
+				WithdrawAddress: goodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgRegisterFeeShare{
+				Sender:          badAddress,
+				Contract:        goodAddress,
+				WithdrawAddress: goodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgRegisterFeeShare{
+				Sender:          goodAddress,
+				Contract:        badAddress,
+				WithdrawAddress: goodAddress,
+			},
+			expErr: true,
+		},
+		"bad withdraw address": {
+			src: MsgRegisterFeeShare{
+				Sender:          goodAddress,
+				Contract:        goodAddress,
+				WithdrawAddress: badAddress,
+			},
+			expErr: true,
+		},
+		"empty withdraw address": {
+			src: MsgRegisterFeeShare{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelFeeShareValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgCancelFeeShare
+		expErr bool
+	}{
+		"all good": {
+			src: MsgCancelFeeShare{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgCancelFeeShare{
+				Sender:   badAddress,
+				Contract: goodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgCancelFeeShare{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetFeeSponsorshipValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	limit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	specs := map[string]struct {
+		src    MsgSetFeeSponsorship
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSetFeeSponsorship{
+				Sender:           goodAddress,
+				Contract:         goodAddress,
+				PerBlockLimit:    limit,
+				PerSenderLimit:   limit,
+				ApprovalGasLimit: 1,
+			},
+		},
+		"bad sender": {
+			src: MsgSetFeeSponsorship{
+				Sender:           badAddress,
+				Contract:         goodAddress,
+				PerBlockLimit:    limit,
+				PerSenderLimit:   limit,
+				ApprovalGasLimit: 1,
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgSetFeeSponsorship{
+				Sender:           goodAddress,
+				Contract:         badAddress,
+				PerBlockLimit:    limit,
+				PerSenderLimit:   limit,
+				ApprovalGasLimit: 1,
+			},
+			expErr: true,
+		},
+		"empty per block limit": {
+			src: MsgSetFeeSponsorship{
+				Sender:           goodAddress,
+				Contract:         goodAddress,
+				PerSenderLimit:   limit,
+				ApprovalGasLimit: 1,
+			},
+			expErr: true,
+		},
+		"empty per sender limit": {
+			src: MsgSetFeeSponsorship{
+				Sender:           goodAddress,
+				Contract:         goodAddress,
+				PerBlockLimit:    limit,
+				ApprovalGasLimit: 1,
+			},
+			expErr: true,
+		},
+		"invalid limit": {
+			src: MsgSetFeeSponsorship{
+				Sender:           goodAddress,
+				Contract:         goodAddress,
+				PerBlockLimit:    sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdkmath.NewInt(-1)}},
+				PerSenderLimit:   limit,
+				ApprovalGasLimit: 1,
+			},
+			expErr: true,
+		},
+		"per sender limit exceeds per block limit": {
+			src: MsgSetFeeSponsorship{
+				Sender:           goodAddress,
+				Contract:         goodAddress,
+				PerBlockLimit:    limit,
+				PerSenderLimit:   limit.Add(sdk.NewInt64Coin("denom", 1)),
+				ApprovalGasLimit: 1,
+			},
+			expErr: true,
+		},
+		"zero approval gas limit": {
+			src: MsgSetFeeSponsorship{
+				Sender:         goodAddress,
+				Contract:       goodAddress,
+				PerBlockLimit:  limit,
+				PerSenderLimit: limit,
+			},
+			expErr: true,
+		},
+		"approval gas limit exceeds max": {
+			src: MsgSetFeeSponsorship{
+				Sender:           goodAddress,
+				Contract:         goodAddress,
+				PerBlockLimit:    limit,
+				PerSenderLimit:   limit,
+				ApprovalGasLimit: MaxFeeSponsorshipApprovalGasLimit + 1,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveFeeSponsorshipValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgRemoveFeeSponsorship
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRemoveFeeSponsorship{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgRemoveFeeSponsorship{
+				Sender:   badAddress,
+				Contract: goodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgRemoveFeeSponsorship{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgProposeContractAdminValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+	specs := map[string]struct {
+		src    MsgProposeContractAdmin
+		expErr bool
+	}{
+		"all good": {
+			src: MsgProposeContractAdmin{
+				Sender:   goodAddress,
+				NewAdmin: otherGoodAddress,
+				Contract: goodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgProposeContractAdmin{
+				Sender:   badAddress,
+				NewAdmin: otherGoodAddress,
+				Contract: goodAddress,
+			},
+			expErr: true,
+		},
+		"bad new admin": {
+			src: MsgProposeContractAdmin{
+				Sender:   goodAddress,
+				NewAdmin: badAddress,
+				Contract: goodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgProposeContractAdmin{
+				Sender:   goodAddress,
+				NewAdmin: otherGoodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+		"new admin same as sender": {
+			src: MsgProposeContractAdmin{
+				Sender:   goodAddress,
+				NewAdmin: goodAddress,
+				Contract: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAcceptContractAdminValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgAcceptContractAdmin
+		expErr bool
+	}{
+		"all good": {
+			src: MsgAcceptContractAdmin{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgAcceptContractAdmin{
+				Sender:   badAddress,
+				Contract: goodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgAcceptContractAdmin{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelContractAdminTransferValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgCancelContractAdminTransfer
+		expErr bool
+	}{
+		"all good": {
+			src: MsgCancelContractAdminTransfer{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgCancelContractAdminTransfer{
+				Sender:   badAddress,
+				Contract: goodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgCancelContractAdminTransfer{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetCodeMetadataValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgSetCodeMetadata
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSetCodeMetadata{
+				Sender:   goodAddress,
+				CodeID:   1,
+				Metadata: CodeMetadata{Commit: "a1b2c3d"},
+			},
+		},
+		"empty metadata": {
+			src: MsgSetCodeMetadata{
+				Sender: goodAddress,
+				CodeID: 1,
+			},
+		},
+		"bad sender": {
+			src: MsgSetCodeMetadata{
+				Sender: badAddress,
+				CodeID: 1,
+			},
+			expErr: true,
+		},
+		"empty code id": {
+			src: MsgSetCodeMetadata{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+		"invalid metadata": {
+			src: MsgSetCodeMetadata{
+				Sender:   goodAddress,
+				CodeID:   1,
+				Metadata: CodeMetadata{Commit: strings.Repeat("a", MaxCodeMetadataFieldSize+1)},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRegisterContractNameValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgRegisterContractName
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRegisterContractName{Sender: goodAddress, Contract: goodAddress, Name: "mantra.dex.pool-factory"},
+		},
+		"bad sender": {
+			src:    MsgRegisterContractName{Sender: badAddress, Contract: goodAddress, Name: "mantra"},
+			expErr: true,
+		},
+		"bad contract": {
+			src:    MsgRegisterContractName{Sender: goodAddress, Contract: badAddress, Name: "mantra"},
+			expErr: true,
+		},
+		"invalid name": {
+			src:    MsgRegisterContractName{Sender: goodAddress, Contract: goodAddress, Name: "Mantra"},
+			expErr: true,
+		},
+		"empty name": {
+			src:    MsgRegisterContractName{Sender: goodAddress, Contract: goodAddress},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgTransferContractNameValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgTransferContractName
+		expErr bool
+	}{
+		"all good": {
+			src: MsgTransferContractName{Sender: goodAddress, Name: "mantra.dex.pool-factory", NewContract: goodAddress},
+		},
+		"bad sender": {
+			src:    MsgTransferContractName{Sender: badAddress, Name: "mantra", NewContract: goodAddress},
+			expErr: true,
+		},
+		"bad new contract": {
+			src:    MsgTransferContractName{Sender: goodAddress, Name: "mantra", NewContract: badAddress},
+			expErr: true,
+		},
+		"invalid name": {
+			src:    MsgTransferContractName{Sender: goodAddress, Name: "mantra..dex", NewContract: goodAddress},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgReleaseContractNameValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgReleaseContractName
+		expErr bool
+	}{
+		"all good": {
+			src: MsgReleaseContractName{Sender: goodAddress, Name: "mantra.dex.pool-factory"},
+		},
+		"bad sender": {
+			src:    MsgReleaseContractName{Sender: badAddress, Name: "mantra"},
+			expErr: true,
+		},
+		"empty name": {
+			src:    MsgReleaseContractName{Sender: goodAddress},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDeleteContractValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgDeleteContract
+		expErr bool
+	}{
+		"all good": {
+			src: MsgDeleteContract{Sender: goodAddress, Contract: goodAddress},
+		},
+		"with sweep recipient": {
+			src: MsgDeleteContract{Sender: goodAddress, Contract: goodAddress, SweepRecipient: goodAddress},
+		},
+		"bad sender": {
+			src:    MsgDeleteContract{Sender: badAddress, Contract: goodAddress},
+			expErr: true,
+		},
+		"empty sender": {
+			src:    MsgDeleteContract{Contract: goodAddress},
+			expErr: true,
+		},
+		"bad contract": {
+			src:    MsgDeleteContract{Sender: goodAddress, Contract: badAddress},
+			expErr: true,
+		},
+		"empty contract": {
+			src:    MsgDeleteContract{Sender: goodAddress},
+			expErr: true,
+		},
+		"bad sweep recipient": {
+			src:    MsgDeleteContract{Sender: goodAddress, Contract: goodAddress, SweepRecipient: badAddress},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetCodeGasMultiplierValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	specs := map[string]struct {
+		src    MsgSetCodeGasMultiplier
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSetCodeGasMultiplier{Authority: goodAddress, CodeID: 1, MultiplierBps: 5_000},
+		},
+		"remove": {
+			src: MsgSetCodeGasMultiplier{Authority: goodAddress, CodeID: 1},
+		},
+		"bad authority": {
+			src:    MsgSetCodeGasMultiplier{Authority: badAddress, CodeID: 1, MultiplierBps: 5_000},
+			expErr: true,
+		},
+		"empty code id": {
+			src:    MsgSetCodeGasMultiplier{Authority: goodAddress, MultiplierBps: 5_000},
+			expErr: true,
+		},
+		"below min": {
+			src:    MsgSetCodeGasMultiplier{Authority: goodAddress, CodeID: 1, MultiplierBps: MinCodeGasMultiplierBps - 1},
+			expErr: true,
+		},
+		"above max": {
+			src:    MsgSetCodeGasMultiplier{Authority: goodAddress, CodeID: 1, MultiplierBps: MaxCodeGasMultiplierBps + 1},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetCodeSchemaValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	schema := compressedTestContractSchema(t)
+	specs := map[string]struct {
+		src    MsgSetCodeSchema
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSetCodeSchema{Sender: goodAddress, CodeID: 1, Schema: schema, ValidateMessages: true},
+		},
+		"without validation": {
+			src: MsgSetCodeSchema{Sender: goodAddress, CodeID: 1, Schema: schema},
+		},
+		"remove": {
+			src: MsgSetCodeSchema{Sender: goodAddress, CodeID: 1},
+		},
+		"validation without schema": {
+			src:    MsgSetCodeSchema{Sender: goodAddress, CodeID: 1, ValidateMessages: true},
+			expErr: true,
+		},
+		"bad sender": {
+			src:    MsgSetCodeSchema{Sender: badAddress, CodeID: 1, Schema: schema},
+			expErr: true,
+		},
+		"empty code id": {
+			src:    MsgSetCodeSchema{Sender: goodAddress, Schema: schema},
+			expErr: true,
+		},
+		"not compressed": {
+			src:    MsgSetCodeSchema{Sender: goodAddress, CodeID: 1, Schema: []byte(testContractSchema)},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"reflect"
 	"slices"
+	"time"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -45,6 +47,11 @@ func (c CodeInfo) ValidateBasic() error {
 	if err := c.InstantiateConfig.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "instantiate config")
 	}
+	if c.Metadata != nil {
+		if err := c.Metadata.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "metadata")
+		}
+	}
 	return nil
 }
 
@@ -57,7 +64,33 @@ func NewCodeInfo(codeHash []byte, creator sdk.AccAddress, instantiatePermission
 	}
 }
 
-var AllCodeHistoryTypes = []ContractCodeHistoryOperationType{ContractCodeHistoryOperationTypeGenesis, ContractCodeHistoryOperationTypeInit, ContractCodeHistoryOperationTypeMigrate}
+// ValidateBasic syntax checks
+func (m CodeMetadata) ValidateBasic() error {
+	for _, f := range []struct{ name, value string }{
+		{"source repository", m.SourceRepository},
+		{"commit", m.Commit},
+		{"builder image", m.BuilderImage},
+		{"optimizer version", m.OptimizerVersion},
+		{"schema hash", m.SchemaHash},
+	} {
+		if len(f.value) > MaxCodeMetadataFieldSize {
+			return errorsmod.Wrapf(ErrLimit, "%s cannot be longer than %d characters", f.name, MaxCodeMetadataFieldSize)
+		}
+	}
+	if m.SchemaHash != "" {
+		if _, err := hex.DecodeString(m.SchemaHash); err != nil {
+			return errorsmod.Wrapf(ErrInvalid, "schema hash: %s", err)
+		}
+	}
+	return nil
+}
+
+// IsEmpty returns true when no metadata field is set
+func (m CodeMetadata) IsEmpty() bool {
+	return m == CodeMetadata{}
+}
+
+var AllCodeHistoryTypes = []ContractCodeHistoryOperationType{ContractCodeHistoryOperationTypeGenesis, ContractCodeHistoryOperationTypeInit, ContractCodeHistoryOperationTypeMigrate, ContractCodeHistoryOperationTypeDelete}
 
 // NewContractInfo creates a new instance of a given WASM contract info
 func NewContractInfo(codeID uint64, creator, admin sdk.AccAddress, label string, createdAt *AbsoluteTxPosition) ContractInfo {
@@ -267,6 +300,177 @@ func (c ContractCodeHistoryEntry) ValidateBasic() error {
 	return errorsmod.Wrap(c.Msg.ValidateBasic(), "msg")
 }
 
+// ValidateBasic syntax checks
+func (c CronSchedule) ValidateBasic() error {
+	if err := ValidateCronScheduleName(c.Name); err != nil {
+		return errorsmod.Wrap(err, "name")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := c.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "payload msg")
+	}
+	if c.Interval == 0 {
+		return ErrEmpty.Wrap("interval")
+	}
+	switch {
+	case c.GasLimit == 0:
+		return ErrEmpty.Wrap("gas limit")
+	case c.GasLimit > MaxCronGasLimit:
+		return ErrLimit.Wrapf("gas limit must not be greater than %d", MaxCronGasLimit)
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of the subscription
+func (s EpochHookSubscription) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(s.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := ValidateEpochIdentifier(s.EpochIdentifier); err != nil {
+		return errorsmod.Wrap(err, "epoch identifier")
+	}
+	switch {
+	case s.GasLimit == 0:
+		return ErrEmpty.Wrap("gas limit")
+	case s.GasLimit > MaxEpochHookGasLimit:
+		return ErrLimit.Wrapf("gas limit must not be greater than %d", MaxEpochHookGasLimit)
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of the fee share registration
+func (f FeeShare) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(f.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(f.WithdrawAddress); err != nil {
+		return errorsmod.Wrap(err, "withdraw address")
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of the pending admin transfer
+func (t PendingAdminTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(t.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(t.NewAdmin); err != nil {
+		return errorsmod.Wrap(err, "new admin")
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of the contract name
+func (n ContractName) ValidateBasic() error {
+	if err := ValidateContractName(n.Name); err != nil {
+		return errorsmod.Wrap(err, "name")
+	}
+	if _, err := sdk.AccAddressFromBech32(n.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+// IsExpired returns true when the transfer can no longer be accepted at the given block time
+func (t PendingAdminTransfer) IsExpired(blockTime time.Time) bool {
+	return t.ExpiresAt != nil && blockTime.After(*t.ExpiresAt)
+}
+
+// ValidateBasic performs basic validation of the fee sponsorship
+func (s FeeSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(s.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := validateFeeSponsorshipLimit(s.PerBlockLimit); err != nil {
+		return errorsmod.Wrap(err, "per block limit")
+	}
+	if err := validateFeeSponsorshipLimit(s.PerSenderLimit); err != nil {
+		return errorsmod.Wrap(err, "per sender limit")
+	}
+	if !s.PerSenderLimit.IsAllLTE(s.PerBlockLimit) {
+		return ErrInvalid.Wrap("per sender limit must not exceed per block limit")
+	}
+	switch {
+	case s.ApprovalGasLimit == 0:
+		return ErrEmpty.Wrap("approval gas limit")
+	case s.ApprovalGasLimit > MaxFeeSponsorshipApprovalGasLimit:
+		return ErrLimit.Wrapf("approval gas limit must not be greater than %d", MaxFeeSponsorshipApprovalGasLimit)
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of the storage deposit
+func (d StorageDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(d.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := d.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(err, "amount")
+	}
+	if !d.Amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalid, "amount must be positive")
+	}
+	return nil
+}
+
+func validateFeeSponsorshipLimit(limit sdk.Coins) error {
+	if limit.Empty() {
+		return ErrEmpty
+	}
+	if !limit.IsValid() {
+		return ErrInvalid.Wrap(limit.String())
+	}
+	return nil
+}
+
+// FeeSponsorshipSudoMsg is the sudo message sent to a sponsoring contract to approve paying the fee of a tx
+type FeeSponsorshipSudoMsg struct {
+	ApproveFeeSponsorship *ApproveFeeSponsorshipMsg `json:"approve_fee_sponsorship,omitempty"`
+}
+
+// ApproveFeeSponsorshipMsg contains the fee payer, the fee and the contract execute messages of the tx.
+// The contract approves by returning a successful response.
+type ApproveFeeSponsorshipMsg struct {
+	FeePayer string                              `json:"fee_payer"`
+	Fee      wasmvmtypes.Array[wasmvmtypes.Coin] `json:"fee"`
+	Msgs     []SponsoredExecuteMsg               `json:"msgs"`
+}
+
+// SponsoredExecuteMsg is a contract execute message of a sponsored tx
+type SponsoredExecuteMsg struct {
+	Sender string                              `json:"sender"`
+	Msg    RawContractMessage                  `json:"msg"`
+	Funds  wasmvmtypes.Array[wasmvmtypes.Coin] `json:"funds"`
+}
+
+// EpochHookSudoMsg is the sudo message sent to a subscribed contract when an x/epochs epoch ends
+type EpochHookSudoMsg struct {
+	EpochEnd *EpochEndMsg `json:"epoch_end,omitempty"`
+}
+
+// EpochEndMsg contains the identifier and number of the epoch that ended
+type EpochEndMsg struct {
+	Identifier string `json:"identifier"`
+	Number     uint64 `json:"number"`
+}
+
+// ContractNameCustomQuery is the custom query that contracts can send to resolve claimed contract names
+type ContractNameCustomQuery struct {
+	ContractByName *ContractByNameCustomQuery `json:"contract_by_name,omitempty"`
+}
+
+// ContractByNameCustomQuery requests the address of the contract that claimed the name
+type ContractByNameCustomQuery struct {
+	Name string `json:"name"`
+}
+
+// ContractByNameCustomQueryResponse contains the address of the contract that claimed the name
+type ContractByNameCustomQueryResponse struct {
+	Address string `json:"address"`
+}
+
 // NewEnv initializes the environment for a contract instance
 func NewEnv(ctx sdk.Context, txHash func([]byte) []byte, contractAddr sdk.AccAddress) wasmvmtypes.Env {
 	// safety checks before casting below
@@ -331,6 +535,8 @@ type NodeConfig struct {
 	MemoryCacheSize uint32 `mapstructure:"memory_cache_size"`
 	// ContractDebugMode log what contract print
 	ContractDebugMode bool
+	// SimulateExecuteDisabled turns off the SimulateExecute query endpoint
+	SimulateExecuteDisabled bool `mapstructure:"simulate_execute_disabled"`
 }
 
 // DefaultNodeConfig returns the default settings for NodeConfig
@@ -366,7 +572,11 @@ memory_cache_size = %d
 # Simulation gas limit is the max gas to be used in a tx simulation call.
 # When not set the consensus max block gas is used instead
 %s
-`, c.SmartQueryGasLimit, c.MemoryCacheSize, simGasLimit)
+
+# Disables the SimulateExecute query that runs contract executions from
+# arbitrary senders with arbitrary funds
+simulate_execute_disabled = %t
+`, c.SmartQueryGasLimit, c.MemoryCacheSize, simGasLimit, c.SimulateExecuteDisabled)
 }
 
 // VerifyAddressLen ensures that the address matches the expected length
@@ -438,11 +648,14 @@ type txContracts map[string]struct{}
 type TxContracts struct {
 	// contracts contains the contracts (identified by checksum) which have already been executed in a transaction
 	contracts txContracts
+	// addresses contains the addresses of the contract instances which have been called in a transaction
+	addresses txContracts
 }
 
 func NewTxContracts() TxContracts {
 	c := make(txContracts, 0)
-	return TxContracts{contracts: c}
+	a := make(txContracts, 0)
+	return TxContracts{contracts: c, addresses: a}
 }
 
 func (tc TxContracts) AddContract(checksum []byte) {
@@ -462,3 +675,38 @@ func (tc TxContracts) Exists(checksum []byte) bool {
 func (tc TxContracts) GetContracts() txContracts {
 	return tc.contracts
 }
+
+// AddContractAddress stores the address of a contract instance that was called in the transaction
+func (tc TxContracts) AddContractAddress(contractAddr sdk.AccAddress) {
+	if len(contractAddr) == 0 || tc.addresses == nil {
+		return
+	}
+	tc.addresses[string(contractAddr)] = struct{}{}
+}
+
+// newAddressScope returns tx contracts with the same executed code checksums but without contract addresses
+func (tc TxContracts) newAddressScope() TxContracts {
+	scope := TxContracts{contracts: tc.contracts}
+	if tc.addresses != nil {
+		scope.addresses = make(txContracts)
+	}
+	return scope
+}
+
+// mergeContractAddresses adds the contract addresses of the other tx contracts
+func (tc TxContracts) mergeContractAddresses(other TxContracts) {
+	for a := range other.addresses {
+		tc.addresses[a] = struct{}{}
+	}
+}
+
+// GetContractAddresses returns the addresses of all contract instances that were called in the transaction,
+// sorted by bytes
+func (tc TxContracts) GetContractAddresses() []sdk.AccAddress {
+	r := make([]sdk.AccAddress, 0, len(tc.addresses))
+	for a := range tc.addresses {
+		r = append(r, sdk.AccAddress(a))
+	}
+	slices.SortFunc(r, func(a, b sdk.AccAddress) int { return bytes.Compare(a, b) })
+	return r
+}
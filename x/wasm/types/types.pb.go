@@ -9,13 +9,18 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	github_com_cometbft_cometbft_libs_bytes "github.com/cometbft/cometbft/libs/bytes"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -23,6 +28,7 @@ var (
 	_ = proto.Marshal
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -75,6 +81,8 @@ const (
 	ContractCodeHistoryOperationTypeMigrate ContractCodeHistoryOperationType = 2
 	// ContractCodeHistoryOperationTypeGenesis based on genesis data
 	ContractCodeHistoryOperationTypeGenesis ContractCodeHistoryOperationType = 3
+	// ContractCodeHistoryOperationTypeDelete contract deletion tombstone
+	ContractCodeHistoryOperationTypeDelete ContractCodeHistoryOperationType = 4
 )
 
 var ContractCodeHistoryOperationType_name = map[int32]string{
@@ -82,6 +90,7 @@ var ContractCodeHistoryOperationType_name = map[int32]string{
 	1: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT",
 	2: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE",
 	3: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_GENESIS",
+	4: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_DELETE",
 }
 
 var ContractCodeHistoryOperationType_value = map[string]int32{
@@ -89,6 +98,7 @@ var ContractCodeHistoryOperationType_value = map[string]int32{
 	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT":        1,
 	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE":     2,
 	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_GENESIS":     3,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_DELETE":      4,
 }
 
 func (x ContractCodeHistoryOperationType) String() string {
@@ -190,6 +200,39 @@ var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
 type Params struct {
 	CodeUploadAccess             AccessConfig `protobuf:"bytes,1,opt,name=code_upload_access,json=codeUploadAccess,proto3" json:"code_upload_access" yaml:"code_upload_access"`
 	InstantiateDefaultPermission AccessType   `protobuf:"varint,2,opt,name=instantiate_default_permission,json=instantiateDefaultPermission,proto3,enum=cosmwasm.wasm.v1.AccessType" json:"instantiate_default_permission,omitempty" yaml:"instantiate_default_permission"`
+	// DeveloperFeeShareBps is the share of the tx fees in basis points (1/10000)
+	// that is paid to the registered withdraw addresses of the contracts called
+	// in the tx. Zero disables the fee share.
+	DeveloperFeeShareBps uint32 `protobuf:"varint,3,opt,name=developer_fee_share_bps,json=developerFeeShareBps,proto3" json:"developer_fee_share_bps,omitempty" yaml:"developer_fee_share_bps"`
+	// AdminTransferExpirySeconds is the time in seconds after which a proposed
+	// contract admin transfer can no longer be accepted. Zero never expires.
+	AdminTransferExpirySeconds uint64 `protobuf:"varint,4,opt,name=admin_transfer_expiry_seconds,json=adminTransferExpirySeconds,proto3" json:"admin_transfer_expiry_seconds,omitempty" yaml:"admin_transfer_expiry_seconds"`
+	// StorageDepositDenom is the denom of the deposit that contracts must hold
+	// for the bytes they store
+	StorageDepositDenom string `protobuf:"bytes,5,opt,name=storage_deposit_denom,json=storageDepositDenom,proto3" json:"storage_deposit_denom,omitempty" yaml:"storage_deposit_denom"`
+	// StorageDepositPerByte is the deposit amount required per byte stored by a
+	// contract. Zero disables storage deposits.
+	StorageDepositPerByte uint64 `protobuf:"varint,6,opt,name=storage_deposit_per_byte,json=storageDepositPerByte,proto3" json:"storage_deposit_per_byte,omitempty" yaml:"storage_deposit_per_byte"`
+	// AutoPinWindowBlocks is the number of recent blocks in which the executions
+	// of each code are counted for automatic pinning. Zero disables automatic
+	// pinning.
+	AutoPinWindowBlocks uint64 `protobuf:"varint,7,opt,name=auto_pin_window_blocks,json=autoPinWindowBlocks,proto3" json:"auto_pin_window_blocks,omitempty" yaml:"auto_pin_window_blocks"`
+	// AutoPinMaxCodes is the max number of most executed codes that are pinned
+	// automatically
+	AutoPinMaxCodes uint32 `protobuf:"varint,8,opt,name=auto_pin_max_codes,json=autoPinMaxCodes,proto3" json:"auto_pin_max_codes,omitempty" yaml:"auto_pin_max_codes"`
+	// AutoPinMemoryBudget is the max total size in bytes of the wasm codes that
+	// are pinned automatically
+	AutoPinMemoryBudget uint64 `protobuf:"varint,9,opt,name=auto_pin_memory_budget,json=autoPinMemoryBudget,proto3" json:"auto_pin_memory_budget,omitempty" yaml:"auto_pin_memory_budget"`
+	// AutoPinMinExecutions is the min number of executions within the window for
+	// a code to be pinned automatically
+	AutoPinMinExecutions uint64 `protobuf:"varint,10,opt,name=auto_pin_min_executions,json=autoPinMinExecutions,proto3" json:"auto_pin_min_executions,omitempty" yaml:"auto_pin_min_executions"`
+	// GasRegister contains the gas costs charged for contract interactions.
+	// When not set, the costs configured in the node binary are used.
+	GasRegister *GasRegisterParams `protobuf:"bytes,11,opt,name=gas_register,json=gasRegister,proto3" json:"gas_register,omitempty" yaml:"gas_register"`
+	// EpochHookGasBudget is the max sum of the gas limits of all contracts that
+	// are subscribed to the same epoch identifier. Zero disables new epoch hook
+	// subscriptions.
+	EpochHookGasBudget uint64 `protobuf:"varint,12,opt,name=epoch_hook_gas_budget,json=epochHookGasBudget,proto3" json:"epoch_hook_gas_budget,omitempty" yaml:"epoch_hook_gas_budget"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -229,6 +272,80 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// GasRegisterParams are the gas costs charged for contract interactions. All
+// costs are in SDK gas unless stated otherwise.
+type GasRegisterParams struct {
+	// InstanceCost is charged when a contract instance is loaded
+	InstanceCost uint64 `protobuf:"varint,1,opt,name=instance_cost,json=instanceCost,proto3" json:"instance_cost,omitempty"`
+	// InstanceCostDiscount is charged instead of the instance cost when the
+	// contract is assumed to be in one of the in-memory caches
+	InstanceCostDiscount uint64 `protobuf:"varint,2,opt,name=instance_cost_discount,json=instanceCostDiscount,proto3" json:"instance_cost_discount,omitempty"`
+	// CompileCost is charged per byte to persist and compile a new wasm code
+	CompileCost uint64 `protobuf:"varint,3,opt,name=compile_cost,json=compileCost,proto3" json:"compile_cost,omitempty"`
+	// UncompressCostNumerator and UncompressCostDenominator define the fraction
+	// charged per byte to unpack a gzipped wasm code
+	UncompressCostNumerator   uint64 `protobuf:"varint,4,opt,name=uncompress_cost_numerator,json=uncompressCostNumerator,proto3" json:"uncompress_cost_numerator,omitempty"`
+	UncompressCostDenominator uint64 `protobuf:"varint,5,opt,name=uncompress_cost_denominator,json=uncompressCostDenominator,proto3" json:"uncompress_cost_denominator,omitempty"`
+	// GasMultiplier is how many CosmWasm gas points equal 1 SDK gas point
+	GasMultiplier uint64 `protobuf:"varint,6,opt,name=gas_multiplier,json=gasMultiplier,proto3" json:"gas_multiplier,omitempty"`
+	// EventPerAttributeCost is charged per event attribute
+	EventPerAttributeCost uint64 `protobuf:"varint,7,opt,name=event_per_attribute_cost,json=eventPerAttributeCost,proto3" json:"event_per_attribute_cost,omitempty"`
+	// EventAttributeDataCost is charged per byte of event attribute data
+	EventAttributeDataCost uint64 `protobuf:"varint,8,opt,name=event_attribute_data_cost,json=eventAttributeDataCost,proto3" json:"event_attribute_data_cost,omitempty"`
+	// EventAttributeDataFreeTier is the number of bytes of total event
+	// attribute data that is free of charge
+	EventAttributeDataFreeTier uint64 `protobuf:"varint,9,opt,name=event_attribute_data_free_tier,json=eventAttributeDataFreeTier,proto3" json:"event_attribute_data_free_tier,omitempty"`
+	// ContractMessageDataCost is charged per byte of the message that goes to
+	// the contract
+	ContractMessageDataCost uint64 `protobuf:"varint,10,opt,name=contract_message_data_cost,json=contractMessageDataCost,proto3" json:"contract_message_data_cost,omitempty"`
+	// CustomEventCost is charged per custom event
+	CustomEventCost uint64 `protobuf:"varint,11,opt,name=custom_event_cost,json=customEventCost,proto3" json:"custom_event_cost,omitempty"`
+	// HumanizeAddressCost is charged to convert a canonical address to the
+	// human readable format in the contract API
+	HumanizeAddressCost uint64 `protobuf:"varint,12,opt,name=humanize_address_cost,json=humanizeAddressCost,proto3" json:"humanize_address_cost,omitempty"`
+	// CanonicalizeAddressCost is charged to convert a human readable address to
+	// the canonical format in the contract API
+	CanonicalizeAddressCost uint64 `protobuf:"varint,13,opt,name=canonicalize_address_cost,json=canonicalizeAddressCost,proto3" json:"canonicalize_address_cost,omitempty"`
+}
+
+func (m *GasRegisterParams) Reset()         { *m = GasRegisterParams{} }
+func (m *GasRegisterParams) String() string { return proto.CompactTextString(m) }
+func (*GasRegisterParams) ProtoMessage()    {}
+func (*GasRegisterParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{3}
+}
+
+func (m *GasRegisterParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *GasRegisterParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasRegisterParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *GasRegisterParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasRegisterParams.Merge(m, src)
+}
+
+func (m *GasRegisterParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *GasRegisterParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasRegisterParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasRegisterParams proto.InternalMessageInfo
+
 // CodeInfo is data for the uploaded contract WASM code
 type CodeInfo struct {
 	// CodeHash is the unique identifier created by wasmvm
@@ -237,13 +354,15 @@ type CodeInfo struct {
 	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 	// InstantiateConfig access control to apply on contract creation, optional
 	InstantiateConfig AccessConfig `protobuf:"bytes,5,opt,name=instantiate_config,json=instantiateConfig,proto3" json:"instantiate_config"`
+	// Metadata is optional information to verify the build of the code
+	Metadata *CodeMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
 func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
 func (*CodeInfo) ProtoMessage()    {}
 func (*CodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{3}
+	return fileDescriptor_e6155d98fa173e02, []int{4}
 }
 
 func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
@@ -277,6 +396,60 @@ func (m *CodeInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CodeInfo proto.InternalMessageInfo
 
+// CodeMetadata is information about the source and build of a wasm code that
+// allows to reproduce and verify it. All fields are optional.
+type CodeMetadata struct {
+	// SourceRepository is the URL of the source code repository
+	SourceRepository string `protobuf:"bytes,1,opt,name=source_repository,json=sourceRepository,proto3" json:"source_repository,omitempty"`
+	// Commit is the revision of the source code that was built
+	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// BuilderImage is the container image used for the build, e.g.
+	// "cosmwasm/optimizer:0.16.0"
+	BuilderImage string `protobuf:"bytes,3,opt,name=builder_image,json=builderImage,proto3" json:"builder_image,omitempty"`
+	// OptimizerVersion is the version of the optimizer used for the build
+	OptimizerVersion string `protobuf:"bytes,4,opt,name=optimizer_version,json=optimizerVersion,proto3" json:"optimizer_version,omitempty"`
+	// SchemaHash is the hex encoded hash of the contract JSON schema
+	SchemaHash string `protobuf:"bytes,5,opt,name=schema_hash,json=schemaHash,proto3" json:"schema_hash,omitempty"`
+}
+
+func (m *CodeMetadata) Reset()         { *m = CodeMetadata{} }
+func (m *CodeMetadata) String() string { return proto.CompactTextString(m) }
+func (*CodeMetadata) ProtoMessage()    {}
+func (*CodeMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{5}
+}
+
+func (m *CodeMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CodeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *CodeMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeMetadata.Merge(m, src)
+}
+
+func (m *CodeMetadata) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CodeMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeMetadata proto.InternalMessageInfo
+
 // ContractInfo stores a WASM contract instance
 type ContractInfo struct {
 	// CodeID is the reference to the stored Wasm code
@@ -300,7 +473,7 @@ func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
 func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractInfo) ProtoMessage()    {}
 func (*ContractInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{4}
+	return fileDescriptor_e6155d98fa173e02, []int{6}
 }
 
 func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
@@ -348,7 +521,7 @@ func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEnt
 func (m *ContractCodeHistoryEntry) String() string { return proto.CompactTextString(m) }
 func (*ContractCodeHistoryEntry) ProtoMessage()    {}
 func (*ContractCodeHistoryEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{5}
+	return fileDescriptor_e6155d98fa173e02, []int{7}
 }
 
 func (m *ContractCodeHistoryEntry) XXX_Unmarshal(b []byte) error {
@@ -396,7 +569,7 @@ func (m *AbsoluteTxPosition) Reset()         { *m = AbsoluteTxPosition{} }
 func (m *AbsoluteTxPosition) String() string { return proto.CompactTextString(m) }
 func (*AbsoluteTxPosition) ProtoMessage()    {}
 func (*AbsoluteTxPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{6}
+	return fileDescriptor_e6155d98fa173e02, []int{8}
 }
 
 func (m *AbsoluteTxPosition) XXX_Unmarshal(b []byte) error {
@@ -442,7 +615,7 @@ func (m *Model) Reset()         { *m = Model{} }
 func (m *Model) String() string { return proto.CompactTextString(m) }
 func (*Model) ProtoMessage()    {}
 func (*Model) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{7}
+	return fileDescriptor_e6155d98fa173e02, []int{9}
 }
 
 func (m *Model) XXX_Unmarshal(b []byte) error {
@@ -476,893 +649,5483 @@ func (m *Model) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Model proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
-	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
-	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1.AccessTypeParam")
-	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1.AccessConfig")
-	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1.Params")
-	proto.RegisterType((*CodeInfo)(nil), "cosmwasm.wasm.v1.CodeInfo")
-	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1.ContractInfo")
-	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
-	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
-	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
+// CronSchedule is a governance registered contract sudo call that is executed
+// periodically at the end of a block
+type CronSchedule struct {
+	// Name is the unique identifier of the schedule
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Msg json encoded message to be passed to the contract as sudo
+	Msg RawContractMessage `protobuf:"bytes,3,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// Interval is the number of blocks between two executions
+	Interval uint64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// GasLimit is the max gas that can be consumed by a single execution
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// MaxErrors is the number of consecutive failed executions after which the
+	// schedule is disabled. Zero never disables the schedule.
+	MaxErrors uint64 `protobuf:"varint,6,opt,name=max_errors,json=maxErrors,proto3" json:"max_errors,omitempty"`
+	// NextHeight is the block height of the next execution
+	NextHeight uint64 `protobuf:"varint,7,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// ErrorCount is the number of consecutive failed executions
+	ErrorCount uint64 `protobuf:"varint,8,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	// Disabled is set when the schedule reached the max errors
+	Disabled bool `protobuf:"varint,9,opt,name=disabled,proto3" json:"disabled,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
-
-var fileDescriptor_e6155d98fa173e02 = []byte{
-	// 1221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xda, 0x4e, 0x62, 0x4f, 0x4c, 0xd9, 0x0e, 0xa9, 0xea, 0x98, 0xc8, 0x36, 0x4b, 0x09,
-	0x6d, 0xda, 0xda, 0xad, 0x41, 0x15, 0xea, 0xa1, 0x92, 0x3f, 0xb6, 0xcd, 0x56, 0x8a, 0x6d, 0xad,
-	0x5d, 0x4a, 0x90, 0xca, 0x6a, 0x3f, 0xc6, 0xce, 0x50, 0x7b, 0xc7, 0xda, 0x19, 0xa7, 0xf6, 0x7f,
-	0x80, 0x8c, 0x90, 0x38, 0x70, 0x40, 0x48, 0x96, 0x90, 0x40, 0xd0, 0x63, 0x0f, 0xfd, 0x07, 0xb8,
-	0x55, 0x9c, 0x2a, 0x4e, 0x9c, 0x2c, 0x70, 0x0f, 0xe5, 0x9c, 0x03, 0x87, 0x9e, 0xd0, 0xce, 0xd8,
-	0xf5, 0x8a, 0x7e, 0xc4, 0x70, 0x59, 0xcd, 0xbc, 0xf7, 0x7e, 0xbf, 0x79, 0xef, 0xf7, 0x66, 0xdf,
-	0x2e, 0xd8, 0xb2, 0x09, 0xed, 0xde, 0x33, 0x69, 0x37, 0xcf, 0x1f, 0x87, 0x97, 0xf3, 0x6c, 0xd8,
-	0x43, 0x34, 0xd7, 0xf3, 0x08, 0x23, 0x50, 0x9e, 0x7b, 0x73, 0xfc, 0x71, 0x78, 0x39, 0xb5, 0xe9,
-	0x5b, 0x08, 0x35, 0xb8, 0x3f, 0x2f, 0x36, 0x22, 0x38, 0xb5, 0xd1, 0x26, 0x6d, 0x22, 0xec, 0xfe,
-	0x6a, 0x66, 0xdd, 0x6c, 0x13, 0xd2, 0xee, 0xa0, 0x3c, 0xdf, 0x59, 0xfd, 0x56, 0xde, 0x74, 0x87,
-	0x33, 0xd7, 0x49, 0xb3, 0x8b, 0x5d, 0x92, 0xe7, 0x4f, 0x61, 0x52, 0xee, 0x80, 0x37, 0x8b, 0xb6,
-	0x8d, 0x28, 0x6d, 0x0e, 0x7b, 0xa8, 0x6e, 0x7a, 0x66, 0x17, 0x56, 0xc0, 0xca, 0xa1, 0xd9, 0xe9,
-	0xa3, 0xa4, 0x94, 0x95, 0xce, 0x9e, 0x28, 0x6c, 0xe5, 0xfe, 0x9d, 0x53, 0x6e, 0x81, 0x28, 0xc9,
-	0x47, 0x93, 0x4c, 0x62, 0x68, 0x76, 0x3b, 0x57, 0x15, 0x0e, 0x52, 0x74, 0x01, 0xbe, 0x1a, 0xfd,
-	0xf6, 0xfb, 0x8c, 0xa4, 0xfc, 0x2c, 0x81, 0x84, 0x88, 0x2e, 0x13, 0xb7, 0x85, 0xdb, 0xb0, 0x01,
-	0x40, 0x0f, 0x79, 0x5d, 0x4c, 0x29, 0x26, 0xee, 0x52, 0x27, 0x9c, 0x3a, 0x9a, 0x64, 0x4e, 0x8a,
-	0x13, 0x16, 0x48, 0x45, 0x0f, 0xd0, 0xc0, 0x2b, 0x20, 0x6e, 0x3a, 0x8e, 0x87, 0x28, 0x45, 0x34,
-	0x19, 0xc9, 0x46, 0xce, 0xc6, 0x4b, 0xc9, 0xdf, 0x1e, 0x5e, 0xdc, 0x98, 0xa9, 0x55, 0x14, 0xbe,
-	0x06, 0xf3, 0xb0, 0xdb, 0xd6, 0x17, 0xa1, 0x22, 0xc7, 0x9b, 0xd1, 0x58, 0x58, 0x8e, 0x28, 0xdf,
-	0x84, 0xc1, 0x2a, 0xaf, 0x9f, 0x42, 0x06, 0xa0, 0x4d, 0x1c, 0x64, 0xf4, 0x7b, 0x1d, 0x62, 0x3a,
-	0x86, 0xc9, 0x73, 0xe1, 0xb9, 0xae, 0x17, 0xd2, 0xaf, 0xca, 0x55, 0xd4, 0x57, 0xda, 0x7e, 0x34,
-	0xc9, 0x84, 0x8e, 0x26, 0x99, 0x4d, 0x91, 0xf1, 0x8b, 0x3c, 0xca, 0xfd, 0xa7, 0x0f, 0x76, 0x24,
-	0x5d, 0xf6, 0x3d, 0xb7, 0xb8, 0x43, 0xe0, 0xe1, 0x57, 0x12, 0x48, 0x63, 0x97, 0x32, 0xd3, 0x65,
-	0xd8, 0x64, 0xc8, 0x70, 0x50, 0xcb, 0xec, 0x77, 0x98, 0x11, 0x90, 0x2b, 0xbc, 0x84, 0x5c, 0xe7,
-	0x8e, 0x26, 0x99, 0xf7, 0xc4, 0xe1, 0xaf, 0x67, 0x53, 0xf4, 0xad, 0x40, 0x40, 0x45, 0xf8, 0xeb,
-	0xcf, 0xdd, 0x5c, 0x9c, 0x90, 0xf2, 0x8b, 0x04, 0x62, 0x65, 0xe2, 0x20, 0xcd, 0x6d, 0x11, 0xf8,
-	0x36, 0x88, 0xf3, 0x82, 0x0e, 0x4c, 0x7a, 0xc0, 0xf5, 0x48, 0xe8, 0x31, 0xdf, 0xb0, 0x6b, 0xd2,
-	0x03, 0x58, 0x00, 0x6b, 0xb6, 0x87, 0x4c, 0x46, 0x3c, 0x9e, 0xe7, 0xeb, 0x5a, 0x30, 0x0f, 0x84,
-	0x9f, 0x00, 0x18, 0x4c, 0xd2, 0xe6, 0x1a, 0x26, 0x57, 0x96, 0x52, 0x3a, 0xee, 0x2b, 0x2d, 0xc4,
-	0x3c, 0x19, 0x20, 0x11, 0xde, 0x9b, 0xd1, 0x58, 0x44, 0x8e, 0xde, 0x8c, 0xc6, 0xa2, 0xf2, 0x8a,
-	0xf2, 0x30, 0x02, 0x12, 0x65, 0xe2, 0x32, 0xcf, 0xb4, 0x19, 0xaf, 0xe3, 0x5d, 0xb0, 0xc6, 0xeb,
-	0xc0, 0x0e, 0xaf, 0x22, 0x5a, 0x02, 0xd3, 0x49, 0x66, 0x95, 0x97, 0x59, 0xd1, 0x57, 0x7d, 0x97,
-	0xe6, 0xfc, 0xaf, 0x7a, 0x72, 0x60, 0xc5, 0x74, 0xba, 0xd8, 0x4d, 0x46, 0x8e, 0x41, 0x88, 0x30,
-	0xb8, 0x01, 0x56, 0x3a, 0xa6, 0x85, 0x3a, 0xc9, 0xa8, 0x1f, 0xaf, 0x8b, 0x0d, 0xbc, 0x36, 0x3b,
-	0x19, 0x39, 0x33, 0x29, 0xce, 0xbc, 0x44, 0x0a, 0x8b, 0x92, 0x4e, 0x9f, 0xa1, 0xe6, 0xa0, 0x4e,
-	0x28, 0x66, 0x98, 0xb8, 0xfa, 0x1c, 0x04, 0x2f, 0x82, 0x75, 0x6c, 0xd9, 0x46, 0x8f, 0x78, 0xcc,
-	0x2f, 0x71, 0x95, 0xe7, 0xf2, 0xc6, 0x74, 0x92, 0x89, 0x6b, 0xa5, 0x72, 0x9d, 0x78, 0x4c, 0xab,
-	0xe8, 0x71, 0x6c, 0xd9, 0x7c, 0xe9, 0xc0, 0x4b, 0x20, 0x81, 0x2d, 0xbb, 0xf0, 0x3c, 0x7e, 0x8d,
-	0xc7, 0x9f, 0x98, 0x4e, 0x32, 0x40, 0x2b, 0x95, 0x0b, 0x33, 0x00, 0xf0, 0x63, 0x66, 0x88, 0xcf,
-	0x40, 0x1c, 0x0d, 0x18, 0x72, 0xf9, 0xa5, 0x8c, 0xf1, 0x14, 0x37, 0x72, 0x62, 0xec, 0xe4, 0xe6,
-	0x63, 0x27, 0x57, 0x74, 0x87, 0xa5, 0x9d, 0x5f, 0x1f, 0x5e, 0xdc, 0x7e, 0x21, 0xf7, 0x60, 0x2f,
-	0xd4, 0x39, 0x8f, 0xbe, 0xa0, 0xbc, 0x1a, 0xfd, 0xcb, 0x9f, 0x1d, 0x5f, 0x86, 0x41, 0x72, 0x1e,
-	0xea, 0xf7, 0x66, 0x17, 0x53, 0x46, 0xbc, 0xa1, 0xea, 0x32, 0x6f, 0x08, 0xeb, 0x20, 0x4e, 0x7a,
-	0xc8, 0x33, 0xd9, 0x62, 0x8c, 0x14, 0x72, 0xaf, 0x3c, 0x29, 0x00, 0xaf, 0xcd, 0x51, 0xfe, 0xdb,
-	0xa2, 0x2f, 0x48, 0x82, 0x97, 0x22, 0xfc, 0xca, 0x4b, 0x71, 0x0d, 0xac, 0xf5, 0x7b, 0x0e, 0x6f,
-	0x4d, 0xe4, 0xbf, 0xb4, 0x66, 0x06, 0x82, 0x1f, 0x81, 0x48, 0x97, 0xb6, 0x79, 0xbb, 0x13, 0xa5,
-	0xed, 0x67, 0x93, 0x0c, 0xd4, 0xcd, 0x7b, 0xf3, 0x2c, 0xf7, 0x10, 0xa5, 0x66, 0x1b, 0x7d, 0xf7,
-	0xf4, 0xc1, 0xce, 0x3a, 0x76, 0x3b, 0xd8, 0x45, 0xc6, 0xe7, 0x94, 0xb8, 0xba, 0x0f, 0x51, 0x74,
-	0x00, 0x5f, 0x24, 0x86, 0xef, 0x80, 0x84, 0xd5, 0x21, 0xf6, 0x5d, 0xe3, 0x00, 0xe1, 0xf6, 0x01,
-	0x13, 0xd7, 0x59, 0x5f, 0xe7, 0xb6, 0x5d, 0x6e, 0x82, 0x9b, 0x20, 0xc6, 0x06, 0x06, 0x76, 0x1d,
-	0x34, 0x10, 0x85, 0xe9, 0x6b, 0x6c, 0xa0, 0xf9, 0x5b, 0x05, 0x81, 0x95, 0x3d, 0xe2, 0xa0, 0x0e,
-	0xbc, 0x0e, 0x22, 0x77, 0xd1, 0x50, 0xbc, 0xd2, 0xa5, 0x0f, 0x9f, 0x4d, 0x32, 0x97, 0xda, 0x98,
-	0x1d, 0xf4, 0xad, 0x9c, 0x4d, 0xba, 0x79, 0x9b, 0x74, 0x11, 0xb3, 0x5a, 0x6c, 0xb1, 0xe8, 0x60,
-	0x8b, 0xe6, 0xad, 0x21, 0x43, 0x34, 0xb7, 0x8b, 0x06, 0x25, 0x7f, 0xa1, 0xfb, 0x04, 0xfe, 0x7d,
-	0x16, 0x9f, 0x8e, 0x30, 0x1f, 0x0e, 0x62, 0xb3, 0xf3, 0xb7, 0x04, 0xc0, 0x62, 0x42, 0xc1, 0x2b,
-	0xe0, 0x74, 0xb1, 0x5c, 0x56, 0x1b, 0x0d, 0xa3, 0xb9, 0x5f, 0x57, 0x8d, 0x5b, 0xd5, 0x46, 0x5d,
-	0x2d, 0x6b, 0xd7, 0x35, 0xb5, 0x22, 0x87, 0x52, 0x9b, 0xa3, 0x71, 0xf6, 0xd4, 0x22, 0xf8, 0x96,
-	0x4b, 0x7b, 0xc8, 0xc6, 0x2d, 0x8c, 0x1c, 0x78, 0x01, 0xc0, 0x20, 0xae, 0x5a, 0x2b, 0xd5, 0x2a,
-	0xfb, 0xb2, 0x94, 0xda, 0x18, 0x8d, 0xb3, 0xf2, 0x02, 0x52, 0x25, 0x16, 0x71, 0x86, 0xb0, 0x00,
-	0x4e, 0x05, 0xa3, 0xd5, 0x8f, 0x55, 0x7d, 0x9f, 0x03, 0x22, 0xa9, 0xd3, 0xa3, 0x71, 0xf6, 0xad,
-	0x05, 0x40, 0x3d, 0x44, 0xde, 0x90, 0x63, 0xae, 0x81, 0xad, 0x20, 0xa6, 0x58, 0xdd, 0x37, 0x6a,
-	0xd7, 0x8d, 0x62, 0xa5, 0xa2, 0xab, 0x8d, 0x86, 0xda, 0x90, 0xa3, 0xa9, 0xad, 0xd1, 0x38, 0x9b,
-	0x5c, 0x40, 0x8b, 0xee, 0xb0, 0xd6, 0x2a, 0xce, 0xbf, 0x27, 0xa9, 0xd8, 0x17, 0x3f, 0xa4, 0x43,
-	0xf7, 0x7f, 0x4c, 0x87, 0x14, 0xff, 0x9b, 0x12, 0xde, 0xf9, 0x29, 0x02, 0xb2, 0xc7, 0x5d, 0x41,
-	0x88, 0xc0, 0xa5, 0x72, 0xad, 0xda, 0xd4, 0x8b, 0xe5, 0xa6, 0x51, 0xae, 0x55, 0x54, 0x63, 0x57,
-	0x6b, 0x34, 0x6b, 0xfa, 0xbe, 0x51, 0xab, 0xab, 0x7a, 0xb1, 0xa9, 0xd5, 0xaa, 0x2f, 0xd3, 0x29,
-	0x3f, 0x1a, 0x67, 0xcf, 0x1f, 0xc7, 0x1d, 0x54, 0xef, 0x36, 0x38, 0xb7, 0xd4, 0x31, 0x5a, 0x55,
-	0x6b, 0xca, 0x52, 0xea, 0xec, 0x68, 0x9c, 0x3d, 0x73, 0x1c, 0xbf, 0xe6, 0x62, 0x06, 0xef, 0x80,
-	0x0b, 0x4b, 0x11, 0xef, 0x69, 0x37, 0xf4, 0x62, 0x53, 0x95, 0xc3, 0xa9, 0xf3, 0xa3, 0x71, 0xf6,
-	0xfd, 0xe3, 0xb8, 0xf7, 0x70, 0xdb, 0x33, 0x19, 0x5a, 0x9a, 0xfe, 0x86, 0x5a, 0x55, 0x1b, 0x5a,
-	0x43, 0x8e, 0x2c, 0x47, 0x7f, 0x03, 0xb9, 0x88, 0x62, 0x9a, 0x8a, 0xfa, 0x2d, 0x2b, 0xed, 0x3e,
-	0xfa, 0x33, 0x1d, 0xba, 0x3f, 0x4d, 0x4b, 0x8f, 0xa6, 0x69, 0xe9, 0xf1, 0x34, 0x2d, 0xfd, 0x31,
-	0x4d, 0x4b, 0x5f, 0x3f, 0x49, 0x87, 0x1e, 0x3f, 0x49, 0x87, 0x7e, 0x7f, 0x92, 0x0e, 0x7d, 0xba,
-	0x1d, 0x78, 0x21, 0xca, 0x84, 0x76, 0x6f, 0xcf, 0xff, 0xe0, 0x9c, 0xfc, 0x40, 0xfc, 0xc9, 0xf1,
-	0xdf, 0x38, 0x6b, 0x95, 0xcf, 0xbf, 0x0f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x13, 0xdb, 0xf4,
-	0x60, 0xe7, 0x09, 0x00, 0x00,
+func (m *CronSchedule) Reset()         { *m = CronSchedule{} }
+func (m *CronSchedule) String() string { return proto.CompactTextString(m) }
+func (*CronSchedule) ProtoMessage()    {}
+func (*CronSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{10}
 }
 
-func (this *AccessTypeParam) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CronSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
 
-	that1, ok := that.(*AccessTypeParam)
-	if !ok {
-		that2, ok := that.(AccessTypeParam)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *CronSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
-	}
-	return true
 }
 
-func (this *AccessConfig) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CronSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronSchedule.Merge(m, src)
+}
 
-	that1, ok := that.(*AccessConfig)
-	if !ok {
-		that2, ok := that.(AccessConfig)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Permission != that1.Permission {
-		return false
-	}
-	if len(this.Addresses) != len(that1.Addresses) {
-		return false
-	}
-	for i := range this.Addresses {
-		if this.Addresses[i] != that1.Addresses[i] {
-			return false
-		}
-	}
-	return true
+func (m *CronSchedule) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CronSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronSchedule.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_CronSchedule proto.InternalMessageInfo
+
+// EpochHookSubscription is a contract subscription to the end of an x/epochs
+// epoch. The contract is called via sudo when an epoch with the identifier
+// ends.
+type EpochHookSubscription struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// EpochIdentifier is the identifier of the x/epochs epoch, e.g. "week"
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// GasLimit is the max gas that can be consumed by a single hook call
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *EpochHookSubscription) Reset()         { *m = EpochHookSubscription{} }
+func (m *EpochHookSubscription) String() string { return proto.CompactTextString(m) }
+func (*EpochHookSubscription) ProtoMessage()    {}
+func (*EpochHookSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{11}
+}
+
+func (m *EpochHookSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EpochHookSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochHookSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.CodeUploadAccess.Equal(&that1.CodeUploadAccess) {
-		return false
-	}
-	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
-		return false
-	}
-	return true
 }
 
-func (this *CodeInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *EpochHookSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochHookSubscription.Merge(m, src)
+}
 
-	that1, ok := that.(*CodeInfo)
-	if !ok {
-		that2, ok := that.(CodeInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *EpochHookSubscription) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EpochHookSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochHookSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochHookSubscription proto.InternalMessageInfo
+
+// FeeShare is the registration of a contract for a share of the tx fees
+type FeeShare struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// WithdrawAddress is the address that receives the fee share
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *FeeShare) Reset()         { *m = FeeShare{} }
+func (m *FeeShare) String() string { return proto.CompactTextString(m) }
+func (*FeeShare) ProtoMessage()    {}
+func (*FeeShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{12}
+}
+
+func (m *FeeShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FeeShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
-		return false
-	}
-	if this.Creator != that1.Creator {
-		return false
-	}
-	if !this.InstantiateConfig.Equal(&that1.InstantiateConfig) {
-		return false
-	}
-	return true
 }
 
-func (this *ContractInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *FeeShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeShare.Merge(m, src)
+}
 
-	that1, ok := that.(*ContractInfo)
-	if !ok {
-		that2, ok := that.(ContractInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *FeeShare) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *FeeShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeShare proto.InternalMessageInfo
+
+// CodeGasMultiplier is a gas discount or surcharge for the contracts of a
+// code. It is applied to the contract setup costs and the wasmvm gas.
+type CodeGasMultiplier struct {
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// MultiplierBps is the multiplier in basis points (1/10000). 10000 is the
+	// regular gas price.
+	MultiplierBps uint32 `protobuf:"varint,2,opt,name=multiplier_bps,json=multiplierBps,proto3" json:"multiplier_bps,omitempty"`
+}
+
+func (m *CodeGasMultiplier) Reset()         { *m = CodeGasMultiplier{} }
+func (m *CodeGasMultiplier) String() string { return proto.CompactTextString(m) }
+func (*CodeGasMultiplier) ProtoMessage()    {}
+func (*CodeGasMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{13}
+}
+
+func (m *CodeGasMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CodeGasMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeGasMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CodeID != that1.CodeID {
-		return false
-	}
-	if this.Creator != that1.Creator {
-		return false
-	}
-	if this.Admin != that1.Admin {
-		return false
-	}
-	if this.Label != that1.Label {
-		return false
-	}
-	if !this.Created.Equal(that1.Created) {
-		return false
-	}
-	if this.IBCPortID != that1.IBCPortID {
-		return false
-	}
-	if this.IBC2PortID != that1.IBC2PortID {
-		return false
-	}
-	if !this.Extension.Equal(that1.Extension) {
-		return false
-	}
-	return true
 }
 
-func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CodeGasMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeGasMultiplier.Merge(m, src)
+}
 
-	that1, ok := that.(*ContractCodeHistoryEntry)
-	if !ok {
-		that2, ok := that.(ContractCodeHistoryEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Operation != that1.Operation {
-		return false
-	}
-	if this.CodeID != that1.CodeID {
-		return false
-	}
-	if !this.Updated.Equal(that1.Updated) {
-		return false
-	}
-	if !bytes.Equal(this.Msg, that1.Msg) {
-		return false
-	}
-	return true
+func (m *CodeGasMultiplier) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CodeGasMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeGasMultiplier.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*AbsoluteTxPosition)
-	if !ok {
-		that2, ok := that.(AbsoluteTxPosition)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.BlockHeight != that1.BlockHeight {
-		return false
-	}
-	if this.TxIndex != that1.TxIndex {
-		return false
-	}
-	return true
+var xxx_messageInfo_CodeGasMultiplier proto.InternalMessageInfo
+
+// CodeSchema is the JSON schema of the contract messages of a code
+type CodeSchema struct {
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Schema is the gzip compressed JSON schema as generated by cosmwasm-schema
+	Schema []byte `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// ValidateMessages enables the validation of instantiate and execute
+	// messages against the schema before the contract is called
+	ValidateMessages bool `protobuf:"varint,3,opt,name=validate_messages,json=validateMessages,proto3" json:"validate_messages,omitempty"`
 }
 
-func (this *Model) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CodeSchema) Reset()         { *m = CodeSchema{} }
+func (m *CodeSchema) String() string { return proto.CompactTextString(m) }
+func (*CodeSchema) ProtoMessage()    {}
+func (*CodeSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{14}
+}
 
-	that1, ok := that.(*Model)
-	if !ok {
-		that2, ok := that.(Model)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *CodeSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CodeSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Key, that1.Key) {
-		return false
-	}
-	if !bytes.Equal(this.Value, that1.Value) {
-		return false
-	}
-	return true
 }
 
-func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CodeSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeSchema.Merge(m, src)
 }
 
-func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CodeSchema) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *CodeSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeSchema.DiscardUnknown(m)
 }
 
-func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_CodeSchema proto.InternalMessageInfo
+
+// FeeSponsorship is the opt-in of a contract to pay the fees of txs that
+// only execute the contract. The contract approves every tx via sudo.
+type FeeSponsorship struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// PerBlockLimit is the max total fee paid by the contract in a block
+	PerBlockLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=per_block_limit,json=perBlockLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"per_block_limit"`
+	// PerSenderLimit is the max fee paid by the contract for a single sender
+	// in a block
+	PerSenderLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=per_sender_limit,json=perSenderLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"per_sender_limit"`
+	// ApprovalGasLimit is the max gas that can be consumed by the sudo approval
+	// call
+	ApprovalGasLimit uint64 `protobuf:"varint,4,opt,name=approval_gas_limit,json=approvalGasLimit,proto3" json:"approval_gas_limit,omitempty"`
 }
 
-func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeSponsorship) Reset()         { *m = FeeSponsorship{} }
+func (m *FeeSponsorship) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorship) ProtoMessage()    {}
+func (*FeeSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{15}
 }
 
-func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *FeeSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FeeSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Permission != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *FeeSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorship.Merge(m, src)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeSponsorship) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InstantiateDefaultPermission != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *FeeSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorship.DiscardUnknown(m)
 }
 
-func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_FeeSponsorship proto.InternalMessageInfo
+
+// FeeSponsorshipUsage is the fee paid by a sponsoring contract in a block
+type FeeSponsorshipUsage struct {
+	// Height is the block height the usage belongs to
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Spent is the total fee paid in the block
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
 }
 
-func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeSponsorshipUsage) Reset()         { *m = FeeSponsorshipUsage{} }
+func (m *FeeSponsorshipUsage) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorshipUsage) ProtoMessage()    {}
+func (*FeeSponsorshipUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{16}
 }
 
-func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
+func (m *FeeSponsorshipUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FeeSponsorshipUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorshipUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CodeHash) > 0 {
-		i -= len(m.CodeHash)
-		copy(dAtA[i:], m.CodeHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
 
-func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *FeeSponsorshipUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorshipUsage.Merge(m, src)
 }
 
-func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeSponsorshipUsage) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Extension != nil {
-		{
-			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.IBC2PortID) > 0 {
-		i -= len(m.IBC2PortID)
-		copy(dAtA[i:], m.IBC2PortID)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBC2PortID)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.IBCPortID) > 0 {
-		i -= len(m.IBCPortID)
-		copy(dAtA[i:], m.IBCPortID)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Created != nil {
-		{
-			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *FeeSponsorshipUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorshipUsage.DiscardUnknown(m)
 }
 
-func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_FeeSponsorshipUsage proto.InternalMessageInfo
+
+// FeeSponsorshipSenderUsage is the fee paid by a sponsoring contract for a
+// sender in a block. It is stored separately for every sender.
+type FeeSponsorshipSenderUsage struct {
+	// Sender is the address of the tx fee payer
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Spent is the fee paid for the sender in the block
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
 }
 
-func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeSponsorshipSenderUsage) Reset()         { *m = FeeSponsorshipSenderUsage{} }
+func (m *FeeSponsorshipSenderUsage) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorshipSenderUsage) ProtoMessage()    {}
+func (*FeeSponsorshipSenderUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{17}
 }
 
-func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Updated != nil {
-		{
-			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *FeeSponsorshipSenderUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FeeSponsorshipSenderUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorshipSenderUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Operation != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *FeeSponsorshipSenderUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorshipSenderUsage.Merge(m, src)
 }
 
-func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeSponsorshipSenderUsage) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *FeeSponsorshipSenderUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorshipSenderUsage.DiscardUnknown(m)
 }
 
-func (m *Model) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_FeeSponsorshipSenderUsage proto.InternalMessageInfo
+
+// PendingAdminTransfer is a proposed contract admin change that takes effect
+// when the new admin accepts it
+type PendingAdminTransfer struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// NewAdmin is the address that can accept the admin transfer
+	NewAdmin string `protobuf:"bytes,2,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
+	// ExpiresAt is the block time after which the transfer can no longer be
+	// accepted. Not set when the transfer does not expire.
+	ExpiresAt *time.Time `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
 }
 
-func (m *Model) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingAdminTransfer) Reset()         { *m = PendingAdminTransfer{} }
+func (m *PendingAdminTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingAdminTransfer) ProtoMessage()    {}
+func (*PendingAdminTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{18}
 }
 
-func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *PendingAdminTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PendingAdminTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAdminTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
 
-func (m *AccessTypeParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovTypes(uint64(m.Value))
-	}
-	return n
+func (m *PendingAdminTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAdminTransfer.Merge(m, src)
 }
 
-func (m *AccessConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Permission != 0 {
-		n += 1 + sovTypes(uint64(m.Permission))
-	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	return n
+func (m *PendingAdminTransfer) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CodeUploadAccess.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.InstantiateDefaultPermission != 0 {
-		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
-	}
-	return n
+func (m *PendingAdminTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAdminTransfer.DiscardUnknown(m)
 }
 
-func (m *CodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_PendingAdminTransfer proto.InternalMessageInfo
+
+// ContractStorageUsage is the size of a contract's key value storage
+type ContractStorageUsage struct {
+	// Bytes is the total length of all keys and values stored by the contract
+	Bytes uint64 `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// Keys is the number of entries stored by the contract
+	Keys uint64 `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *ContractStorageUsage) Reset()         { *m = ContractStorageUsage{} }
+func (m *ContractStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*ContractStorageUsage) ProtoMessage()    {}
+func (*ContractStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{19}
+}
+
+func (m *ContractStorageUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStorageUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+
+func (m *ContractStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStorageUsage.Merge(m, src)
+}
+
+func (m *ContractStorageUsage) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStorageUsage proto.InternalMessageInfo
+
+// StorageDeposit is the deposit held in escrow for the storage of a contract
+type StorageDeposit struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Amount is the deposit held for the contract
+	Amount types1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *StorageDeposit) Reset()         { *m = StorageDeposit{} }
+func (m *StorageDeposit) String() string { return proto.CompactTextString(m) }
+func (*StorageDeposit) ProtoMessage()    {}
+func (*StorageDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{20}
+}
+
+func (m *StorageDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *StorageDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+
+func (m *StorageDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageDeposit.Merge(m, src)
+}
+
+func (m *StorageDeposit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *StorageDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageDeposit proto.InternalMessageInfo
+
+// ContractName is a unique human readable name claimed for a contract
+type ContractName struct {
+	// Name is the unique name, e.g. "mantra.dex.pool-factory"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *ContractName) Reset()         { *m = ContractName{} }
+func (m *ContractName) String() string { return proto.CompactTextString(m) }
+func (*ContractName) ProtoMessage()    {}
+func (*ContractName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{21}
+}
+
+func (m *ContractName) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractName.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.InstantiateConfig.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
 }
 
-func (m *ContractInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractName.Merge(m, src)
+}
+
+func (m *ContractName) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractName) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractName proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
+	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1.AccessTypeParam")
+	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1.AccessConfig")
+	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1.Params")
+	proto.RegisterType((*GasRegisterParams)(nil), "cosmwasm.wasm.v1.GasRegisterParams")
+	proto.RegisterType((*CodeInfo)(nil), "cosmwasm.wasm.v1.CodeInfo")
+	proto.RegisterType((*CodeMetadata)(nil), "cosmwasm.wasm.v1.CodeMetadata")
+	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1.ContractInfo")
+	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
+	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
+	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
+	proto.RegisterType((*CronSchedule)(nil), "cosmwasm.wasm.v1.CronSchedule")
+	proto.RegisterType((*EpochHookSubscription)(nil), "cosmwasm.wasm.v1.EpochHookSubscription")
+	proto.RegisterType((*FeeShare)(nil), "cosmwasm.wasm.v1.FeeShare")
+	proto.RegisterType((*CodeGasMultiplier)(nil), "cosmwasm.wasm.v1.CodeGasMultiplier")
+	proto.RegisterType((*CodeSchema)(nil), "cosmwasm.wasm.v1.CodeSchema")
+	proto.RegisterType((*FeeSponsorship)(nil), "cosmwasm.wasm.v1.FeeSponsorship")
+	proto.RegisterType((*FeeSponsorshipUsage)(nil), "cosmwasm.wasm.v1.FeeSponsorshipUsage")
+	proto.RegisterType((*FeeSponsorshipSenderUsage)(nil), "cosmwasm.wasm.v1.FeeSponsorshipSenderUsage")
+	proto.RegisterType((*PendingAdminTransfer)(nil), "cosmwasm.wasm.v1.PendingAdminTransfer")
+	proto.RegisterType((*ContractStorageUsage)(nil), "cosmwasm.wasm.v1.ContractStorageUsage")
+	proto.RegisterType((*StorageDeposit)(nil), "cosmwasm.wasm.v1.StorageDeposit")
+	proto.RegisterType((*ContractName)(nil), "cosmwasm.wasm.v1.ContractName")
+}
+
+func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
+
+var fileDescriptor_e6155d98fa173e02 = []byte{
+	// 2669 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0x94, 0x4c, 0x8e, 0x28, 0x99, 0x5a, 0x4b, 0x36, 0xc5, 0xd8, 0x24, 0xb3, 0x4e,
+	0x5c, 0x45, 0x8e, 0x29, 0x5b, 0x4d, 0xd3, 0xd6, 0x2d, 0x5c, 0xf0, 0x4b, 0x12, 0x8d, 0xe8, 0x03,
+	0x4b, 0x3a, 0x89, 0xdb, 0xa6, 0x8b, 0xe1, 0xee, 0x88, 0x9c, 0x8a, 0x3b, 0xb3, 0xd8, 0x19, 0x4a,
+	0x62, 0xae, 0x6d, 0x81, 0x56, 0x41, 0x8b, 0x5c, 0x0a, 0x14, 0x05, 0x04, 0x14, 0x68, 0xd1, 0x06,
+	0x3d, 0xe5, 0x90, 0x3f, 0xa1, 0x2d, 0x8c, 0x9e, 0x82, 0x9e, 0x7a, 0x62, 0x5a, 0xe5, 0x90, 0x9e,
+	0x75, 0xe8, 0x21, 0x40, 0x81, 0x62, 0x66, 0x76, 0x49, 0xca, 0x92, 0x2c, 0xc5, 0x28, 0xd2, 0x0b,
+	0xb5, 0xf3, 0xde, 0xfb, 0xbd, 0x79, 0xf3, 0xde, 0x9b, 0xf7, 0xde, 0xae, 0xc0, 0x75, 0x9b, 0x32,
+	0x77, 0x17, 0x32, 0x77, 0x51, 0xfe, 0xec, 0xdc, 0x5b, 0xe4, 0x5d, 0x0f, 0xb1, 0xbc, 0xe7, 0x53,
+	0x4e, 0xf5, 0x64, 0xc8, 0xcd, 0xcb, 0x9f, 0x9d, 0x7b, 0xe9, 0x39, 0x41, 0xa1, 0xcc, 0x92, 0xfc,
+	0x45, 0xb5, 0x50, 0xc2, 0xe9, 0x99, 0x26, 0x6d, 0x52, 0x45, 0x17, 0x4f, 0x01, 0x75, 0xae, 0x49,
+	0x69, 0xb3, 0x8d, 0x16, 0xe5, 0xaa, 0xd1, 0xd9, 0x5a, 0x84, 0xa4, 0x1b, 0xb0, 0xa6, 0xa1, 0x8b,
+	0x09, 0x5d, 0x94, 0xbf, 0x01, 0x29, 0xa3, 0x34, 0x2e, 0x36, 0x20, 0x43, 0x8b, 0x3b, 0xf7, 0x1a,
+	0x88, 0xc3, 0x7b, 0x8b, 0x36, 0xc5, 0x24, 0xe0, 0x67, 0x9f, 0xd6, 0xc6, 0xb1, 0x8b, 0x18, 0x87,
+	0xae, 0xa7, 0x04, 0x8c, 0x77, 0xc0, 0xe5, 0x82, 0x6d, 0x23, 0xc6, 0xea, 0x5d, 0x0f, 0x6d, 0x42,
+	0x1f, 0xba, 0x7a, 0x19, 0x8c, 0xed, 0xc0, 0x76, 0x07, 0xa5, 0xb4, 0x9c, 0x36, 0x3f, 0xb5, 0x74,
+	0x3d, 0xff, 0xf4, 0xa1, 0xf2, 0x03, 0x44, 0x31, 0x79, 0xd4, 0xcb, 0x26, 0xba, 0xd0, 0x6d, 0xdf,
+	0x37, 0x24, 0xc8, 0x30, 0x15, 0xf8, 0x7e, 0xf4, 0x57, 0xbf, 0xc9, 0x6a, 0xc6, 0x1f, 0x34, 0x90,
+	0x50, 0xd2, 0x25, 0x4a, 0xb6, 0x70, 0x53, 0xaf, 0x01, 0xe0, 0x21, 0xdf, 0xc5, 0x8c, 0x61, 0x4a,
+	0x2e, 0xb4, 0xc3, 0xec, 0x51, 0x2f, 0x3b, 0xad, 0x76, 0x18, 0x20, 0x0d, 0x73, 0x48, 0x8d, 0xfe,
+	0x3a, 0x88, 0x43, 0xc7, 0xf1, 0x11, 0x63, 0x88, 0xa5, 0x22, 0xb9, 0xc8, 0x7c, 0xbc, 0x98, 0xfa,
+	0xdb, 0x47, 0x77, 0x66, 0x02, 0x77, 0x17, 0x14, 0xaf, 0xc6, 0x7d, 0x4c, 0x9a, 0xe6, 0x40, 0x54,
+	0xd9, 0xf8, 0x30, 0x1a, 0x1b, 0x4d, 0x46, 0x8c, 0x83, 0x38, 0x18, 0x97, 0xe7, 0x67, 0x3a, 0x07,
+	0xba, 0x4d, 0x1d, 0x64, 0x75, 0xbc, 0x36, 0x85, 0x8e, 0x05, 0xa5, 0x2d, 0xd2, 0xd6, 0x89, 0xa5,
+	0xcc, 0x59, 0xb6, 0xaa, 0xf3, 0x15, 0x6f, 0x3d, 0xe9, 0x65, 0x47, 0x8e, 0x7a, 0xd9, 0x39, 0x65,
+	0xf1, 0x49, 0x3d, 0xc6, 0x07, 0x9f, 0x7d, 0xb8, 0xa0, 0x99, 0x49, 0xc1, 0x79, 0x24, 0x19, 0x0a,
+	0xaf, 0xff, 0x5c, 0x03, 0x19, 0x4c, 0x18, 0x87, 0x84, 0x63, 0xc8, 0x91, 0xe5, 0xa0, 0x2d, 0xd8,
+	0x69, 0x73, 0x6b, 0xc8, 0x5d, 0xa3, 0x17, 0x70, 0xd7, 0x2b, 0x47, 0xbd, 0xec, 0xcb, 0x6a, 0xf3,
+	0x67, 0x6b, 0x33, 0xcc, 0xeb, 0x43, 0x02, 0x65, 0xc5, 0xdf, 0x1c, 0x38, 0xf5, 0x31, 0xb8, 0xe6,
+	0xa0, 0x1d, 0xd4, 0xa6, 0x1e, 0xf2, 0xad, 0x2d, 0x84, 0x2c, 0xd6, 0x82, 0x3e, 0xb2, 0x1a, 0x9e,
+	0x70, 0xb1, 0x36, 0x3f, 0x59, 0x34, 0x8e, 0x7a, 0xd9, 0x8c, 0xda, 0xe9, 0x0c, 0x41, 0xc3, 0x9c,
+	0xe9, 0x73, 0x96, 0x11, 0xaa, 0x09, 0x7a, 0xd1, 0x63, 0xfa, 0x36, 0xb8, 0x01, 0x1d, 0x17, 0x13,
+	0x8b, 0xfb, 0x90, 0xb0, 0x2d, 0xe4, 0x5b, 0x68, 0xcf, 0xc3, 0x7e, 0xd7, 0x62, 0xc8, 0xa6, 0xc4,
+	0x61, 0xa9, 0x68, 0x4e, 0x9b, 0x8f, 0x16, 0xe7, 0x8f, 0x7a, 0xd9, 0x97, 0xd4, 0x06, 0xcf, 0x14,
+	0x37, 0xcc, 0xb4, 0xe4, 0xd7, 0x03, 0x76, 0x45, 0x72, 0x6b, 0x8a, 0xa9, 0xd7, 0xc1, 0x2c, 0xe3,
+	0xd4, 0x87, 0x4d, 0xe1, 0x04, 0x8f, 0x32, 0xcc, 0x2d, 0x07, 0x11, 0xea, 0xa6, 0xc6, 0x72, 0xda,
+	0x7c, 0xbc, 0x98, 0x3b, 0xea, 0x65, 0xaf, 0xab, 0x4d, 0x4e, 0x15, 0x33, 0xcc, 0x2b, 0x01, 0xbd,
+	0xac, 0xc8, 0x65, 0x41, 0xd5, 0xbf, 0x0f, 0x52, 0x4f, 0x8b, 0x8b, 0xe3, 0x37, 0xba, 0x1c, 0xa5,
+	0xc6, 0xa5, 0xf5, 0x37, 0x8f, 0x7a, 0xd9, 0xec, 0xe9, 0x8a, 0x43, 0x49, 0xc3, 0x9c, 0x3d, 0xae,
+	0x7b, 0x13, 0xf9, 0xc5, 0x2e, 0x47, 0xfa, 0x9b, 0xe0, 0x2a, 0xec, 0x70, 0x6a, 0x79, 0x98, 0x58,
+	0xbb, 0x98, 0x38, 0x74, 0xd7, 0x6a, 0xb4, 0xa9, 0xbd, 0xcd, 0x52, 0x97, 0xa4, 0xee, 0x17, 0x8f,
+	0x7a, 0xd9, 0x1b, 0x81, 0x67, 0x4e, 0x95, 0x33, 0xcc, 0x2b, 0x82, 0xb1, 0x89, 0xc9, 0x5b, 0x92,
+	0x5c, 0x94, 0x54, 0xfd, 0x21, 0xd0, 0xfb, 0xf2, 0x2e, 0xdc, 0xb3, 0x44, 0x12, 0xb2, 0x54, 0x4c,
+	0x86, 0xf3, 0xc6, 0x20, 0x6b, 0x4f, 0xca, 0x18, 0xe6, 0xe5, 0x40, 0xdf, 0x1a, 0xdc, 0x2b, 0x09,
+	0xca, 0x31, 0x1b, 0x5d, 0xe4, 0x52, 0xbf, 0x6b, 0x35, 0x3a, 0x4e, 0x13, 0xf1, 0x54, 0xfc, 0x4c,
+	0x1b, 0x8f, 0xc9, 0x0d, 0x6c, 0x5c, 0x93, 0xe4, 0xa2, 0xa4, 0x8a, 0xbc, 0x1b, 0xc8, 0x63, 0x62,
+	0xa1, 0x3d, 0x64, 0x77, 0x38, 0xa6, 0x84, 0xa5, 0x80, 0x54, 0x3c, 0x94, 0x77, 0x67, 0x08, 0x1a,
+	0xe6, 0x4c, 0xa8, 0x19, 0x93, 0x4a, 0x9f, 0xac, 0x5b, 0x20, 0xd1, 0x84, 0xcc, 0xf2, 0x51, 0x13,
+	0x33, 0x8e, 0xfc, 0xd4, 0x84, 0xbc, 0xd2, 0x37, 0x4f, 0xde, 0xa7, 0x15, 0xc8, 0xcc, 0x40, 0x48,
+	0xd5, 0x84, 0xe2, 0xb5, 0xa3, 0x5e, 0xf6, 0x8a, 0xda, 0x74, 0x58, 0x85, 0x61, 0x4e, 0x34, 0x07,
+	0xb2, 0x7a, 0x0d, 0xcc, 0x22, 0x8f, 0xda, 0x2d, 0xab, 0x45, 0xe9, 0xb6, 0x25, 0x04, 0x03, 0x97,
+	0x24, 0xa4, 0xe5, 0x43, 0xb9, 0x76, 0xaa, 0x98, 0x61, 0xea, 0x92, 0xbe, 0x4a, 0xe9, 0xf6, 0x0a,
+	0x64, 0xca, 0x21, 0xb2, 0x4a, 0x8d, 0x18, 0x7f, 0x1e, 0x03, 0xd3, 0x27, 0xcc, 0xd2, 0x6f, 0x82,
+	0x49, 0x75, 0x89, 0x6d, 0x64, 0xd9, 0x94, 0x71, 0x59, 0xa5, 0xa2, 0x66, 0x22, 0x24, 0x96, 0x28,
+	0xe3, 0xfa, 0x6b, 0xe0, 0xea, 0x31, 0x21, 0xcb, 0xc1, 0xcc, 0xa6, 0x1d, 0xc2, 0x65, 0x41, 0x89,
+	0x9a, 0x33, 0xc3, 0xd2, 0xe5, 0x80, 0xa7, 0xbf, 0x08, 0x12, 0x36, 0x75, 0x3d, 0xdc, 0x0e, 0x34,
+	0x47, 0xa4, 0xec, 0x44, 0x40, 0x93, 0x8a, 0xef, 0x83, 0xb9, 0x0e, 0x11, 0x04, 0x51, 0x4f, 0x95,
+	0x6a, 0xd2, 0x71, 0x91, 0x0f, 0x39, 0xf5, 0xd5, 0x1d, 0x36, 0xaf, 0x0d, 0x04, 0x04, 0x64, 0x3d,
+	0x64, 0xeb, 0x0f, 0xc0, 0x0b, 0x4f, 0x63, 0xe5, 0x7d, 0xc3, 0x44, 0xa2, 0xc7, 0x24, 0x7a, 0xee,
+	0x38, 0xba, 0x3c, 0x10, 0xd0, 0x5f, 0x06, 0x53, 0xc2, 0x71, 0x6e, 0xa7, 0xcd, 0xb1, 0xd7, 0xc6,
+	0xc8, 0x57, 0xd7, 0xce, 0x9c, 0x6c, 0x42, 0xb6, 0xd6, 0x27, 0xea, 0x5f, 0x07, 0x29, 0xb4, 0x83,
+	0x88, 0xba, 0x73, 0x90, 0x73, 0x1f, 0x37, 0x3a, 0x3c, 0x38, 0x91, 0xbc, 0x4b, 0xe6, 0xac, 0xe4,
+	0x6f, 0x22, 0xbf, 0x10, 0x72, 0xe5, 0xd9, 0xbe, 0x09, 0xe6, 0x14, 0x70, 0x00, 0x72, 0x20, 0x87,
+	0x0a, 0x19, 0x93, 0xc8, 0xab, 0x52, 0xa0, 0x0f, 0x2b, 0x43, 0x0e, 0x25, 0xb4, 0x08, 0x32, 0xa7,
+	0x42, 0xb7, 0x7c, 0x84, 0x2c, 0x2e, 0x4c, 0x95, 0x37, 0xc4, 0x4c, 0x9f, 0xc4, 0x2f, 0xfb, 0x08,
+	0xd5, 0x85, 0xdd, 0xdf, 0x02, 0x69, 0x9b, 0x12, 0xee, 0x43, 0x9b, 0x5b, 0x2e, 0x62, 0x4c, 0x96,
+	0x8f, 0xfe, 0xfe, 0x40, 0xf9, 0x36, 0x94, 0x58, 0x53, 0x02, 0x7d, 0x03, 0x16, 0xc0, 0xb4, 0xdd,
+	0x61, 0x9c, 0xba, 0x96, 0xb2, 0x43, 0x62, 0x26, 0x24, 0xe6, 0xb2, 0x62, 0x54, 0x04, 0x5d, 0xca,
+	0x2e, 0x81, 0xd9, 0x56, 0xc7, 0x85, 0x04, 0xbf, 0x8b, 0xac, 0xa0, 0x33, 0x2a, 0x79, 0x99, 0xb2,
+	0xe6, 0x95, 0x90, 0x19, 0x34, 0xd1, 0x30, 0xee, 0x36, 0x24, 0x94, 0x60, 0x1b, 0xb6, 0x4f, 0xe0,
+	0x26, 0x03, 0xdb, 0x86, 0x04, 0x86, 0xb0, 0xc6, 0x7f, 0x34, 0x10, 0x13, 0x05, 0xa4, 0x4a, 0xb6,
+	0xa8, 0xfe, 0x02, 0x88, 0xcb, 0x0e, 0xd9, 0x82, 0xac, 0x25, 0x53, 0x37, 0x61, 0xc6, 0x04, 0x61,
+	0x15, 0xb2, 0x96, 0xbe, 0x04, 0x2e, 0xd9, 0x3e, 0x92, 0xd9, 0x30, 0x2a, 0x4b, 0xf5, 0xd9, 0x3d,
+	0x3d, 0x14, 0xd4, 0xdf, 0x06, 0xfa, 0x70, 0xd7, 0xb3, 0x65, 0x53, 0x96, 0xc9, 0x74, 0x7e, 0xeb,
+	0x8e, 0x8b, 0xd6, 0xad, 0xba, 0xf3, 0xf4, 0x90, 0x92, 0x60, 0x70, 0xb9, 0x0f, 0x62, 0x2e, 0xe2,
+	0x50, 0xc4, 0x40, 0x66, 0xda, 0xa9, 0xfa, 0xc4, 0xc1, 0xd6, 0x02, 0x29, 0xb3, 0x2f, 0xff, 0x30,
+	0x1a, 0x8b, 0x24, 0xa3, 0x0f, 0xa3, 0xb1, 0x68, 0x72, 0xcc, 0xf8, 0x8b, 0x06, 0x12, 0xc3, 0x62,
+	0xfa, 0x6d, 0x30, 0xcd, 0x68, 0xc7, 0xb7, 0x91, 0xe5, 0xab, 0x26, 0x40, 0xfd, 0xae, 0xf4, 0x45,
+	0xdc, 0x4c, 0x2a, 0x86, 0xd9, 0xa7, 0xeb, 0x57, 0xc1, 0xb8, 0x4d, 0x5d, 0x17, 0xab, 0xab, 0x1b,
+	0x37, 0x83, 0x95, 0xa8, 0x03, 0x8d, 0x0e, 0x6e, 0x3b, 0xc8, 0xb7, 0xb0, 0x0b, 0x9b, 0x48, 0xde,
+	0xd6, 0xb8, 0x99, 0x08, 0x88, 0x55, 0x41, 0x13, 0x3b, 0x51, 0x8f, 0x63, 0x17, 0xbf, 0x8b, 0x7c,
+	0x6b, 0x07, 0xf9, 0x72, 0xa6, 0x88, 0xaa, 0x9d, 0xfa, 0x8c, 0x37, 0x15, 0x5d, 0xcf, 0x82, 0x09,
+	0x66, 0xb7, 0x90, 0x0b, 0x55, 0x70, 0x64, 0xb3, 0x34, 0x81, 0x22, 0x89, 0xf0, 0x18, 0x1f, 0x45,
+	0xc4, 0x41, 0x54, 0x02, 0xca, 0x60, 0xde, 0x04, 0x97, 0x64, 0x30, 0xb1, 0xa3, 0xaa, 0x50, 0x11,
+	0x1c, 0xf6, 0xb2, 0xe3, 0x32, 0xd6, 0x65, 0x61, 0xa8, 0x83, 0xaa, 0xce, 0x73, 0x05, 0x35, 0x0f,
+	0xc6, 0x64, 0x7f, 0x57, 0x87, 0x7a, 0x06, 0x42, 0x89, 0xe9, 0x33, 0x60, 0xac, 0x0d, 0x1b, 0xa8,
+	0x1d, 0x9c, 0x4d, 0x2d, 0xf4, 0x07, 0xc1, 0xce, 0xc8, 0x09, 0xf2, 0xe1, 0xa5, 0x53, 0xf2, 0xa1,
+	0xc1, 0x68, 0xbb, 0xc3, 0x51, 0x7d, 0x6f, 0x53, 0x78, 0x1c, 0x53, 0x62, 0x86, 0x20, 0xfd, 0x0e,
+	0x98, 0xc0, 0x0d, 0xdb, 0xf2, 0xa8, 0xcf, 0xc5, 0x11, 0xc7, 0xa5, 0x2d, 0x93, 0x87, 0xbd, 0x6c,
+	0xbc, 0x5a, 0x2c, 0x6d, 0x52, 0x9f, 0x57, 0xcb, 0x66, 0x1c, 0x37, 0x6c, 0xf9, 0xe8, 0xe8, 0x77,
+	0x41, 0x02, 0x37, 0xec, 0xa5, 0xbe, 0xfc, 0x25, 0x29, 0x3f, 0x75, 0xd8, 0xcb, 0x82, 0x6a, 0xb1,
+	0xb4, 0x14, 0x00, 0x80, 0x90, 0x09, 0x10, 0x3f, 0x00, 0x71, 0xb4, 0xc7, 0x11, 0x91, 0x61, 0x89,
+	0x49, 0x13, 0x67, 0xf2, 0x6a, 0x7e, 0xcf, 0x87, 0xf3, 0x7b, 0xbe, 0x40, 0xba, 0xc5, 0x85, 0xbf,
+	0x7e, 0x74, 0xe7, 0xd6, 0x29, 0xb9, 0x37, 0x88, 0x45, 0x25, 0xd4, 0x63, 0x0e, 0x54, 0xde, 0x8f,
+	0xfe, 0x4b, 0x4c, 0xe4, 0xef, 0x8d, 0x82, 0x54, 0x28, 0x2a, 0x62, 0xb3, 0x8a, 0xc5, 0x08, 0xd2,
+	0xad, 0x10, 0xee, 0x77, 0xf5, 0x4d, 0x10, 0x17, 0xb3, 0x1a, 0xe4, 0x83, 0xe1, 0x7c, 0x29, 0x7f,
+	0xe6, 0x4e, 0x43, 0xf0, 0x8d, 0x10, 0x25, 0x66, 0x50, 0x73, 0xa0, 0x64, 0x38, 0x29, 0x46, 0xcf,
+	0x4c, 0x8a, 0x07, 0xe0, 0x52, 0xc7, 0x73, 0x64, 0x68, 0x22, 0x5f, 0x24, 0x34, 0x01, 0x48, 0xff,
+	0x06, 0x88, 0xb8, 0xac, 0x29, 0xc3, 0x9d, 0x28, 0xde, 0xfa, 0xbc, 0x97, 0xd5, 0x4d, 0xb8, 0x5b,
+	0x3a, 0x5e, 0x1c, 0x7f, 0xfd, 0xd9, 0x87, 0x0b, 0x13, 0x98, 0xb4, 0x31, 0x41, 0xd6, 0x0f, 0x19,
+	0x25, 0xa6, 0x80, 0x18, 0x26, 0xd0, 0x4f, 0x2a, 0x16, 0xad, 0x4f, 0x8e, 0x51, 0x56, 0x0b, 0xe1,
+	0x66, 0x2b, 0x6c, 0xaa, 0x13, 0x92, 0xb6, 0x2a, 0x49, 0xfa, 0x1c, 0x88, 0xf1, 0x3d, 0x0b, 0x13,
+	0x07, 0xed, 0x05, 0x5d, 0xf4, 0x12, 0xdf, 0xab, 0x8a, 0xa5, 0x81, 0xc0, 0xd8, 0x1a, 0x75, 0x50,
+	0x5b, 0x5f, 0x06, 0x91, 0x6d, 0xa4, 0xee, 0x72, 0xa2, 0xf8, 0xda, 0xe7, 0xbd, 0xec, 0xdd, 0x26,
+	0xe6, 0xad, 0x4e, 0x23, 0x6f, 0x53, 0x77, 0xd1, 0xa6, 0x2e, 0xe2, 0x8d, 0x2d, 0x3e, 0x78, 0x68,
+	0xe3, 0x06, 0x5b, 0x14, 0x63, 0x21, 0xcb, 0xaf, 0xa2, 0x3d, 0x31, 0x07, 0x32, 0x53, 0x28, 0x10,
+	0xf9, 0xac, 0x5e, 0xc8, 0x46, 0x65, 0x85, 0x54, 0x0b, 0xe3, 0xc9, 0x28, 0x48, 0x94, 0x7c, 0x4a,
+	0x6a, 0x76, 0x0b, 0x39, 0x9d, 0x36, 0xd2, 0x75, 0x10, 0x25, 0xd0, 0x45, 0x41, 0xed, 0x90, 0xcf,
+	0xfa, 0x6b, 0x20, 0x16, 0x36, 0x89, 0x73, 0xef, 0x5b, 0x5f, 0x32, 0xf4, 0x67, 0xe4, 0x0b, 0xfb,
+	0x53, 0x4f, 0x83, 0x18, 0x26, 0x1c, 0xf9, 0x3b, 0xb0, 0x1d, 0x0c, 0x00, 0xfd, 0xb5, 0x28, 0xf6,
+	0xa2, 0x63, 0xb7, 0xb1, 0x28, 0x5f, 0xaa, 0xbf, 0xc7, 0x9a, 0x90, 0xbd, 0x21, 0xd6, 0xfa, 0x0d,
+	0x00, 0xc4, 0xb0, 0x89, 0x7c, 0x9f, 0xfa, 0x2c, 0x68, 0xe5, 0x71, 0x17, 0xee, 0x55, 0x24, 0x41,
+	0x54, 0x23, 0x82, 0xf6, 0x78, 0x18, 0x10, 0xd5, 0xb9, 0x81, 0x20, 0x05, 0xf1, 0xc8, 0x82, 0x09,
+	0x89, 0xb5, 0xd4, 0x60, 0xa3, 0x1a, 0x34, 0x90, 0xa4, 0x92, 0x1c, 0x67, 0xd2, 0x20, 0xe6, 0x60,
+	0x06, 0x1b, 0x6d, 0xe4, 0xc8, 0xf6, 0x1b, 0x33, 0xfb, 0x6b, 0xe3, 0x97, 0x1a, 0x98, 0xad, 0x84,
+	0x83, 0x57, 0xad, 0xd3, 0x60, 0xb6, 0x8f, 0x3d, 0x99, 0x09, 0xc3, 0xfe, 0xd3, 0x2e, 0xec, 0xbf,
+	0x57, 0x40, 0x52, 0xcd, 0x77, 0xd8, 0x41, 0x84, 0xe3, 0x2d, 0xd1, 0xf2, 0x55, 0xbd, 0xbe, 0x2c,
+	0xe9, 0xd5, 0x3e, 0xf9, 0xb8, 0x53, 0x22, 0xc7, 0x9d, 0x62, 0xfc, 0x44, 0x03, 0xb1, 0xf0, 0xbd,
+	0xe9, 0x39, 0x4d, 0x29, 0x81, 0xe4, 0x2e, 0xe6, 0x2d, 0xc7, 0x87, 0xbb, 0x61, 0x9b, 0x3e, 0x37,
+	0x11, 0x2e, 0x87, 0x88, 0x80, 0x6c, 0x58, 0x60, 0x5a, 0xdc, 0xd8, 0x95, 0x63, 0x93, 0xd5, 0x85,
+	0xca, 0xfd, 0xcb, 0x60, 0x6a, 0x30, 0xa1, 0xc9, 0x77, 0x47, 0xb1, 0xf9, 0xa4, 0x39, 0x39, 0xa0,
+	0x16, 0x3d, 0x66, 0xec, 0x00, 0x20, 0x80, 0x35, 0xd9, 0x5d, 0x2e, 0xa6, 0xf9, 0x2a, 0x18, 0x57,
+	0xcd, 0x28, 0xb8, 0x15, 0xc1, 0x4a, 0x34, 0xb9, 0x1d, 0xd8, 0xc6, 0xa2, 0x30, 0x84, 0x83, 0x93,
+	0x7a, 0x61, 0x8d, 0x99, 0xc9, 0x90, 0x11, 0xa4, 0x30, 0x33, 0x7e, 0x11, 0x01, 0x53, 0xc2, 0xc1,
+	0x1e, 0x25, 0x8c, 0xfa, 0xac, 0x85, 0xbd, 0xe7, 0x74, 0xf3, 0xcf, 0x34, 0x70, 0x59, 0xbe, 0xd5,
+	0xc9, 0xb2, 0xa1, 0xa2, 0x39, 0x9a, 0x8b, 0xcc, 0x4f, 0x2c, 0xcd, 0xe5, 0x03, 0x68, 0x03, 0x32,
+	0x94, 0x0f, 0x3e, 0xd1, 0xe4, 0x4b, 0x14, 0x93, 0xe2, 0xb2, 0x18, 0x38, 0xfe, 0xf8, 0x49, 0x76,
+	0xfe, 0x58, 0x59, 0x90, 0xdf, 0x73, 0xd4, 0x9f, 0x3b, 0xcc, 0xd9, 0x0e, 0xbe, 0x2f, 0x09, 0x00,
+	0x13, 0x77, 0x2e, 0xd1, 0x46, 0x4d, 0x68, 0x77, 0x2d, 0x5b, 0x10, 0xd4, 0xb4, 0x32, 0xe9, 0x21,
+	0x5f, 0xbe, 0xe0, 0xa9, 0xab, 0xf4, 0x9e, 0x06, 0x92, 0xc2, 0x16, 0x86, 0x88, 0x98, 0x07, 0xc2,
+	0xd4, 0xfa, 0x92, 0x8c, 0x99, 0xf2, 0x90, 0x5f, 0x93, 0x3b, 0x2b, 0x6b, 0x5e, 0x05, 0x3a, 0xf4,
+	0x3c, 0x9f, 0xee, 0xc0, 0xb6, 0x35, 0xc8, 0x74, 0x55, 0x1b, 0x92, 0x21, 0x67, 0x25, 0xcc, 0xf8,
+	0xdf, 0x6b, 0xe0, 0xca, 0xf1, 0x80, 0x3c, 0x12, 0x91, 0x12, 0xd1, 0x1e, 0xaa, 0xc5, 0x11, 0x33,
+	0x58, 0xe9, 0xbb, 0x60, 0x8c, 0x79, 0x88, 0x7c, 0x89, 0xce, 0x56, 0xfb, 0x89, 0x31, 0x6e, 0xee,
+	0xb8, 0xa1, 0xea, 0xd0, 0xca, 0xdc, 0xbb, 0x60, 0x5c, 0x79, 0xff, 0xdc, 0x14, 0x0a, 0xe4, 0xfe,
+	0x7f, 0x07, 0xf9, 0x93, 0x06, 0x66, 0x36, 0x11, 0x71, 0x30, 0x69, 0x16, 0x86, 0x3f, 0xa2, 0x3c,
+	0xe7, 0x45, 0xf8, 0x1a, 0x88, 0x13, 0x24, 0x4a, 0x8d, 0x98, 0xd7, 0xce, 0xed, 0x38, 0x04, 0xed,
+	0xca, 0x4d, 0xf5, 0xef, 0x00, 0x20, 0xbf, 0xe9, 0x20, 0x66, 0x41, 0x1e, 0x0c, 0x01, 0xe9, 0x13,
+	0xc3, 0x4f, 0x3d, 0xfc, 0x78, 0x59, 0x8c, 0xbe, 0xff, 0x49, 0x56, 0x13, 0xc3, 0x8d, 0xc4, 0x14,
+	0xb8, 0xb1, 0x0c, 0x66, 0xc2, 0x06, 0x55, 0x53, 0x9f, 0x54, 0x54, 0x24, 0x66, 0xc0, 0x98, 0x6c,
+	0xa9, 0x41, 0x0f, 0x57, 0x0b, 0xd1, 0x2a, 0xb7, 0x51, 0x97, 0x05, 0x9d, 0x5b, 0x3e, 0x07, 0xe3,
+	0xd1, 0x8f, 0x35, 0x30, 0x55, 0x3b, 0xf6, 0x4d, 0xe6, 0x39, 0x1d, 0xf1, 0x6d, 0x30, 0x0e, 0xdd,
+	0xfe, 0x4b, 0xf6, 0x33, 0x23, 0x3a, 0xf4, 0xe2, 0x11, 0x60, 0x8c, 0xb7, 0x07, 0xb3, 0xf5, 0xba,
+	0xe8, 0xe3, 0xff, 0xb3, 0xde, 0xbe, 0xf0, 0x6f, 0x0d, 0x80, 0xc1, 0xe7, 0x42, 0xfd, 0x75, 0x70,
+	0xad, 0x50, 0x2a, 0x55, 0x6a, 0x35, 0xab, 0xfe, 0x78, 0xb3, 0x62, 0x3d, 0x5a, 0xaf, 0x6d, 0x56,
+	0x4a, 0xd5, 0xe5, 0x6a, 0xa5, 0x9c, 0x1c, 0x49, 0xcf, 0xed, 0x1f, 0xe4, 0x66, 0x07, 0xc2, 0x8f,
+	0x08, 0xf3, 0x90, 0x2d, 0xfa, 0x96, 0x23, 0xae, 0xf5, 0x30, 0x6e, 0x7d, 0xa3, 0xb8, 0x51, 0x7e,
+	0x9c, 0xd4, 0xd2, 0x33, 0xfb, 0x07, 0xb9, 0xe4, 0x00, 0xb2, 0x4e, 0x1b, 0xd4, 0xe9, 0x8a, 0x97,
+	0xcc, 0x61, 0xe9, 0xca, 0x9b, 0x15, 0xf3, 0xb1, 0x04, 0x44, 0xd2, 0xd7, 0xf6, 0x0f, 0x72, 0x57,
+	0x06, 0x80, 0xca, 0x0e, 0xf2, 0xbb, 0x12, 0xf3, 0x00, 0x5c, 0x1f, 0xc6, 0x14, 0xd6, 0x1f, 0x5b,
+	0x1b, 0xcb, 0x56, 0xa1, 0x5c, 0x36, 0x2b, 0xb5, 0x5a, 0xa5, 0x96, 0x8c, 0xa6, 0xaf, 0xef, 0x1f,
+	0xe4, 0x52, 0x03, 0x68, 0x81, 0x74, 0x37, 0xb6, 0x0a, 0xe1, 0xc7, 0xdd, 0x74, 0xec, 0xa7, 0xbf,
+	0xcd, 0x8c, 0x7c, 0xf0, 0xbb, 0xcc, 0x88, 0x11, 0x8d, 0x8d, 0x26, 0x47, 0x17, 0x7e, 0x14, 0x05,
+	0xb9, 0xf3, 0x26, 0x57, 0x1d, 0x81, 0xbb, 0xa5, 0x8d, 0xf5, 0xba, 0x59, 0x28, 0xd5, 0xad, 0xd2,
+	0x46, 0xb9, 0x62, 0xad, 0x56, 0x6b, 0xf5, 0x0d, 0xf3, 0xb1, 0xb5, 0xb1, 0x59, 0x31, 0x0b, 0xf5,
+	0xea, 0xc6, 0xfa, 0x69, 0x7e, 0x5a, 0xdc, 0x3f, 0xc8, 0xdd, 0x3e, 0x4f, 0xf7, 0xb0, 0xf7, 0xde,
+	0x02, 0xaf, 0x5c, 0x68, 0x9b, 0xea, 0x7a, 0xb5, 0x9e, 0xd4, 0xd2, 0xf3, 0xfb, 0x07, 0xb9, 0x97,
+	0xce, 0xd3, 0x5f, 0x25, 0x98, 0xeb, 0xef, 0x80, 0x57, 0x2f, 0xa4, 0x78, 0xad, 0xba, 0x62, 0x16,
+	0xea, 0x95, 0xe4, 0x68, 0xfa, 0xf6, 0xfe, 0x41, 0xee, 0x2b, 0xe7, 0xe9, 0x5e, 0xc3, 0x4d, 0x1f,
+	0x72, 0x74, 0x61, 0xf5, 0x2b, 0x95, 0xf5, 0x4a, 0xad, 0x5a, 0x4b, 0x46, 0x2e, 0xa6, 0x7e, 0x05,
+	0x11, 0xc4, 0x30, 0xd3, 0xbf, 0x07, 0x6e, 0x5f, 0x48, 0x7d, 0xb9, 0xf2, 0x46, 0xa5, 0x5e, 0x49,
+	0x46, 0xd3, 0x0b, 0xfb, 0x07, 0xb9, 0x5b, 0xe7, 0x69, 0x2f, 0xa3, 0x36, 0xe2, 0x28, 0x1d, 0x15,
+	0xf9, 0x50, 0x5c, 0x7d, 0xf2, 0xcf, 0xcc, 0xc8, 0x07, 0x87, 0x19, 0xed, 0xc9, 0x61, 0x46, 0xfb,
+	0xf8, 0x30, 0xa3, 0xfd, 0xe3, 0x30, 0xa3, 0xbd, 0xff, 0x69, 0x66, 0xe4, 0xe3, 0x4f, 0x33, 0x23,
+	0x7f, 0xff, 0x34, 0x33, 0xf2, 0xdd, 0x5b, 0x43, 0x75, 0xb5, 0x44, 0x99, 0xfb, 0x56, 0xf8, 0xcf,
+	0x1e, 0x67, 0x71, 0x4f, 0xfd, 0xd3, 0x47, 0xd6, 0xd6, 0xc6, 0xb8, 0x2c, 0x4b, 0x5f, 0xfd, 0x6f,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x56, 0x57, 0x1d, 0x12, 0x1a, 0x00, 0x00,
+}
+
+func (this *AccessTypeParam) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
+
+	that1, ok := that.(*AccessTypeParam)
+	if !ok {
+		that2, ok := that.(AccessTypeParam)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Value != that1.Value {
+		return false
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return true
+}
+
+func (this *AccessConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*AccessConfig)
+	if !ok {
+		that2, ok := that.(AccessConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.IBCPortID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.IBC2PortID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Permission != that1.Permission {
+		return false
 	}
-	if m.Extension != nil {
-		l = m.Extension.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
 	}
-	return n
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	return true
 }
 
-func (m *ContractCodeHistoryEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.Operation != 0 {
-		n += 1 + sovTypes(uint64(m.Operation))
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Updated != nil {
-		l = m.Updated.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if !this.CodeUploadAccess.Equal(&that1.CodeUploadAccess) {
+		return false
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
+		return false
 	}
-	return n
+	if this.DeveloperFeeShareBps != that1.DeveloperFeeShareBps {
+		return false
+	}
+	if this.AdminTransferExpirySeconds != that1.AdminTransferExpirySeconds {
+		return false
+	}
+	if this.StorageDepositDenom != that1.StorageDepositDenom {
+		return false
+	}
+	if this.StorageDepositPerByte != that1.StorageDepositPerByte {
+		return false
+	}
+	if this.AutoPinWindowBlocks != that1.AutoPinWindowBlocks {
+		return false
+	}
+	if this.AutoPinMaxCodes != that1.AutoPinMaxCodes {
+		return false
+	}
+	if this.AutoPinMemoryBudget != that1.AutoPinMemoryBudget {
+		return false
+	}
+	if this.AutoPinMinExecutions != that1.AutoPinMinExecutions {
+		return false
+	}
+	if !this.GasRegister.Equal(that1.GasRegister) {
+		return false
+	}
+	if this.EpochHookGasBudget != that1.EpochHookGasBudget {
+		return false
+	}
+	return true
 }
 
-func (m *AbsoluteTxPosition) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *GasRegisterParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.BlockHeight))
+
+	that1, ok := that.(*GasRegisterParams)
+	if !ok {
+		that2, ok := that.(GasRegisterParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.InstanceCost != that1.InstanceCost {
+		return false
+	}
+	if this.InstanceCostDiscount != that1.InstanceCostDiscount {
+		return false
+	}
+	if this.CompileCost != that1.CompileCost {
+		return false
+	}
+	if this.UncompressCostNumerator != that1.UncompressCostNumerator {
+		return false
+	}
+	if this.UncompressCostDenominator != that1.UncompressCostDenominator {
+		return false
+	}
+	if this.GasMultiplier != that1.GasMultiplier {
+		return false
+	}
+	if this.EventPerAttributeCost != that1.EventPerAttributeCost {
+		return false
+	}
+	if this.EventAttributeDataCost != that1.EventAttributeDataCost {
+		return false
+	}
+	if this.EventAttributeDataFreeTier != that1.EventAttributeDataFreeTier {
+		return false
+	}
+	if this.ContractMessageDataCost != that1.ContractMessageDataCost {
+		return false
+	}
+	if this.CustomEventCost != that1.CustomEventCost {
+		return false
+	}
+	if this.HumanizeAddressCost != that1.HumanizeAddressCost {
+		return false
+	}
+	if this.CanonicalizeAddressCost != that1.CanonicalizeAddressCost {
+		return false
+	}
+	return true
+}
+
+func (this *CodeInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeInfo)
+	if !ok {
+		that2, ok := that.(CodeInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if !this.InstantiateConfig.Equal(&that1.InstantiateConfig) {
+		return false
+	}
+	if !this.Metadata.Equal(that1.Metadata) {
+		return false
+	}
+	return true
+}
+
+func (this *CodeMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeMetadata)
+	if !ok {
+		that2, ok := that.(CodeMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SourceRepository != that1.SourceRepository {
+		return false
+	}
+	if this.Commit != that1.Commit {
+		return false
+	}
+	if this.BuilderImage != that1.BuilderImage {
+		return false
+	}
+	if this.OptimizerVersion != that1.OptimizerVersion {
+		return false
+	}
+	if this.SchemaHash != that1.SchemaHash {
+		return false
+	}
+	return true
+}
+
+func (this *ContractInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractInfo)
+	if !ok {
+		that2, ok := that.(ContractInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if this.Label != that1.Label {
+		return false
+	}
+	if !this.Created.Equal(that1.Created) {
+		return false
+	}
+	if this.IBCPortID != that1.IBCPortID {
+		return false
+	}
+	if this.IBC2PortID != that1.IBC2PortID {
+		return false
+	}
+	if !this.Extension.Equal(that1.Extension) {
+		return false
+	}
+	return true
+}
+
+func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractCodeHistoryEntry)
+	if !ok {
+		that2, ok := that.(ContractCodeHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Operation != that1.Operation {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !this.Updated.Equal(that1.Updated) {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	return true
+}
+
+func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AbsoluteTxPosition)
+	if !ok {
+		that2, ok := that.(AbsoluteTxPosition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.TxIndex != that1.TxIndex {
+		return false
+	}
+	return true
+}
+
+func (this *Model) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Model)
+	if !ok {
+		that2, ok := that.(Model)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
+	}
+	return true
+}
+
+func (this *CronSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CronSchedule)
+	if !ok {
+		that2, ok := that.(CronSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.MaxErrors != that1.MaxErrors {
+		return false
+	}
+	if this.NextHeight != that1.NextHeight {
+		return false
+	}
+	if this.ErrorCount != that1.ErrorCount {
+		return false
+	}
+	if this.Disabled != that1.Disabled {
+		return false
+	}
+	return true
+}
+
+func (this *EpochHookSubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EpochHookSubscription)
+	if !ok {
+		that2, ok := that.(EpochHookSubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.EpochIdentifier != that1.EpochIdentifier {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+
+func (this *FeeShare) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeShare)
+	if !ok {
+		that2, ok := that.(FeeShare)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.WithdrawAddress != that1.WithdrawAddress {
+		return false
+	}
+	return true
+}
+
+func (this *CodeGasMultiplier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeGasMultiplier)
+	if !ok {
+		that2, ok := that.(CodeGasMultiplier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if this.MultiplierBps != that1.MultiplierBps {
+		return false
+	}
+	return true
+}
+
+func (this *CodeSchema) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeSchema)
+	if !ok {
+		that2, ok := that.(CodeSchema)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !bytes.Equal(this.Schema, that1.Schema) {
+		return false
+	}
+	if this.ValidateMessages != that1.ValidateMessages {
+		return false
+	}
+	return true
+}
+
+func (this *FeeSponsorship) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeSponsorship)
+	if !ok {
+		that2, ok := that.(FeeSponsorship)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if len(this.PerBlockLimit) != len(that1.PerBlockLimit) {
+		return false
+	}
+	for i := range this.PerBlockLimit {
+		if !this.PerBlockLimit[i].Equal(&that1.PerBlockLimit[i]) {
+			return false
+		}
+	}
+	if len(this.PerSenderLimit) != len(that1.PerSenderLimit) {
+		return false
+	}
+	for i := range this.PerSenderLimit {
+		if !this.PerSenderLimit[i].Equal(&that1.PerSenderLimit[i]) {
+			return false
+		}
+	}
+	if this.ApprovalGasLimit != that1.ApprovalGasLimit {
+		return false
+	}
+	return true
+}
+
+func (this *FeeSponsorshipUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeSponsorshipUsage)
+	if !ok {
+		that2, ok := that.(FeeSponsorshipUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if len(this.Spent) != len(that1.Spent) {
+		return false
+	}
+	for i := range this.Spent {
+		if !this.Spent[i].Equal(&that1.Spent[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *FeeSponsorshipSenderUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeSponsorshipSenderUsage)
+	if !ok {
+		that2, ok := that.(FeeSponsorshipSenderUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if len(this.Spent) != len(that1.Spent) {
+		return false
+	}
+	for i := range this.Spent {
+		if !this.Spent[i].Equal(&that1.Spent[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *PendingAdminTransfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingAdminTransfer)
+	if !ok {
+		that2, ok := that.(PendingAdminTransfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.NewAdmin != that1.NewAdmin {
+		return false
+	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	return true
+}
+
+func (this *ContractStorageUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractStorageUsage)
+	if !ok {
+		that2, ok := that.(ContractStorageUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Bytes != that1.Bytes {
+		return false
+	}
+	if this.Keys != that1.Keys {
+		return false
+	}
+	return true
+}
+
+func (this *StorageDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StorageDeposit)
+	if !ok {
+		that2, ok := that.(StorageDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	return true
+}
+
+func (this *ContractName) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractName)
+	if !ok {
+		that2, ok := that.(ContractName)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	return true
+}
+
+func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Permission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochHookGasBudget != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EpochHookGasBudget))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.GasRegister != nil {
+		{
+			size, err := m.GasRegister.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.AutoPinMinExecutions != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AutoPinMinExecutions))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AutoPinMemoryBudget != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AutoPinMemoryBudget))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AutoPinMaxCodes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AutoPinMaxCodes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AutoPinWindowBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AutoPinWindowBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StorageDepositPerByte != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageDepositPerByte))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.StorageDepositDenom) > 0 {
+		i -= len(m.StorageDepositDenom)
+		copy(dAtA[i:], m.StorageDepositDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.StorageDepositDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AdminTransferExpirySeconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AdminTransferExpirySeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DeveloperFeeShareBps != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DeveloperFeeShareBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InstantiateDefaultPermission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GasRegisterParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasRegisterParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasRegisterParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanonicalizeAddressCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CanonicalizeAddressCost))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.HumanizeAddressCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HumanizeAddressCost))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CustomEventCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CustomEventCost))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ContractMessageDataCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ContractMessageDataCost))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EventAttributeDataFreeTier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventAttributeDataFreeTier))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EventAttributeDataCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventAttributeDataCost))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EventPerAttributeCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventPerAttributeCost))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasMultiplier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasMultiplier))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UncompressCostDenominator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UncompressCostDenominator))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UncompressCostNumerator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UncompressCostNumerator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CompileCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CompileCost))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InstanceCostDiscount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstanceCostDiscount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InstanceCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstanceCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SchemaHash) > 0 {
+		i -= len(m.SchemaHash)
+		copy(dAtA[i:], m.SchemaHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SchemaHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OptimizerVersion) > 0 {
+		i -= len(m.OptimizerVersion)
+		copy(dAtA[i:], m.OptimizerVersion)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OptimizerVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BuilderImage) > 0 {
+		i -= len(m.BuilderImage)
+		copy(dAtA[i:], m.BuilderImage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BuilderImage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceRepository) > 0 {
+		i -= len(m.SourceRepository)
+		copy(dAtA[i:], m.SourceRepository)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SourceRepository)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Extension != nil {
+		{
+			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.IBC2PortID) > 0 {
+		i -= len(m.IBC2PortID)
+		copy(dAtA[i:], m.IBC2PortID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBC2PortID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.IBCPortID) > 0 {
+		i -= len(m.IBCPortID)
+		copy(dAtA[i:], m.IBCPortID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Operation != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Model) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Model) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CronSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ErrorCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ErrorCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxErrors != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxErrors))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochHookSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochHookSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochHookSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeGasMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeGasMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeGasMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MultiplierBps != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MultiplierBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidateMessages {
+		i--
+		if m.ValidateMessages {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApprovalGasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ApprovalGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PerSenderLimit) > 0 {
+		for iNdEx := len(m.PerSenderLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerSenderLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PerBlockLimit) > 0 {
+		for iNdEx := len(m.PerBlockLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerBlockLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsorshipUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorshipUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorshipUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsorshipSenderUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorshipSenderUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorshipSenderUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAdminTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAdminTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAdminTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTypes(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractStorageUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStorageUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStorageUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Keys != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Keys))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Bytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractName) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractName) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *AccessTypeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTypes(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AccessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovTypes(uint64(m.Permission))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CodeUploadAccess.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.InstantiateDefaultPermission != 0 {
+		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	}
+	if m.DeveloperFeeShareBps != 0 {
+		n += 1 + sovTypes(uint64(m.DeveloperFeeShareBps))
+	}
+	if m.AdminTransferExpirySeconds != 0 {
+		n += 1 + sovTypes(uint64(m.AdminTransferExpirySeconds))
+	}
+	l = len(m.StorageDepositDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StorageDepositPerByte != 0 {
+		n += 1 + sovTypes(uint64(m.StorageDepositPerByte))
+	}
+	if m.AutoPinWindowBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.AutoPinWindowBlocks))
+	}
+	if m.AutoPinMaxCodes != 0 {
+		n += 1 + sovTypes(uint64(m.AutoPinMaxCodes))
+	}
+	if m.AutoPinMemoryBudget != 0 {
+		n += 1 + sovTypes(uint64(m.AutoPinMemoryBudget))
+	}
+	if m.AutoPinMinExecutions != 0 {
+		n += 1 + sovTypes(uint64(m.AutoPinMinExecutions))
+	}
+	if m.GasRegister != nil {
+		l = m.GasRegister.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EpochHookGasBudget != 0 {
+		n += 1 + sovTypes(uint64(m.EpochHookGasBudget))
+	}
+	return n
+}
+
+func (m *GasRegisterParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InstanceCost != 0 {
+		n += 1 + sovTypes(uint64(m.InstanceCost))
+	}
+	if m.InstanceCostDiscount != 0 {
+		n += 1 + sovTypes(uint64(m.InstanceCostDiscount))
+	}
+	if m.CompileCost != 0 {
+		n += 1 + sovTypes(uint64(m.CompileCost))
+	}
+	if m.UncompressCostNumerator != 0 {
+		n += 1 + sovTypes(uint64(m.UncompressCostNumerator))
+	}
+	if m.UncompressCostDenominator != 0 {
+		n += 1 + sovTypes(uint64(m.UncompressCostDenominator))
+	}
+	if m.GasMultiplier != 0 {
+		n += 1 + sovTypes(uint64(m.GasMultiplier))
+	}
+	if m.EventPerAttributeCost != 0 {
+		n += 1 + sovTypes(uint64(m.EventPerAttributeCost))
+	}
+	if m.EventAttributeDataCost != 0 {
+		n += 1 + sovTypes(uint64(m.EventAttributeDataCost))
+	}
+	if m.EventAttributeDataFreeTier != 0 {
+		n += 1 + sovTypes(uint64(m.EventAttributeDataFreeTier))
+	}
+	if m.ContractMessageDataCost != 0 {
+		n += 1 + sovTypes(uint64(m.ContractMessageDataCost))
+	}
+	if m.CustomEventCost != 0 {
+		n += 1 + sovTypes(uint64(m.CustomEventCost))
+	}
+	if m.HumanizeAddressCost != 0 {
+		n += 1 + sovTypes(uint64(m.HumanizeAddressCost))
+	}
+	if m.CanonicalizeAddressCost != 0 {
+		n += 1 + sovTypes(uint64(m.CanonicalizeAddressCost))
+	}
+	return n
+}
+
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.InstantiateConfig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *CodeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceRepository)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BuilderImage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.OptimizerVersion)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SchemaHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IBCPortID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IBC2PortID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Extension != nil {
+		l = m.Extension.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCodeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovTypes(uint64(m.Operation))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AbsoluteTxPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *Model) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *CronSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	if m.MaxErrors != 0 {
+		n += 1 + sovTypes(uint64(m.MaxErrors))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovTypes(uint64(m.NextHeight))
+	}
+	if m.ErrorCount != 0 {
+		n += 1 + sovTypes(uint64(m.ErrorCount))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EpochHookSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *FeeShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *CodeGasMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	if m.MultiplierBps != 0 {
+		n += 1 + sovTypes(uint64(m.MultiplierBps))
+	}
+	return n
+}
+
+func (m *CodeSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ValidateMessages {
+		n += 2
+	}
+	return n
+}
+
+func (m *FeeSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.PerBlockLimit) > 0 {
+		for _, e := range m.PerBlockLimit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.PerSenderLimit) > 0 {
+		for _, e := range m.PerSenderLimit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ApprovalGasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.ApprovalGasLimit))
+	}
+	return n
+}
+
+func (m *FeeSponsorshipUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeSponsorshipSenderUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingAdminTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractStorageUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		n += 1 + sovTypes(uint64(m.Bytes))
+	}
+	if m.Keys != 0 {
+		n += 1 + sovTypes(uint64(m.Keys))
+	}
+	return n
+}
+
+func (m *StorageDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ContractName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+			}
+			m.InstantiateDefaultPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFeeShareBps", wireType)
+			}
+			m.DeveloperFeeShareBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeveloperFeeShareBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminTransferExpirySeconds", wireType)
+			}
+			m.AdminTransferExpirySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminTransferExpirySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDepositDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDepositDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDepositPerByte", wireType)
+			}
+			m.StorageDepositPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageDepositPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPinWindowBlocks", wireType)
+			}
+			m.AutoPinWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoPinWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPinMaxCodes", wireType)
+			}
+			m.AutoPinMaxCodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoPinMaxCodes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPinMemoryBudget", wireType)
+			}
+			m.AutoPinMemoryBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoPinMemoryBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPinMinExecutions", wireType)
+			}
+			m.AutoPinMinExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoPinMinExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRegister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasRegister == nil {
+				m.GasRegister = &GasRegisterParams{}
+			}
+			if err := m.GasRegister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochHookGasBudget", wireType)
+			}
+			m.EpochHookGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochHookGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GasRegisterParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasRegisterParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasRegisterParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceCost", wireType)
+			}
+			m.InstanceCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstanceCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceCostDiscount", wireType)
+			}
+			m.InstanceCostDiscount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstanceCostDiscount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompileCost", wireType)
+			}
+			m.CompileCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompileCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostNumerator", wireType)
+			}
+			m.UncompressCostNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostNumerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostDenominator", wireType)
+			}
+			m.UncompressCostDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
+			}
+			m.GasMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventPerAttributeCost", wireType)
+			}
+			m.EventPerAttributeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventPerAttributeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventAttributeDataCost", wireType)
+			}
+			m.EventAttributeDataCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventAttributeDataCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventAttributeDataFreeTier", wireType)
+			}
+			m.EventAttributeDataFreeTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventAttributeDataFreeTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMessageDataCost", wireType)
+			}
+			m.ContractMessageDataCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractMessageDataCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomEventCost", wireType)
+			}
+			m.CustomEventCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CustomEventCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HumanizeAddressCost", wireType)
+			}
+			m.HumanizeAddressCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HumanizeAddressCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalizeAddressCost", wireType)
+			}
+			m.CanonicalizeAddressCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanonicalizeAddressCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &CodeMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CodeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRepository", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRepository = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuilderImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuilderImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimizerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimizerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &AbsoluteTxPosition{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBC2PortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBC2PortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Extension == nil {
+				m.Extension = &types.Any{}
+			}
+			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Updated == nil {
+				m.Updated = &AbsoluteTxPosition{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Model) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Model: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CronSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxErrors", wireType)
+			}
+			m.MaxErrors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxErrors |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCount", wireType)
+			}
+			m.ErrorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.TxIndex != 0 {
-		n += 1 + sovTypes(uint64(m.TxIndex))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EpochHookSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochHookSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochHookSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Model) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
+func (m *FeeShare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1385,17 +6148,17 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeShare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.Value = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1405,11 +6168,56 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= AccessType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1432,7 +6240,7 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+func (m *CodeGasMultiplier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1455,17 +6263,17 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: CodeGasMultiplier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CodeGasMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			m.Permission = 0
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1475,16 +6283,16 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= AccessType(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiplierBps", wireType)
 			}
-			var stringLen uint64
+			m.MultiplierBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1494,24 +6302,11 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MultiplierBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1534,7 +6329,7 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CodeSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1557,17 +6352,36 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: CodeSchema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CodeSchema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1577,30 +6391,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Schema = append(m.Schema[:0], dAtA[iNdEx:postIndex]...)
+			if m.Schema == nil {
+				m.Schema = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidateMessages", wireType)
 			}
-			m.InstantiateDefaultPermission = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1610,11 +6425,12 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ValidateMessages = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1637,7 +6453,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+func (m *FeeSponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1660,17 +6476,17 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeSponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1680,31 +6496,29 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeHash == nil {
-				m.CodeHash = []byte{}
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerBlockLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1714,27 +6528,29 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.PerBlockLimit = append(m.PerBlockLimit, types1.Coin{})
+			if err := m.PerBlockLimit[len(m.PerBlockLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerSenderLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1761,10 +6577,30 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PerSenderLimit = append(m.PerSenderLimit, types1.Coin{})
+			if err := m.PerSenderLimit[len(m.PerSenderLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalGasLimit", wireType)
+			}
+			m.ApprovalGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovalGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1787,7 +6623,7 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+func (m *FeeSponsorshipUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1810,17 +6646,17 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeSponsorshipUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeSponsorshipUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.CodeID = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1830,16 +6666,16 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1849,59 +6685,80 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			m.Spent = append(m.Spent, types1.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *FeeSponsorshipSenderUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSponsorshipSenderUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSponsorshipSenderUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1929,11 +6786,11 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1960,16 +6817,65 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &AbsoluteTxPosition{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Spent = append(m.Spent, types1.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PendingAdminTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAdminTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAdminTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1997,11 +6903,11 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBC2PortID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2029,11 +6935,11 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IBC2PortID = string(dAtA[iNdEx:postIndex])
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2060,10 +6966,10 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Extension == nil {
-				m.Extension = &types.Any{}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
 			}
-			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2089,7 +6995,7 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
+func (m *ContractStorageUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2112,17 +7018,17 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractStorageUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractStorageUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
 			}
-			m.Operation = 0
+			m.Bytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2132,71 +7038,16 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				m.Bytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
-			}
-			m.CodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Updated == nil {
-				m.Updated = &AbsoluteTxPosition{}
-			}
-			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var byteLen int
+			m.Keys = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2206,26 +7057,11 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Keys |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2248,7 +7084,7 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
+func (m *StorageDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2271,17 +7107,17 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2291,16 +7127,29 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.TxIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2310,11 +7159,25 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2337,7 +7200,7 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Model) Unmarshal(dAtA []byte) error {
+func (m *ContractName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2360,17 +7223,17 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Model: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractName: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractName: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2380,31 +7243,29 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2414,25 +7275,23 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -112,6 +112,27 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 			srcMutator: func(c *CodeInfo) { c.InstantiateConfig = AccessConfig{} },
 			expError:   true,
 		},
+		"with metadata": {
+			srcMutator: func(c *CodeInfo) {
+				c.Metadata = &CodeMetadata{
+					SourceRepository: "https://github.com/CosmWasm/cw-plus",
+					Commit:           "a1b2c3d",
+					BuilderImage:     "cosmwasm/optimizer:0.16.0",
+					OptimizerVersion: "0.16.0",
+					SchemaHash:       "0123456789abcdef",
+				}
+			},
+		},
+		"metadata schema hash not hex": {
+			srcMutator: func(c *CodeInfo) { c.Metadata = &CodeMetadata{SchemaHash: "xyz"} },
+			expError:   true,
+		},
+		"metadata field too long": {
+			srcMutator: func(c *CodeInfo) {
+				c.Metadata = &CodeMetadata{SourceRepository: strings.Repeat("a", MaxCodeMetadataFieldSize+1)}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -126,6 +147,16 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 	}
 }
 
+func TestCodeMetadataValidateBasicDeterministic(t *testing.T) {
+	tooLong := strings.Repeat("a", MaxCodeMetadataFieldSize+1)
+	src := CodeMetadata{SourceRepository: tooLong, Commit: tooLong, BuilderImage: tooLong, OptimizerVersion: tooLong}
+	for range 20 {
+		err := src.ValidateBasic()
+		require.ErrorIs(t, err, ErrLimit)
+		assert.Contains(t, err.Error(), "source repository cannot be longer")
+	}
+}
+
 func TestContractInfoSetExtension(t *testing.T) {
 	anyTime := time.Now().UTC()
 	aNestedProtobufExt := func() ContractInfoExtension {
@@ -706,3 +737,176 @@ func TestTxContractsExists(t *testing.T) {
 		})
 	}
 }
+
+func TestTxContractsAddContractAddress(t *testing.T) {
+	var (
+		addrA sdk.AccAddress = bytes.Repeat([]byte{1}, ContractAddrLen)
+		addrB sdk.AccAddress = bytes.Repeat([]byte{2}, ContractAddrLen)
+	)
+	specs := map[string]struct {
+		addrs []sdk.AccAddress
+		exp   []sdk.AccAddress
+	}{
+		"single address": {
+			addrs: []sdk.AccAddress{addrA},
+			exp:   []sdk.AccAddress{addrA},
+		},
+		"duplicate addresses": {
+			addrs: []sdk.AccAddress{addrA, addrA},
+			exp:   []sdk.AccAddress{addrA},
+		},
+		"multiple addresses sorted": {
+			addrs: []sdk.AccAddress{addrB, addrA},
+			exp:   []sdk.AccAddress{addrA, addrB},
+		},
+		"empty address": {
+			addrs: []sdk.AccAddress{{}},
+			exp:   []sdk.AccAddress{},
+		},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			txContracts := NewTxContracts()
+			require.Empty(t, txContracts.GetContractAddresses())
+
+			for _, a := range spec.addrs {
+				txContracts.AddContractAddress(a)
+			}
+
+			assert.Equal(t, spec.exp, txContracts.GetContractAddresses())
+			// zero value is a no-op
+			TxContracts{}.AddContractAddress(addrA)
+		})
+	}
+}
+
+func TestWithTxContractsScope(t *testing.T) {
+	var (
+		addrA sdk.AccAddress = bytes.Repeat([]byte{1}, ContractAddrLen)
+		addrB sdk.AccAddress = bytes.Repeat([]byte{2}, ContractAddrLen)
+	)
+	parent := NewTxContracts()
+	parent.AddContractAddress(addrA)
+	ctx := WithTxContracts(sdk.Context{}.WithContext(context.Background()), parent)
+
+	// when
+	scopeCtx, commit := WithTxContractsScope(ctx)
+	scope, ok := TxContractsFromContext(scopeCtx)
+	require.True(t, ok)
+	scope.AddContractAddress(addrB)
+	scope.AddContract([]byte("checksum"))
+
+	// then the address is not tracked before commit
+	assert.Equal(t, []sdk.AccAddress{addrA}, parent.GetContractAddresses())
+	assert.Equal(t, []sdk.AccAddress{addrB}, scope.GetContractAddresses())
+	// but the executed code is shared
+	assert.True(t, parent.Exists([]byte("checksum")))
+
+	// and when committed
+	commit()
+	assert.Equal(t, []sdk.AccAddress{addrA, addrB}, parent.GetContractAddresses())
+
+	// and without tx contracts
+	noTxContractsCtx := sdk.Context{}.WithContext(context.Background())
+	gotCtx, commit := WithTxContractsScope(noTxContractsCtx)
+	commit()
+	_, ok = TxContractsFromContext(gotCtx)
+	assert.False(t, ok)
+}
+
+func TestPendingAdminTransferIsExpired(t *testing.T) {
+	expiresAt := time.Unix(1_700_000_000, 0).UTC()
+	specs := map[string]struct {
+		src       PendingAdminTransfer
+		blockTime time.Time
+		exp       bool
+	}{
+		"before expiry": {
+			src:       PendingAdminTransfer{ExpiresAt: &expiresAt},
+			blockTime: expiresAt.Add(-time.Second),
+		},
+		"at expiry": {
+			src:       PendingAdminTransfer{ExpiresAt: &expiresAt},
+			blockTime: expiresAt,
+		},
+		"after expiry": {
+			src:       PendingAdminTransfer{ExpiresAt: &expiresAt},
+			blockTime: expiresAt.Add(time.Second),
+			exp:       true,
+		},
+		"never expires": {
+			src:       PendingAdminTransfer{},
+			blockTime: expiresAt.Add(time.Hour),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, spec.src.IsExpired(spec.blockTime))
+		})
+	}
+}
+
+func TestContractNameValidateBasic(t *testing.T) {
+	myContract := sdk.AccAddress(make([]byte, ContractAddrLen)).String()
+	specs := map[string]struct {
+		src    ContractName
+		expErr bool
+	}{
+		"all good": {
+			src: ContractName{Name: "mantra.dex.pool-factory", Contract: myContract},
+		},
+		"digits and underscore": {
+			src: ContractName{Name: "pool_1", Contract: myContract},
+		},
+		"max length": {
+			src: ContractName{Name: strings.Repeat("a", MaxContractNameSize), Contract: myContract},
+		},
+		"name too long": {
+			src:    ContractName{Name: strings.Repeat("a", MaxContractNameSize+1), Contract: myContract},
+			expErr: true,
+		},
+		"name empty": {
+			src:    ContractName{Contract: myContract},
+			expErr: true,
+		},
+		"upper case": {
+			src:    ContractName{Name: "Mantra", Contract: myContract},
+			expErr: true,
+		},
+		"whitespace": {
+			src:    ContractName{Name: "my name", Contract: myContract},
+			expErr: true,
+		},
+		"leading dot": {
+			src:    ContractName{Name: ".mantra", Contract: myContract},
+			expErr: true,
+		},
+		"trailing dot": {
+			src:    ContractName{Name: "mantra.", Contract: myContract},
+			expErr: true,
+		},
+		"empty segment": {
+			src:    ContractName{Name: "mantra..dex", Contract: myContract},
+			expErr: true,
+		},
+		"non ascii": {
+			src:    ContractName{Name: "mäntra", Contract: myContract},
+			expErr: true,
+		},
+		"invalid contract": {
+			src:    ContractName{Name: "mantra", Contract: invalidAddress},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
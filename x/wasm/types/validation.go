@@ -30,8 +30,55 @@ var (
 
 	// MaxAddressCount is the maximum number of addresses allowed within a message
 	MaxAddressCount = 50
+
+	// MaxCronScheduleNameSize is the longest name that can be used for a cron schedule
+	MaxCronScheduleNameSize = 64
+
+	// MaxCronGasLimit is the max gas limit that can be set for a single cron schedule execution
+	MaxCronGasLimit uint64 = 10_000_000 // extension point for chains to customize via compile flag.
+
+	// MaxCronGasPerBlock is the max sum of the gas limits of all cron schedules that are executed in a block
+	MaxCronGasPerBlock uint64 = 50_000_000 // extension point for chains to customize via compile flag.
+
+	// MaxEpochIdentifierSize is the longest x/epochs identifier that a contract can subscribe to
+	MaxEpochIdentifierSize = 64
+
+	// MaxEpochHookGasLimit is the max gas limit that can be set for a single epoch hook call
+	MaxEpochHookGasLimit uint64 = 10_000_000 // extension point for chains to customize via compile flag.
+
+	// MaxFeeShareBps is the max developer fee share in basis points (100%)
+	MaxFeeShareBps uint32 = 10_000
+
+	// MaxFeeSponsorshipApprovalGasLimit is the max gas limit that can be set for a fee sponsorship approval call
+	MaxFeeSponsorshipApprovalGasLimit uint64 = 1_000_000 // extension point for chains to customize via compile flag.
+
+	// MaxCodeMetadataFieldSize is the longest value that can be used for a single code metadata field
+	MaxCodeMetadataFieldSize = 256 // extension point for chains to customize via compile flag.
+
+	// MaxContractNameSize is the longest name that can be claimed for a contract
+	MaxContractNameSize = 64 // extension point for chains to customize via compile flag.
+
+	// MinCodeGasMultiplierBps is the largest gas discount that can be set for a code in basis points (10%)
+	MinCodeGasMultiplierBps uint32 = 1_000 // extension point for chains to customize via compile flag.
+
+	// MaxCodeGasMultiplierBps is the largest gas surcharge that can be set for a code in basis points (10x)
+	MaxCodeGasMultiplierBps uint32 = 100_000 // extension point for chains to customize via compile flag.
+
+	// MaxCodeSchemaSize is the largest an uncompressed contract JSON schema can be
+	MaxCodeSchemaSize = 256 * 1024 // extension point for chains to customize via compile flag.
+
+	// MaxSchemaValidationSteps is the max number of schema nodes visited to validate a single contract message
+	MaxSchemaValidationSteps uint64 = 100_000 // extension point for chains to customize via compile flag.
 )
 
+// validateCodeGasMultiplierBps ensures the multiplier is within the min and max bounds
+func validateCodeGasMultiplierBps(bps uint32) error {
+	if bps < MinCodeGasMultiplierBps || bps > MaxCodeGasMultiplierBps {
+		return errorsmod.Wrapf(ErrInvalid, "gas multiplier must be between %d and %d bps", MinCodeGasMultiplierBps, MaxCodeGasMultiplierBps)
+	}
+	return nil
+}
+
 func validateWasmCode(s []byte, maxSize int) error {
 	if len(s) == 0 {
 		return errorsmod.Wrap(ErrEmpty, "is required")
@@ -65,6 +112,60 @@ func ValidateLabel(label string) error {
 	return nil
 }
 
+// ValidateCronScheduleName ensure the name is not empty, not too long and contains
+// only ascii letters, digits, '-', '_' and '.'
+func ValidateCronScheduleName(name string) error {
+	switch n := len(name); {
+	case n == 0:
+		return errorsmod.Wrap(ErrEmpty, "is required")
+	case n > MaxCronScheduleNameSize:
+		return ErrLimit.Wrapf("cannot be longer than %d characters", MaxCronScheduleNameSize)
+	}
+	for _, r := range name {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' || r == '.') {
+			return ErrInvalid.Wrapf("invalid character %q", r)
+		}
+	}
+	return nil
+}
+
+// ValidateContractName ensure the name is not empty, not too long and consists of dot separated
+// non-empty segments of lower case ascii letters, digits, '-' and '_'
+func ValidateContractName(name string) error {
+	switch n := len(name); {
+	case n == 0:
+		return errorsmod.Wrap(ErrEmpty, "is required")
+	case n > MaxContractNameSize:
+		return ErrLimit.Wrapf("cannot be longer than %d characters", MaxContractNameSize)
+	}
+	for _, segment := range strings.Split(name, ".") {
+		if segment == "" {
+			return ErrInvalid.Wrap("empty segment")
+		}
+		for _, r := range segment {
+			if !(('a' <= r && r <= 'z') || ('0' <= r && r <= '9') || r == '-' || r == '_') {
+				return ErrInvalid.Wrapf("invalid character %q", r)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateEpochIdentifier ensure the x/epochs identifier is not empty, not too long and
+// has no whitespaces
+func ValidateEpochIdentifier(identifier string) error {
+	switch n := len(identifier); {
+	case n == 0:
+		return errorsmod.Wrap(ErrEmpty, "is required")
+	case n > MaxEpochIdentifierSize:
+		return ErrLimit.Wrapf("cannot be longer than %d characters", MaxEpochIdentifierSize)
+	}
+	if strings.ContainsFunc(identifier, func(r rune) bool { return unicode.IsSpace(r) || !unicode.IsPrint(r) }) {
+		return ErrInvalid.Wrap("must have printable characters without whitespaces only")
+	}
+	return nil
+}
+
 // ValidateSalt ensure salt constraints
 func ValidateSalt(salt []byte) error {
 	switch n := len(salt); {